@@ -36,11 +36,22 @@ func NewWindow(width, height int) (*Window, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newWindow(ctx, width, height)
+}
+
+// NewSoftWindow creates a new headless window that renders with the
+// software backend of package gioui.org/gpu/soft. Unlike NewWindow,
+// it doesn't need a GPU context, but rendering is much slower.
+func NewSoftWindow(width, height int) (*Window, error) {
+	return newWindow(softContext{}, width, height)
+}
+
+func newWindow(ctx context, width, height int) (*Window, error) {
 	w := &Window{
 		size: image.Point{X: width, Y: height},
 		ctx:  ctx,
 	}
-	err = contextDo(ctx, func() error {
+	err := contextDo(ctx, func() error {
 		dev, err := ctx.Backend()
 		if err != nil {
 			return err
@@ -53,7 +64,7 @@ func NewWindow(width, height int) (*Window, error) {
 			backend.BufferBindingFramebuffer,
 		)
 		if err != nil {
-			return nil
+			return err
 		}
 		const depthBits = 16
 		fbo, err := dev.NewFramebuffer(fboTex, depthBits)
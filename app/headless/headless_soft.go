@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package headless
+
+import (
+	"gioui.org/gpu/backend"
+	"gioui.org/gpu/soft"
+)
+
+// softContext is a context for the software backend.
+type softContext struct{}
+
+func (c softContext) Backend() (backend.Device, error) {
+	return soft.NewBackend(), nil
+}
+
+func (c softContext) MakeCurrent() error {
+	return nil
+}
+
+func (c softContext) ReleaseCurrent() {
+}
+
+func (c softContext) Release() {
+}
@@ -5,9 +5,11 @@ package headless
 import (
 	"image"
 	"image/color"
+	"math"
 	"testing"
 
 	"gioui.org/f32"
+	"gioui.org/internal/f32color"
 	"gioui.org/op"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
@@ -98,6 +100,126 @@ func TestClipping(t *testing.T) {
 	}
 }
 
+func TestStroke(t *testing.T) {
+	w, release := newTestWindow(t)
+	defer release()
+	var ops op.Ops
+
+	red := color.RGBA{R: 0xFF, A: 0xFF}
+	paint.ColorOp{Color: red}.Add(&ops)
+	s := clip.Stroke{Style: clip.StrokeStyle{Width: 10}}
+	s.Begin(&ops)
+	s.Move(f32.Point{X: 50, Y: 50})
+	s.Line(f32.Point{X: 100, Y: 0})
+	s.Line(f32.Point{X: 0, Y: 100})
+	s.Line(f32.Point{X: -100, Y: 0})
+	s.Close()
+	s.End().Add(&ops)
+	paint.PaintOp{Rect: f32.Rectangle{
+		Max: f32.Point{X: 200, Y: 200},
+	}}.Add(&ops)
+	w.Frame(&ops)
+
+	img, err := w.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *dumpImages {
+		if err := saveImage("stroke.png", img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bg := color.RGBA{A: 0xff, R: 0xff, G: 0xff, B: 0xff}
+	tests := []struct {
+		x, y  int
+		color color.RGBA
+	}{
+		{46, 46, red},
+		{100, 52, red},
+		{152, 152, red},
+		{100, 100, bg},
+		{40, 40, bg},
+	}
+	for _, test := range tests {
+		if got := img.RGBAAt(test.x, test.y); got != test.color {
+			t.Errorf("(%d,%d): got color %v, expected %v", test.x, test.y, got, test.color)
+		}
+	}
+}
+
+func TestPathWinding(t *testing.T) {
+	w, release := newTestWindow(t)
+	defer release()
+	var ops op.Ops
+
+	// A translucent color reveals over-saturated coverage.
+	col := color.RGBA{R: 0x80, A: 0x80}
+	paint.ColorOp{Color: col}.Add(&ops)
+	var p clip.Path
+	p.Begin(&ops)
+	poly := func(pts ...f32.Point) {
+		p.Move(pts[0].Sub(p.Pos()))
+		for _, pt := range pts[1:] {
+			p.Line(pt.Sub(p.Pos()))
+		}
+		p.Line(pts[0].Sub(p.Pos()))
+	}
+	square := func(x0, y0, x1, y1 float32) {
+		poly(f32.Pt(x0, y0), f32.Pt(x1, y0), f32.Pt(x1, y1), f32.Pt(x0, y1))
+	}
+	// Nested contours in the same direction.
+	square(50, 50, 150, 150)
+	square(75, 75, 125, 125)
+	// Nested contours in opposite directions.
+	square(200, 50, 300, 150)
+	square(275, 75, 225, 125)
+	// A self-intersecting pentagram around (450, 100).
+	var star []f32.Point
+	for i := 0; i < 5; i++ {
+		a := -math.Pi/2 + float64(i)*4*math.Pi/5
+		star = append(star, f32.Pt(450+50*float32(math.Cos(a)), 100+50*float32(math.Sin(a))))
+	}
+	poly(star...)
+	p.End().Add(&ops)
+	paint.PaintOp{Rect: f32.Rectangle{
+		Max: f32.Point{X: 600, Y: 200},
+	}}.Add(&ops)
+	w.Frame(&ops)
+
+	img, err := w.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *dumpImages {
+		if err := saveImage("winding.png", img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bg := color.RGBA{A: 0xff, R: 0xff, G: 0xff, B: 0xff}
+	filled := img.RGBAAt(60, 60)
+	if filled == bg {
+		t.Errorf("(60,60): got color %v, expected a filled color", filled)
+	}
+	tests := []struct {
+		x, y  int
+		color color.RGBA
+	}{
+		// Regions with a non-zero winding number are filled once.
+		{100, 100, filled},
+		{210, 60, filled},
+		{450, 100, filled},
+		{450, 60, filled},
+		// Regions with a zero winding number are empty.
+		{250, 100, bg},
+		{25, 25, bg},
+	}
+	for _, test := range tests {
+		if got := img.RGBAAt(test.x, test.y); got != test.color {
+			t.Errorf("(%d,%d): got color %v, expected %v", test.x, test.y, got, test.color)
+		}
+	}
+}
+
 func TestDepth(t *testing.T) {
 	w, release := newTestWindow(t)
 	defer release()
@@ -139,10 +261,249 @@ func TestDepth(t *testing.T) {
 	}
 }
 
+func TestTransform(t *testing.T) {
+	w, release := newTestWindow(t)
+	defer release()
+	var ops op.Ops
+
+	red := color.RGBA{R: 0xFF, A: 0xFF}
+	paint.ColorOp{Color: red}.Add(&ops)
+	// Rotate a 100x100 square by 45 degrees around its center.
+	a := f32.Affine2D{}.Rotate(f32.Point{X: 150, Y: 150}, math.Pi/4)
+	op.Affine(a).Add(&ops)
+	paint.PaintOp{Rect: f32.Rectangle{
+		Min: f32.Point{X: 100, Y: 100},
+		Max: f32.Point{X: 200, Y: 200},
+	}}.Add(&ops)
+	w.Frame(&ops)
+
+	img, err := w.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *dumpImages {
+		if err := saveImage("transform.png", img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bg := color.RGBA{A: 0xff, R: 0xff, G: 0xff, B: 0xff}
+	tests := []struct {
+		x, y  int
+		color color.RGBA
+	}{
+		{150, 150, red},
+		// The rotated corners.
+		{150, 85, red},
+		{215, 150, red},
+		// The original corners.
+		{105, 105, bg},
+		{195, 195, bg},
+	}
+	for _, test := range tests {
+		if got := img.RGBAAt(test.x, test.y); got != test.color {
+			t.Errorf("(%d,%d): got color %v, expected %v", test.x, test.y, got, test.color)
+		}
+	}
+}
+
+func TestGradient(t *testing.T) {
+	w, release := newTestWindow(t)
+	defer release()
+	var ops op.Ops
+
+	red := color.RGBA{R: 0xFF, A: 0xFF}
+	blue := color.RGBA{B: 0xFF, A: 0xFF}
+	paint.LinearGradientOp{
+		Start: f32.Point{X: 50},
+		End:   f32.Point{X: 250},
+		Stops: []paint.GradientStop{
+			{Offset: 0, Color: red},
+			{Offset: 1, Color: blue},
+		},
+	}.Add(&ops)
+	paint.PaintOp{Rect: f32.Rectangle{Max: f32.Point{X: 300, Y: 100}}}.Add(&ops)
+	paint.RadialGradientOp{
+		Center: f32.Point{X: 150, Y: 250},
+		Radius: 100,
+		Stops: []paint.GradientStop{
+			{Offset: 0.5, Color: blue},
+			{Offset: 0.5, Color: red},
+		},
+	}.Add(&ops)
+	paint.PaintOp{Rect: f32.Rectangle{
+		Min: f32.Point{X: 0, Y: 150},
+		Max: f32.Point{X: 300, Y: 350},
+	}}.Add(&ops)
+	w.Frame(&ops)
+
+	img, err := w.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *dumpImages {
+		if err := saveImage("gradient.png", img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		x, y  int
+		color color.RGBA
+	}{
+		// Colors before and after the stops.
+		{10, 50, red},
+		{290, 50, blue},
+		// Inside and outside the hard radial transition.
+		{150, 250, blue},
+		{210, 250, red},
+		{150, 340, red},
+	}
+	for _, test := range tests {
+		if got := img.RGBAAt(test.x, test.y); got != test.color {
+			t.Errorf("(%d,%d): got color %v, expected %v", test.x, test.y, got, test.color)
+		}
+	}
+	// Halfway between the stops the colors are mixed.
+	if got := img.RGBAAt(150, 50); got.R == 0 || got.B == 0 || got.G != 0 {
+		t.Errorf("(150,50): got color %v, expected a mix of red and blue", got)
+	}
+}
+
+func TestOpacity(t *testing.T) {
+	w, release := newTestWindow(t)
+	defer release()
+	var ops op.Ops
+
+	red := color.RGBA{R: 0xFF, A: 0xFF}
+	stack := op.Push(&ops)
+	paint.OpacityOp{Opacity: 0.5}.Add(&ops)
+	paint.ColorOp{Color: red}.Add(&ops)
+	// Two overlapping squares.
+	paint.PaintOp{Rect: f32.Rectangle{
+		Min: f32.Point{X: 50, Y: 50},
+		Max: f32.Point{X: 150, Y: 150},
+	}}.Add(&ops)
+	paint.PaintOp{Rect: f32.Rectangle{
+		Min: f32.Point{X: 100, Y: 100},
+		Max: f32.Point{X: 200, Y: 200},
+	}}.Add(&ops)
+	stack.Pop()
+	// Operations after the layer are opaque.
+	paint.ColorOp{Color: red}.Add(&ops)
+	paint.PaintOp{Rect: f32.Rectangle{
+		Min: f32.Point{X: 300, Y: 50},
+		Max: f32.Point{X: 350, Y: 100},
+	}}.Add(&ops)
+	w.Frame(&ops)
+
+	img, err := w.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *dumpImages {
+		if err := saveImage("opacity.png", img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bg := color.RGBA{A: 0xff, R: 0xff, G: 0xff, B: 0xff}
+	faded := img.RGBAAt(75, 75)
+	if faded == red || faded == bg {
+		t.Errorf("(75,75): got color %v, expected faded red", faded)
+	}
+	tests := []struct {
+		x, y  int
+		color color.RGBA
+	}{
+		// The overlap is as faded as the rest of the layer.
+		{125, 125, faded},
+		{175, 175, faded},
+		{25, 25, bg},
+		{325, 75, red},
+	}
+	for _, test := range tests {
+		if got := img.RGBAAt(test.x, test.y); got != test.color {
+			t.Errorf("(%d,%d): got color %v, expected %v", test.x, test.y, got, test.color)
+		}
+	}
+}
+
+func TestNestedOpacity(t *testing.T) {
+	w, release := newTestWindow(t)
+	defer release()
+
+	red := color.RGBA{R: 0xFF, A: 0xFF}
+	blue := color.RGBA{B: 0xFF, A: 0xFF}
+	square := func(ops *op.Ops, col color.RGBA, x0, y0, x1, y1 float32) {
+		paint.ColorOp{Color: col}.Add(ops)
+		paint.PaintOp{Rect: f32.Rect(x0, y0, x1, y1)}.Add(ops)
+	}
+	// Draw a layer covering the window first, to check that
+	// smaller layers are not drawn into its larger texture.
+	var ops op.Ops
+	stack := op.Push(&ops)
+	paint.OpacityOp{Opacity: 0.5}.Add(&ops)
+	square(&ops, blue, 0, 0, 800, 600)
+	stack.Pop()
+	w.Frame(&ops)
+
+	ops.Reset()
+	outer := op.Push(&ops)
+	paint.OpacityOp{Opacity: 0.5}.Add(&ops)
+	square(&ops, red, 50, 50, 150, 150)
+	inner := op.Push(&ops)
+	paint.OpacityOp{Opacity: 0.5}.Add(&ops)
+	square(&ops, blue, 100, 100, 200, 200)
+	inner.Pop()
+	outer.Pop()
+	w.Frame(&ops)
+
+	img, err := w.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *dumpImages {
+		if err := saveImage("nested_opacity.png", img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Layers are blended in linear color space.
+	tests := []struct {
+		x, y  int
+		color f32color.RGBA
+	}{
+		// The outer layer over the white background.
+		{75, 75, f32color.RGBA{R: 1, G: .5, B: .5, A: 1}},
+		// The inner layer over red, then the outer layer.
+		{125, 125, f32color.RGBA{R: .75, G: .5, B: .75, A: 1}},
+		// The inner layer faded twice.
+		{175, 175, f32color.RGBA{R: .75, G: .75, B: 1, A: 1}},
+		{25, 25, f32color.RGBA{R: 1, G: 1, B: 1, A: 1}},
+		{250, 250, f32color.RGBA{R: 1, G: 1, B: 1, A: 1}},
+	}
+	for _, test := range tests {
+		exp := test.color.SRGB()
+		if got := img.RGBAAt(test.x, test.y); !colorsClose(got, exp) {
+			t.Errorf("(%d,%d): got color %v, expected %v", test.x, test.y, got, exp)
+		}
+	}
+}
+
+// colorsClose reports whether the channels of c1 and c2 differ by at
+// most 1, to allow for rounding in blending.
+func colorsClose(c1, c2 color.RGBA) bool {
+	close := func(a, b uint8) bool {
+		return a-b <= 1 || b-a <= 1
+	}
+	return close(c1.R, c2.R) && close(c1.G, c2.G) && close(c1.B, c2.B) && close(c1.A, c2.A)
+}
+
 func newTestWindow(t *testing.T) (*Window, func()) {
 	t.Helper()
 	sz := image.Point{X: 800, Y: 600}
-	w, err := NewWindow(sz.X, sz.Y)
+	newWindow := NewWindow
+	if softTest {
+		newWindow = NewSoftWindow
+	}
+	w, err := newWindow(sz.X, sz.Y)
 	if err != nil {
 		t.Skipf("headless windows not supported: %v", err)
 	}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package headless
+
+import "testing"
+
+// softTest forces the tests to use the software backend.
+var softTest bool
+
+func TestSoft(t *testing.T) {
+	softTest = true
+	defer func() {
+		softTest = false
+	}()
+	tests := []struct {
+		name string
+		test func(t *testing.T)
+	}{
+		{"FramebufferClear", TestFramebufferClear},
+		{"SimpleShader", TestSimpleShader},
+		{"InputShader", TestInputShader},
+		{"Framebuffers", TestFramebuffers},
+		{"Headless", TestHeadless},
+		{"Clipping", TestClipping},
+		{"Stroke", TestStroke},
+		{"PathWinding", TestPathWinding},
+		{"Depth", TestDepth},
+		{"Transform", TestTransform},
+		{"Gradient", TestGradient},
+		{"Opacity", TestOpacity},
+		{"NestedOpacity", TestNestedOpacity},
+	}
+	for _, test := range tests {
+		t.Run(test.name, test.test)
+	}
+}
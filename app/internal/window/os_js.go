@@ -478,7 +478,7 @@ func translateKey(k string) (string, bool) {
 	case "Tab":
 		n = key.NameTab
 	case " ":
-		n = "Space"
+		n = key.NameSpace
 	case "F1", "F2", "F3", "F4", "F5", "F6", "F7", "F8", "F9", "F10", "F11", "F12":
 		n = k
 	default:
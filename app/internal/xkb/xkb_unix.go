@@ -288,7 +288,7 @@ func convertKeysym(s C.xkb_keysym_t) (string, bool) {
 	case C.XKB_KEY_Tab, C.XKB_KEY_KP_Tab, C.XKB_KEY_ISO_Left_Tab:
 		n = key.NameTab
 	case 0x20, C.XKB_KEY_KP_Space:
-		n = "Space"
+		n = key.NameSpace
 	default:
 		return "", false
 	}
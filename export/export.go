@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+/*
+Package export converts operation lists to vector document formats.
+
+SVG writes a frame as an SVG image and PDF writes it as a single PDF
+page. Only the drawing operations are interpreted: transformations,
+clip paths, color, image and gradient brushes, opacity layers and paint
+operations. Text reaches the operation list as clip paths from
+text.Shaper and is exported as vector outlines.
+
+Both formats use the frame coordinates as their unit; one pixel
+becomes one SVG user unit or one PDF point.
+*/
+package export
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+
+	"gioui.org/f32"
+	"gioui.org/internal/opconst"
+	"gioui.org/internal/ops"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+)
+
+// item is either a filled shape or an opacity layer.
+type item struct {
+	// shape is the area to fill, in frame coordinates.
+	shape path
+	clip  *clipPath
+	brush brush
+	// layer is non-nil for opacity layers.
+	layer *layer
+}
+
+type layer struct {
+	opacity float32
+	items   []item
+}
+
+// clipPath is a clip area intersected with its parent
+// clip area.
+type clipPath struct {
+	parent *clipPath
+	path   path
+}
+
+// path is a list of closed contours in frame coordinates.
+type path []contour
+
+type contour []ops.Quad
+
+type brushType uint8
+
+const (
+	brushColor brushType = iota
+	brushImage
+	brushGradient
+)
+
+type brush struct {
+	typ      brushType
+	color    color.RGBA
+	image    imageBrush
+	gradient gradient
+	// t is the transformation from brush coordinates to
+	// frame coordinates.
+	t f32.Affine2D
+}
+
+type imageBrush struct {
+	src *image.RGBA
+	// rect is the source rectangle of src.
+	rect image.Rectangle
+	// dst is the destination rectangle in brush coordinates.
+	dst f32.Rectangle
+}
+
+type gradient struct {
+	radial bool
+	// p0 and p1 are the start and end points of linear
+	// gradients. For radial gradients p0 is the center and
+	// p1.X the radius.
+	p0, p1 f32.Point
+	stops  []paint.GradientStop
+}
+
+type drawState struct {
+	t     f32.Affine2D
+	clip  *clipPath
+	brush brush
+}
+
+// collect interprets the drawing operations of frame.
+func collect(frame *op.Ops) []item {
+	var r ops.Reader
+	r.Reset(frame)
+	var items []item
+	// The default brush is opaque black, as in package gpu.
+	state := drawState{
+		brush: brush{typ: brushColor, color: color.RGBA{A: 0xff}},
+	}
+	collectOps(&r, state, &items)
+	return items
+}
+
+func collectOps(r *ops.Reader, state drawState, items *[]item) {
+	var aux []byte
+loop:
+	for encOp, ok := r.Decode(); ok; encOp, ok = r.Decode() {
+		switch opconst.OpType(encOp.Data[0]) {
+		case opconst.TypeTransform:
+			state.t = state.t.Mul(ops.DecodeTransformOp(encOp.Data))
+		case opconst.TypeAux:
+			aux = encOp.Data[opconst.TypeAuxLen:]
+		case opconst.TypeClip:
+			var p path
+			if len(aux) > 0 {
+				p = decodePath(aux, state.t)
+			} else {
+				p = rectPath(decodeRect(encOp.Data), state.t)
+			}
+			state.clip = &clipPath{parent: state.clip, path: p}
+			aux = nil
+		case opconst.TypeColor:
+			state.brush = brush{typ: brushColor, color: decodeColorOp(encOp.Data)}
+		case opconst.TypeImage:
+			state.brush = brush{typ: brushImage, image: decodeImageOp(encOp.Data, encOp.Refs)}
+		case opconst.TypeLinearGradient, opconst.TypeRadialGradient:
+			state.brush = brush{typ: brushGradient, gradient: decodeGradientOp(encOp.Data)}
+		case opconst.TypeLayer:
+			opacity := decodeLayerOp(encOp.Data)
+			if opacity >= 1 {
+				// Opaque layers are equivalent to drawing
+				// their contents directly.
+				continue
+			}
+			l := &layer{opacity: opacity}
+			// The layer extends to the end of the current state.
+			collectOps(r, state, &l.items)
+			if opacity > 0 && len(l.items) > 0 {
+				*items = append(*items, item{layer: l})
+			}
+			break loop
+		case opconst.TypePaint:
+			rect := decodeRect(encOp.Data)
+			b := state.brush
+			b.t = state.t
+			switch b.typ {
+			case brushColor:
+				if b.color.A == 0 {
+					continue
+				}
+			case brushImage:
+				if b.image.src == nil {
+					continue
+				}
+				b.image.dst = rect
+			}
+			*items = append(*items, item{
+				shape: rectPath(rect, state.t),
+				clip:  state.clip,
+				brush: b,
+			})
+		case opconst.TypePush:
+			collectOps(r, state, items)
+		case opconst.TypePop:
+			break loop
+		}
+	}
+}
+
+// decodePath decodes the path segments of a clip path and
+// transforms them to frame coordinates.
+func decodePath(data []byte, t f32.Affine2D) path {
+	var p path
+	var idx uint32
+	for len(data) >= ops.QuadSize {
+		c, q := ops.DecodeQuad(data)
+		data = data[ops.QuadSize:]
+		if len(p) == 0 || c != idx {
+			p = append(p, nil)
+			idx = c
+		}
+		q.From = t.Transform(q.From)
+		q.Ctrl = t.Transform(q.Ctrl)
+		q.To = t.Transform(q.To)
+		p[len(p)-1] = append(p[len(p)-1], q)
+	}
+	return p
+}
+
+// rectPath returns the outline of a rectangle transformed by t.
+func rectPath(r f32.Rectangle, t f32.Affine2D) path {
+	corners := [4]f32.Point{
+		r.Min,
+		{X: r.Max.X, Y: r.Min.Y},
+		r.Max,
+		{X: r.Min.X, Y: r.Max.Y},
+	}
+	var c contour
+	for i, p := range corners {
+		from, to := t.Transform(p), t.Transform(corners[(i+1)%len(corners)])
+		c = append(c, ops.Quad{From: from, Ctrl: from.Add(to).Mul(.5), To: to})
+	}
+	return path{c}
+}
+
+// isLine reports whether the quadratic segment is a straight line
+// from its start to its end point.
+func isLine(q ops.Quad) bool {
+	const tol = 1e-3
+	mid := q.From.Add(q.To).Mul(.5)
+	d := q.Ctrl.Sub(mid)
+	return math.Abs(float64(d.X)) < tol && math.Abs(float64(d.Y)) < tol
+}
+
+func decodeRect(data []byte) f32.Rectangle {
+	bo := binary.LittleEndian
+	return f32.Rectangle{
+		Min: f32.Point{
+			X: math.Float32frombits(bo.Uint32(data[1:])),
+			Y: math.Float32frombits(bo.Uint32(data[5:])),
+		},
+		Max: f32.Point{
+			X: math.Float32frombits(bo.Uint32(data[9:])),
+			Y: math.Float32frombits(bo.Uint32(data[13:])),
+		},
+	}
+}
+
+func decodeColorOp(data []byte) color.RGBA {
+	if opconst.OpType(data[0]) != opconst.TypeColor {
+		panic("invalid op")
+	}
+	return color.RGBA{
+		R: data[1],
+		G: data[2],
+		B: data[3],
+		A: data[4],
+	}
+}
+
+func decodeImageOp(data []byte, refs []interface{}) imageBrush {
+	if opconst.OpType(data[0]) != opconst.TypeImage {
+		panic("invalid op")
+	}
+	if refs[1] == nil {
+		return imageBrush{}
+	}
+	bo := binary.LittleEndian
+	return imageBrush{
+		src: refs[0].(*image.RGBA),
+		rect: image.Rectangle{
+			Min: image.Point{
+				X: int(bo.Uint32(data[1:])),
+				Y: int(bo.Uint32(data[5:])),
+			},
+			Max: image.Point{
+				X: int(bo.Uint32(data[9:])),
+				Y: int(bo.Uint32(data[13:])),
+			},
+		},
+	}
+}
+
+func decodeGradientOp(data []byte) gradient {
+	var g gradient
+	switch opconst.OpType(data[0]) {
+	case opconst.TypeLinearGradient:
+	case opconst.TypeRadialGradient:
+		g.radial = true
+	default:
+		panic("invalid op")
+	}
+	bo := binary.LittleEndian
+	n := int(data[1])
+	if n > opconst.MaxGradientStops {
+		panic("invalid op")
+	}
+	g.p0 = f32.Point{
+		X: math.Float32frombits(bo.Uint32(data[2:])),
+		Y: math.Float32frombits(bo.Uint32(data[6:])),
+	}
+	g.p1 = f32.Point{
+		X: math.Float32frombits(bo.Uint32(data[10:])),
+		Y: math.Float32frombits(bo.Uint32(data[14:])),
+	}
+	data = data[18:]
+	for i := 0; i < n; i++ {
+		g.stops = append(g.stops, paint.GradientStop{
+			Offset: math.Float32frombits(bo.Uint32(data)),
+			Color: color.RGBA{
+				R: data[4],
+				G: data[5],
+				B: data[6],
+				A: data[7],
+			},
+		})
+		data = data[8:]
+	}
+	return g
+}
+
+func decodeLayerOp(data []byte) float32 {
+	if opconst.OpType(data[0]) != opconst.TypeLayer {
+		panic("invalid op")
+	}
+	bo := binary.LittleEndian
+	return math.Float32frombits(bo.Uint32(data[1:]))
+}
+
+// unpremultiply converts a color with premultiplied alpha to a color
+// with straight alpha, as used by document formats.
+func unpremultiply(c color.RGBA) color.NRGBA {
+	return color.NRGBAModel.Convert(c).(color.NRGBA)
+}
+
+// ftoa formats a float without exponent and with the minimal number of
+// digits.
+func ftoa(v float32) string {
+	if v == 0 {
+		// Avoid negative zero.
+		return "0"
+	}
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
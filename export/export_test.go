@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+func testFrame() *op.Ops {
+	ops := new(op.Ops)
+	red := color.RGBA{R: 0xff, A: 0xff}
+	paint.ColorOp{Color: red}.Add(ops)
+	paint.PaintOp{Rect: f32.Rect(0, 0, 100, 50)}.Add(ops)
+
+	stack := op.Push(ops)
+	op.TransformOp{}.Offset(f32.Pt(10, 60)).Add(ops)
+	var p clip.Path
+	p.Begin(ops)
+	p.Line(f32.Pt(50, 0))
+	p.Quad(f32.Pt(25, 25), f32.Pt(-50, 0))
+	p.End().Add(ops)
+	paint.LinearGradientOp{
+		Start: f32.Pt(0, 0),
+		End:   f32.Pt(50, 0),
+		Stops: []paint.GradientStop{
+			{Offset: 0, Color: red},
+			{Offset: 1, Color: color.RGBA{B: 0xff, A: 0xff}},
+		},
+	}.Add(ops)
+	paint.PaintOp{Rect: f32.Rect(0, 0, 50, 50)}.Add(ops)
+	stack.Pop()
+
+	stack = op.Push(ops)
+	paint.OpacityOp{Opacity: 0.5}.Add(ops)
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.RGBA{G: 0x80, A: 0x80})
+	paint.NewImageOp(img).Add(ops)
+	paint.PaintOp{Rect: f32.Rect(100, 100, 120, 120)}.Add(ops)
+	stack.Pop()
+	return ops
+}
+
+func TestSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := SVG(&buf, image.Pt(200, 200), testFrame()); err != nil {
+		t.Fatal(err)
+	}
+	// Count the elements of the well-formed document.
+	elems := make(map[string]int)
+	d := xml.NewDecoder(&buf)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s, ok := tok.(xml.StartElement); ok {
+			elems[s.Name.Local]++
+		}
+	}
+	exp := map[string]int{
+		"svg":            1,
+		"path":           3,
+		"clipPath":       1,
+		"linearGradient": 1,
+		"stop":           2,
+		"g":              1,
+		"image":          1,
+	}
+	for name, n := range exp {
+		if got := elems[name]; got != n {
+			t.Errorf("got %d %s elements, expected %d", got, name, n)
+		}
+	}
+}
+
+func TestSVGPath(t *testing.T) {
+	var ops op.Ops
+	op.TransformOp{}.Offset(f32.Pt(10, 20)).Add(&ops)
+	var p clip.Path
+	p.Begin(&ops)
+	p.Line(f32.Pt(10, 0))
+	p.Quad(f32.Pt(0, 5), f32.Pt(-10, 10))
+	p.End().Add(&ops)
+	paint.PaintOp{Rect: f32.Rect(0, 0, 10, 10)}.Add(&ops)
+	var buf bytes.Buffer
+	if err := SVG(&buf, image.Pt(100, 100), &ops); err != nil {
+		t.Fatal(err)
+	}
+	if exp := `<path d="M10 20L20 20Q20 25 10 30Z"/>`; !strings.Contains(buf.String(), exp) {
+		t.Errorf("clip path %q not found in\n%s", exp, buf.String())
+	}
+}
+
+func TestPDF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := PDF(&buf, image.Pt(200, 200), testFrame()); err != nil {
+		t.Fatal(err)
+	}
+	doc := buf.Bytes()
+	if !bytes.HasPrefix(doc, []byte("%PDF-")) {
+		t.Fatal("missing PDF header")
+	}
+	// Verify the cross-reference table.
+	m := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(doc)
+	if m == nil {
+		t.Fatal("missing startxref")
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	var n int
+	if _, err := fmt.Sscanf(string(doc[xref:]), "xref\n0 %d\n", &n); err != nil {
+		t.Fatal(err)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(doc[xref:], -1)
+	if len(entries) != n-1 {
+		t.Fatalf("got %d xref entries, expected %d", len(entries), n-1)
+	}
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		if exp := fmt.Sprintf("%d 0 obj\n", i+1); !bytes.HasPrefix(doc[off:], []byte(exp)) {
+			t.Errorf("xref entry %d doesn't point to object %d", i, i+1)
+		}
+	}
+	for _, exp := range []string{"/ShadingType 2", "/Subtype /Form", "/Subtype /Image", "/SMask"} {
+		if !bytes.Contains(doc, []byte(exp)) {
+			t.Errorf("%s not found", exp)
+		}
+	}
+}
@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package export
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"strings"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+)
+
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+	size    image.Point
+	// resources is the object number of the resource dictionary
+	// shared by the page and its forms.
+	resources int
+	// alphas are the constant alpha values of the ExtGState
+	// resources.
+	alphas     []float32
+	extGStates map[float32]string
+	xobjects   []pdfResource
+	shadings   []pdfResource
+	images     map[imageKey]string
+}
+
+type pdfResource struct {
+	name string
+	obj  int
+}
+
+type imageKey struct {
+	src  *image.RGBA
+	rect image.Rectangle
+}
+
+// PDF writes the drawing operations of frame as a single page PDF
+// document with a page of the given size.
+//
+// Gradients are interpolated in sRGB space and the alpha of their
+// stops is ignored.
+func PDF(w io.Writer, size image.Point, frame *op.Ops) error {
+	p := &pdfWriter{
+		size:       size,
+		extGStates: make(map[float32]string),
+		images:     make(map[imageKey]string),
+	}
+	p.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	catalog, pages, page := p.alloc(), p.alloc(), p.alloc()
+	p.resources = p.alloc()
+	var content bytes.Buffer
+	// Flip the y axis to match the frame coordinates.
+	fmt.Fprintf(&content, "1 0 0 -1 0 %d cm\n", size.Y)
+	if err := p.writeItems(&content, collect(frame)); err != nil {
+		return err
+	}
+	contents := p.alloc()
+	if err := p.writeStream(contents, "", content.Bytes()); err != nil {
+		return err
+	}
+	p.writeObject(page, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources %d 0 R /Contents %d 0 R /Group << /S /Transparency /CS /DeviceRGB >> >>",
+		pages, size.X, size.Y, p.resources, contents))
+	p.writeObject(pages, fmt.Sprintf("<< /Type /Pages /Kids [%d 0 R] /Count 1 >>", page))
+	p.writeObject(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pages))
+	p.writeResources()
+	p.writeTrailer(catalog)
+	_, err := w.Write(p.buf.Bytes())
+	return err
+}
+
+func (p *pdfWriter) writeItems(c *bytes.Buffer, items []item) error {
+	for _, it := range items {
+		if l := it.layer; l != nil {
+			// Draw layers as transparency groups.
+			var content bytes.Buffer
+			if err := p.writeItems(&content, l.items); err != nil {
+				return err
+			}
+			form := p.alloc()
+			dict := fmt.Sprintf("/Type /XObject /Subtype /Form /BBox [0 0 %d %d] /Group << /S /Transparency >> /Resources %d 0 R",
+				p.size.X, p.size.Y, p.resources)
+			if err := p.writeStream(form, dict, content.Bytes()); err != nil {
+				return err
+			}
+			name := p.addXObject("Fm", form)
+			fmt.Fprintf(c, "q /%s gs /%s Do Q\n", p.extGState(l.opacity), name)
+			continue
+		}
+		c.WriteString("q\n")
+		for clip := it.clip; clip != nil; clip = clip.parent {
+			writePDFPath(c, clip.path)
+			c.WriteString("W n\n")
+		}
+		b := it.brush
+		switch b.typ {
+		case brushColor:
+			col := unpremultiply(b.color)
+			if col.A != 0xff {
+				fmt.Fprintf(c, "/%s gs\n", p.extGState(float32(col.A)/0xff))
+			}
+			fmt.Fprintf(c, "%s %s %s rg\n", ftoa(float32(col.R)/0xff), ftoa(float32(col.G)/0xff), ftoa(float32(col.B)/0xff))
+			writePDFPath(c, it.shape)
+			c.WriteString("f\n")
+		case brushGradient:
+			writePDFPath(c, it.shape)
+			c.WriteString("W n\n")
+			fmt.Fprintf(c, "%s cm /%s sh\n", pdfMatrix(b.t), p.addShading(b.gradient))
+		case brushImage:
+			name, err := p.addImage(b.image)
+			if err != nil {
+				return err
+			}
+			// Map the unit square to the destination rectangle,
+			// with the first image row at the top.
+			dst := b.image.dst
+			fmt.Fprintf(c, "%s cm %s cm /%s Do\n", pdfMatrix(b.t),
+				pdfMatrix(f32.NewAffine2D(dst.Dx(), 0, dst.Min.X, 0, -dst.Dy(), dst.Max.Y)), name)
+		}
+		c.WriteString("Q\n")
+	}
+	return nil
+}
+
+// extGState returns the name of a graphics state with the
+// given constant alpha.
+func (p *pdfWriter) extGState(alpha float32) string {
+	name, ok := p.extGStates[alpha]
+	if !ok {
+		name = fmt.Sprintf("GS%d", len(p.alphas))
+		p.extGStates[alpha] = name
+		p.alphas = append(p.alphas, alpha)
+	}
+	return name
+}
+
+func (p *pdfWriter) addXObject(prefix string, obj int) string {
+	name := fmt.Sprintf("%s%d", prefix, len(p.xobjects))
+	p.xobjects = append(p.xobjects, pdfResource{name: name, obj: obj})
+	return name
+}
+
+func (p *pdfWriter) addImage(img imageBrush) (string, error) {
+	key := imageKey{src: img.src, rect: img.rect}
+	if name, ok := p.images[key]; ok {
+		return name, nil
+	}
+	r := img.rect.Intersect(img.src.Bounds())
+	w, h := r.Dx(), r.Dy()
+	rgb := make([]byte, 0, w*h*3)
+	alpha := make([]byte, 0, w*h)
+	opaque := true
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			c := unpremultiply(img.src.RGBAAt(x, y))
+			rgb = append(rgb, c.R, c.G, c.B)
+			alpha = append(alpha, c.A)
+			opaque = opaque && c.A == 0xff
+		}
+	}
+	dict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8", w, h)
+	if !opaque {
+		mask := p.alloc()
+		maskDict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8", w, h)
+		if err := p.writeStream(mask, maskDict, alpha); err != nil {
+			return "", err
+		}
+		dict += fmt.Sprintf(" /SMask %d 0 R", mask)
+	}
+	obj := p.alloc()
+	if err := p.writeStream(obj, dict, rgb); err != nil {
+		return "", err
+	}
+	name := p.addXObject("Im", obj)
+	p.images[key] = name
+	return name, nil
+}
+
+// addShading adds a shading dictionary for a gradient and returns
+// its name.
+func (p *pdfWriter) addShading(g gradient) string {
+	stops := g.stops
+	if len(stops) == 0 {
+		return p.addShadingDict("<< /ShadingType 2 /ColorSpace /DeviceRGB /Coords [0 0 1 0] /Function << /FunctionType 2 /Domain [0 1] /C0 [0 0 0] /C1 [0 0 0] /N 1 >> >>")
+	}
+	// Offsets must be strictly increasing.
+	const eps = 1e-4
+	offsets := make([]float32, len(stops))
+	for i, s := range stops {
+		offsets[i] = s.Offset
+		if i > 0 && offsets[i] < offsets[i-1]+eps {
+			offsets[i] = offsets[i-1] + eps
+		}
+	}
+	if len(stops) == 1 {
+		stops = append(stops, stops[0])
+		offsets = append(offsets, offsets[0]+eps)
+	}
+	t0, t1 := offsets[0], offsets[len(offsets)-1]
+	var fn strings.Builder
+	fmt.Fprintf(&fn, "<< /FunctionType 3 /Domain [%s %s] /Functions [", ftoa(t0), ftoa(t1))
+	for i := 0; i < len(stops)-1; i++ {
+		fmt.Fprintf(&fn, "<< /FunctionType 2 /Domain [0 1] /C0 [%s] /C1 [%s] /N 1 >> ", pdfColor(stops[i].Color), pdfColor(stops[i+1].Color))
+	}
+	fn.WriteString("] /Bounds [")
+	for _, o := range offsets[1 : len(offsets)-1] {
+		fmt.Fprintf(&fn, "%s ", ftoa(o))
+	}
+	fn.WriteString("] /Encode [")
+	for i := 0; i < len(stops)-1; i++ {
+		fn.WriteString("0 1 ")
+	}
+	fn.WriteString("] >>")
+	var coords string
+	if g.radial {
+		c, r := g.p0, g.p1.X
+		coords = fmt.Sprintf("/ShadingType 3 /Coords [%s %s %s %s %s %s]",
+			ftoa(c.X), ftoa(c.Y), ftoa(t0*r), ftoa(c.X), ftoa(c.Y), ftoa(t1*r))
+	} else {
+		d := g.p1.Sub(g.p0)
+		start, end := g.p0.Add(d.Mul(t0)), g.p0.Add(d.Mul(t1))
+		coords = fmt.Sprintf("/ShadingType 2 /Coords [%s %s %s %s]",
+			ftoa(start.X), ftoa(start.Y), ftoa(end.X), ftoa(end.Y))
+	}
+	return p.addShadingDict(fmt.Sprintf("<< %s /ColorSpace /DeviceRGB /Domain [%s %s] /Function %s /Extend [true true] >>",
+		coords, ftoa(t0), ftoa(t1), fn.String()))
+}
+
+func (p *pdfWriter) addShadingDict(dict string) string {
+	obj := p.alloc()
+	p.writeObject(obj, dict)
+	name := fmt.Sprintf("Sh%d", len(p.shadings))
+	p.shadings = append(p.shadings, pdfResource{name: name, obj: obj})
+	return name
+}
+
+func (p *pdfWriter) writeResources() {
+	var b strings.Builder
+	b.WriteString("<< /ExtGState <<")
+	for _, alpha := range p.alphas {
+		fmt.Fprintf(&b, " /%s << /ca %s /CA %s >>", p.extGStates[alpha], ftoa(alpha), ftoa(alpha))
+	}
+	b.WriteString(" >> /XObject <<")
+	for _, r := range p.xobjects {
+		fmt.Fprintf(&b, " /%s %d 0 R", r.name, r.obj)
+	}
+	b.WriteString(" >> /Shading <<")
+	for _, r := range p.shadings {
+		fmt.Fprintf(&b, " /%s %d 0 R", r.name, r.obj)
+	}
+	b.WriteString(" >> >>")
+	p.writeObject(p.resources, b.String())
+}
+
+// alloc allocates an object number.
+func (p *pdfWriter) alloc() int {
+	p.offsets = append(p.offsets, -1)
+	return len(p.offsets)
+}
+
+func (p *pdfWriter) writeObject(obj int, body string) {
+	p.offsets[obj-1] = p.buf.Len()
+	fmt.Fprintf(&p.buf, "%d 0 obj\n%s\nendobj\n", obj, body)
+}
+
+// writeStream writes a compressed stream object with the entries
+// of dict added to its stream dictionary.
+func (p *pdfWriter) writeStream(obj int, dict string, data []byte) error {
+	var z bytes.Buffer
+	zw := zlib.NewWriter(&z)
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	if dict != "" {
+		dict += " "
+	}
+	p.offsets[obj-1] = p.buf.Len()
+	fmt.Fprintf(&p.buf, "%d 0 obj\n<< %s/Filter /FlateDecode /Length %d >>\nstream\n", obj, dict, z.Len())
+	p.buf.Write(z.Bytes())
+	p.buf.WriteString("\nendstream\nendobj\n")
+	return nil
+}
+
+func (p *pdfWriter) writeTrailer(root int) {
+	xref := p.buf.Len()
+	fmt.Fprintf(&p.buf, "xref\n0 %d\n0000000000 65535 f \n", len(p.offsets)+1)
+	for _, off := range p.offsets {
+		fmt.Fprintf(&p.buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&p.buf, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(p.offsets)+1, root, xref)
+}
+
+// writePDFPath writes the path construction operators for p. PDF
+// only supports cubic Bézier curves, so quadratic segments are
+// converted.
+func writePDFPath(c *bytes.Buffer, p path) {
+	if len(p) == 0 {
+		c.WriteString("0 0 0 0 re\n")
+		return
+	}
+	for _, con := range p {
+		for i, q := range con {
+			if i == 0 {
+				fmt.Fprintf(c, "%s %s m\n", ftoa(q.From.X), ftoa(q.From.Y))
+			}
+			if isLine(q) {
+				fmt.Fprintf(c, "%s %s l\n", ftoa(q.To.X), ftoa(q.To.Y))
+				continue
+			}
+			c1 := q.From.Add(q.Ctrl.Sub(q.From).Mul(2.0 / 3))
+			c2 := q.To.Add(q.Ctrl.Sub(q.To).Mul(2.0 / 3))
+			fmt.Fprintf(c, "%s %s %s %s %s %s c\n", ftoa(c1.X), ftoa(c1.Y), ftoa(c2.X), ftoa(c2.Y), ftoa(q.To.X), ftoa(q.To.Y))
+		}
+		c.WriteString("h\n")
+	}
+}
+
+func pdfMatrix(t f32.Affine2D) string {
+	sx, hx, ox, hy, sy, oy := t.Elems()
+	return fmt.Sprintf("%s %s %s %s %s %s", ftoa(sx), ftoa(hy), ftoa(hx), ftoa(sy), ftoa(ox), ftoa(oy))
+}
+
+func pdfColor(c color.RGBA) string {
+	n := unpremultiply(c)
+	return fmt.Sprintf("%s %s %s", ftoa(float32(n.R)/0xff), ftoa(float32(n.G)/0xff), ftoa(float32(n.B)/0xff))
+}
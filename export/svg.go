@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+)
+
+type svgWriter struct {
+	w     *bufio.Writer
+	clips map[*clipPath]string
+	nids  int
+	err   error
+}
+
+// SVG writes the drawing operations of frame as an SVG image
+// of the given size.
+//
+// Gradients are interpolated in linear RGB space as they are by
+// package gpu.
+func SVG(w io.Writer, size image.Point, frame *op.Ops) error {
+	s := &svgWriter{
+		w:     bufio.NewWriter(w),
+		clips: make(map[*clipPath]string),
+	}
+	s.printf("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	s.printf("<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" version=\"1.1\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n",
+		size.X, size.Y, size.X, size.Y)
+	s.writeItems(collect(frame))
+	s.printf("</svg>\n")
+	if s.err != nil {
+		return s.err
+	}
+	return s.w.Flush()
+}
+
+func (s *svgWriter) writeItems(items []item) {
+	for _, it := range items {
+		if l := it.layer; l != nil {
+			s.printf("<g opacity=\"%s\">\n", ftoa(l.opacity))
+			s.writeItems(l.items)
+			s.printf("</g>\n")
+			continue
+		}
+		clip := s.clipAttr(it.clip)
+		b := it.brush
+		switch b.typ {
+		case brushColor:
+			c := unpremultiply(b.color)
+			s.printf("<path%s d=\"%s\" fill=\"%s\"%s/>\n", clip, svgPath(it.shape), svgColor(c), svgOpacity("fill-opacity", c.A))
+		case brushGradient:
+			id := s.writeGradient(b.gradient, b.t)
+			s.printf("<path%s d=\"%s\" fill=\"url(#%s)\"/>\n", clip, svgPath(it.shape), id)
+		case brushImage:
+			s.writeImage(clip, b)
+		}
+	}
+}
+
+// clipAttr returns the clip-path attribute for clip, writing its
+// clipPath elements if necessary.
+func (s *svgWriter) clipAttr(clip *clipPath) string {
+	if clip == nil {
+		return ""
+	}
+	id, ok := s.clips[clip]
+	if !ok {
+		parent := s.clipAttr(clip.parent)
+		id = s.newID("c")
+		s.clips[clip] = id
+		s.printf("<clipPath id=\"%s\"%s><path d=\"%s\"/></clipPath>\n", id, parent, svgPath(clip.path))
+	}
+	return fmt.Sprintf(" clip-path=\"url(#%s)\"", id)
+}
+
+func (s *svgWriter) writeGradient(g gradient, t f32.Affine2D) string {
+	id := s.newID("g")
+	if g.radial {
+		s.printf("<radialGradient id=\"%s\" gradientUnits=\"userSpaceOnUse\" cx=\"%s\" cy=\"%s\" r=\"%s\"",
+			id, ftoa(g.p0.X), ftoa(g.p0.Y), ftoa(g.p1.X))
+	} else {
+		s.printf("<linearGradient id=\"%s\" gradientUnits=\"userSpaceOnUse\" x1=\"%s\" y1=\"%s\" x2=\"%s\" y2=\"%s\"",
+			id, ftoa(g.p0.X), ftoa(g.p0.Y), ftoa(g.p1.X), ftoa(g.p1.Y))
+	}
+	s.printf(" gradientTransform=\"%s\" color-interpolation=\"linearRGB\">\n", svgMatrix(t))
+	for _, st := range g.stops {
+		c := unpremultiply(st.Color)
+		s.printf("<stop offset=\"%s\" stop-color=\"%s\"%s/>\n", ftoa(st.Offset), svgColor(c), svgOpacity("stop-opacity", c.A))
+	}
+	if g.radial {
+		s.printf("</radialGradient>\n")
+	} else {
+		s.printf("</linearGradient>\n")
+	}
+	return id
+}
+
+func (s *svgWriter) writeImage(clip string, b brush) {
+	img := b.image
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img.src.SubImage(img.rect)); err != nil {
+		s.setErr(err)
+		return
+	}
+	if clip != "" {
+		// Apply the clip outside the image transformation.
+		s.printf("<g%s>\n", clip)
+	}
+	dst := img.dst
+	s.printf("<image transform=\"%s\" x=\"%s\" y=\"%s\" width=\"%s\" height=\"%s\" preserveAspectRatio=\"none\" xlink:href=\"data:image/png;base64,%s\"/>\n",
+		svgMatrix(b.t), ftoa(dst.Min.X), ftoa(dst.Min.Y), ftoa(dst.Dx()), ftoa(dst.Dy()),
+		base64.StdEncoding.EncodeToString(buf.Bytes()))
+	if clip != "" {
+		s.printf("</g>\n")
+	}
+}
+
+func (s *svgWriter) newID(prefix string) string {
+	s.nids++
+	return fmt.Sprintf("%s%d", prefix, s.nids)
+}
+
+func (s *svgWriter) printf(format string, args ...interface{}) {
+	if s.err != nil {
+		return
+	}
+	_, err := fmt.Fprintf(s.w, format, args...)
+	s.setErr(err)
+}
+
+func (s *svgWriter) setErr(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// svgPath formats p as SVG path data.
+func svgPath(p path) string {
+	var b strings.Builder
+	for _, c := range p {
+		for i, q := range c {
+			if i == 0 {
+				fmt.Fprintf(&b, "M%s %s", ftoa(q.From.X), ftoa(q.From.Y))
+			}
+			if isLine(q) {
+				fmt.Fprintf(&b, "L%s %s", ftoa(q.To.X), ftoa(q.To.Y))
+			} else {
+				fmt.Fprintf(&b, "Q%s %s %s %s", ftoa(q.Ctrl.X), ftoa(q.Ctrl.Y), ftoa(q.To.X), ftoa(q.To.Y))
+			}
+		}
+		b.WriteString("Z")
+	}
+	return b.String()
+}
+
+func svgMatrix(t f32.Affine2D) string {
+	sx, hx, ox, hy, sy, oy := t.Elems()
+	return fmt.Sprintf("matrix(%s %s %s %s %s %s)", ftoa(sx), ftoa(hy), ftoa(hx), ftoa(sy), ftoa(ox), ftoa(oy))
+}
+
+func svgColor(c color.NRGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// svgOpacity returns the opacity attribute for alpha values less than
+// 0xff.
+func svgOpacity(attr string, alpha uint8) string {
+	if alpha == 0xff {
+		return ""
+	}
+	return fmt.Sprintf(" %s=\"%s\"", attr, ftoa(float32(alpha)/0xff))
+}
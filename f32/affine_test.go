@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package f32
+
+import (
+	"math"
+	"testing"
+)
+
+func eq(p1, p2 Point) bool {
+	tol := 1e-5
+	dx, dy := p2.X-p1.X, p2.Y-p1.Y
+	return math.Abs(math.Sqrt(float64(dx*dx+dy*dy))) < tol
+}
+
+func eqAffine(a, b Affine2D) bool {
+	tol := 1e-5
+	sa, ha, oxa, hya, sya, oya := a.Elems()
+	sb, hb, oxb, hyb, syb, oyb := b.Elems()
+	for _, d := range []float32{sa - sb, ha - hb, oxa - oxb, hya - hyb, sya - syb, oya - oyb} {
+		if math.Abs(float64(d)) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTransformOffset(t *testing.T) {
+	p := Point{X: 1, Y: 2}
+	o := Point{X: 2, Y: -3}
+
+	r := Affine2D{}.Offset(o).Transform(p)
+	if !eq(r, Pt(3, -1)) {
+		t.Errorf("offset transformation mismatch: have %v, want {3 -1}", r)
+	}
+	i := Affine2D{}.Offset(o).Invert().Transform(r)
+	if !eq(i, p) {
+		t.Errorf("offset transformation inverse mismatch: have %v, want %v", i, p)
+	}
+}
+
+func TestTransformScale(t *testing.T) {
+	p := Point{X: 1, Y: 2}
+	s := Point{X: -1, Y: 2}
+
+	r := Affine2D{}.Scale(Point{}, s).Transform(p)
+	if !eq(r, Pt(-1, 4)) {
+		t.Errorf("scale transformation mismatch: have %v, want {-1 4}", r)
+	}
+	i := Affine2D{}.Scale(Point{}, s).Invert().Transform(r)
+	if !eq(i, p) {
+		t.Errorf("scale transformation inverse mismatch: have %v, want %v", i, p)
+	}
+}
+
+func TestTransformRotate(t *testing.T) {
+	p := Point{X: 1, Y: 0}
+	a := float32(math.Pi / 2)
+
+	r := Affine2D{}.Rotate(Point{}, a).Transform(p)
+	if !eq(r, Pt(0, 1)) {
+		t.Errorf("rotate transformation mismatch: have %v, want {0 1}", r)
+	}
+	i := Affine2D{}.Rotate(Point{}, a).Invert().Transform(r)
+	if !eq(i, p) {
+		t.Errorf("rotate transformation inverse mismatch: have %v, want %v", i, p)
+	}
+}
+
+func TestTransformShear(t *testing.T) {
+	p := Point{X: 1, Y: 1}
+
+	r := Affine2D{}.Shear(Point{}, math.Pi/4, 0).Transform(p)
+	if !eq(r, Pt(2, 1)) {
+		t.Errorf("shear transformation mismatch: have %v, want {2 1}", r)
+	}
+	i := Affine2D{}.Shear(Point{}, math.Pi/4, 0).Invert().Transform(r)
+	if !eq(i, p) {
+		t.Errorf("shear transformation inverse mismatch: have %v, want %v", i, p)
+	}
+}
+
+func TestTransformOrigin(t *testing.T) {
+	p := Point{X: 2, Y: 1}
+	origin := Point{X: 1, Y: 1}
+
+	r := Affine2D{}.Rotate(origin, math.Pi/2).Transform(p)
+	if !eq(r, Pt(1, 2)) {
+		t.Errorf("rotate around origin mismatch: have %v, want {1 2}", r)
+	}
+	r = Affine2D{}.Scale(origin, Pt(2, 3)).Transform(p)
+	if !eq(r, Pt(3, 1)) {
+		t.Errorf("scale around origin mismatch: have %v, want {3 1}", r)
+	}
+}
+
+func TestTransformMultiply(t *testing.T) {
+	p := Point{X: 1, Y: 2}
+	a := Affine2D{}.Rotate(Point{}, 1).Offset(Pt(3, 4))
+	b := Affine2D{}.Scale(Point{}, Pt(2, 3)).Shear(Point{}, .5, .2)
+
+	want := a.Transform(b.Transform(p))
+	if r := a.Mul(b).Transform(p); !eq(r, want) {
+		t.Errorf("multiplication mismatch: have %v, want %v", r, want)
+	}
+	if m := a.Mul(a.Invert()); !eqAffine(m, Affine2D{}) {
+		t.Errorf("multiplication by inverse is not identity: %v", m)
+	}
+}
+
+func TestTransformElems(t *testing.T) {
+	a := NewAffine2D(1, 2, 3, 4, 5, 6)
+	sx, hx, ox, hy, sy, oy := a.Elems()
+	if sx != 1 || hx != 2 || ox != 3 || hy != 4 || sy != 5 || oy != 6 {
+		t.Errorf("elements mismatch: have %v", a)
+	}
+	if !eqAffine(NewAffine2D(1, 0, 0, 0, 1, 0), Affine2D{}) {
+		t.Error("identity matrix is not the zero Affine2D")
+	}
+}
@@ -24,13 +24,24 @@ func Default() *text.FontRegistry {
 	return shaper
 }
 
-// Register a face. Register panics if Default has been
-// called.
-func Register(font text.Font, face text.Face) {
+// Register a face and the faces to use for runes missing from it.
+// Register panics if Default has been called.
+func Register(font text.Font, face text.Face, fallbacks ...text.FallbackFace) {
 	mu.Lock()
 	defer mu.Unlock()
 	if initialized {
 		panic("Register must be called before Default")
 	}
-	shaper.Register(font, face)
+	shaper.Register(font, face, fallbacks...)
+}
+
+// SetLoader sets the loader of faces for fonts that are not registered.
+// SetLoader panics if Default has been called.
+func SetLoader(l text.FaceLoader) {
+	mu.Lock()
+	defer mu.Unlock()
+	if initialized {
+		panic("SetLoader must be called before Default")
+	}
+	shaper.SetLoader(l)
 }
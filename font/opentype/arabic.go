@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"sort"
+	"unicode"
+)
+
+// joining is the Arabic joining type of a rune.
+type joining uint8
+
+const (
+	joinNone joining = iota
+	joinRight
+	joinDual
+	joinCausing
+	joinTransparent
+)
+
+// joiningRange is a range of runes with the same joining type.
+type joiningRange struct {
+	lo, hi rune
+	typ    joining
+}
+
+// joiningRanges are the joining types of the Arabic letters, from
+// ArabicShaping.txt of the Unicode Character Database.
+var joiningRanges = []joiningRange{
+	{0x0620, 0x0620, joinDual},
+	{0x0622, 0x0625, joinRight},
+	{0x0626, 0x0626, joinDual},
+	{0x0627, 0x0627, joinRight},
+	{0x0628, 0x0628, joinDual},
+	{0x0629, 0x0629, joinRight},
+	{0x062a, 0x062e, joinDual},
+	{0x062f, 0x0632, joinRight},
+	{0x0633, 0x063f, joinDual},
+	{0x0640, 0x0640, joinCausing},
+	{0x0641, 0x0647, joinDual},
+	{0x0648, 0x0648, joinRight},
+	{0x0649, 0x064a, joinDual},
+	{0x066e, 0x066f, joinDual},
+	{0x0671, 0x0673, joinRight},
+	{0x0675, 0x0677, joinRight},
+	{0x0678, 0x0687, joinDual},
+	{0x0688, 0x0699, joinRight},
+	{0x069a, 0x06bf, joinDual},
+	{0x06c0, 0x06c0, joinRight},
+	{0x06c1, 0x06c2, joinDual},
+	{0x06c3, 0x06cb, joinRight},
+	{0x06cc, 0x06cc, joinDual},
+	{0x06cd, 0x06cd, joinRight},
+	{0x06ce, 0x06ce, joinDual},
+	{0x06cf, 0x06cf, joinRight},
+	{0x06d0, 0x06d1, joinDual},
+	{0x06d2, 0x06d3, joinRight},
+	{0x06d5, 0x06d5, joinRight},
+	{0x06ee, 0x06ef, joinRight},
+	{0x06fa, 0x06fc, joinDual},
+	{0x06ff, 0x06ff, joinDual},
+	{0x0750, 0x0758, joinDual},
+	{0x0759, 0x075b, joinRight},
+	{0x075c, 0x076a, joinDual},
+	{0x076b, 0x076c, joinRight},
+	{0x076d, 0x0770, joinDual},
+	{0x0771, 0x0771, joinRight},
+	{0x0772, 0x0772, joinDual},
+	{0x0773, 0x0774, joinRight},
+	{0x0775, 0x0777, joinDual},
+	{0x0778, 0x0779, joinRight},
+	{0x077a, 0x077f, joinDual},
+	{0x08a0, 0x08a9, joinDual},
+	{0x08aa, 0x08ac, joinRight},
+	{0x08ae, 0x08ae, joinRight},
+	{0x08af, 0x08b0, joinDual},
+	{0x08b1, 0x08b2, joinRight},
+	{0x08b3, 0x08b8, joinDual},
+	{0x08b9, 0x08b9, joinRight},
+	{0x08ba, 0x08bd, joinDual},
+	{0x200d, 0x200d, joinCausing},
+}
+
+// Feature masks of the Arabic positional forms.
+const (
+	maskIsol uint32 = 1 << (iota + 1)
+	maskFina
+	maskMedi
+	maskInit
+)
+
+func joiningType(r rune) joining {
+	i := sort.Search(len(joiningRanges), func(i int) bool {
+		return joiningRanges[i].hi >= r
+	})
+	if i < len(joiningRanges) && joiningRanges[i].lo <= r {
+		return joiningRanges[i].typ
+	}
+	if r != 0x200c && (unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf)) {
+		return joinTransparent
+	}
+	return joinNone
+}
+
+// arabicForms selects the positional forms of the glyphs of an Arabic
+// run, where glyph i is the glyph of rune i.
+func arabicForms(runes []rune, glyphs []glyphInfo) {
+	// prev is the index of the previous non-transparent rune, or
+	// -1.
+	prev := -1
+	for i, r := range runes {
+		t := joiningType(r)
+		if t == joinTransparent {
+			continue
+		}
+		glyphs[i].mask |= maskIsol
+		if prev != -1 {
+			pt := joiningType(runes[prev])
+			joinsPrev := (pt == joinDual || pt == joinCausing) && t != joinNone
+			if joinsPrev {
+				// The previous rune joins its successor.
+				if glyphs[prev].mask&maskIsol != 0 {
+					glyphs[prev].mask = glyphs[prev].mask&^maskIsol | maskInit
+				} else {
+					glyphs[prev].mask = glyphs[prev].mask&^maskFina | maskMedi
+				}
+				glyphs[i].mask = glyphs[i].mask&^maskIsol | maskFina
+			}
+		}
+		prev = i
+	}
+}
@@ -0,0 +1,402 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"sort"
+	"sync"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// colorLayer is a layer of a COLR color glyph, drawn in a color of
+// the CPAL palette.
+type colorLayer struct {
+	glyph   sfnt.GlyphIndex
+	palette uint16
+}
+
+// foregroundColor is the palette index of layers drawn with the
+// current brush.
+const foregroundColor = 0xffff
+
+// bitmap is the image data of a color bitmap glyph.
+type bitmap struct {
+	// data is the PNG image.
+	data []byte
+	// origin is the position of the top left corner of the image
+	// relative to the glyph origin, or of the bottom left corner if
+	// bottomUp is set. Positive Y is down.
+	origin   image.Point
+	bottomUp bool
+	// ppem is the size of the strike of the bitmap.
+	ppem int
+}
+
+// bitmapKey identifies the image of a glyph in a strike.
+type bitmapKey struct {
+	glyph sfnt.GlyphIndex
+	ppem  int
+}
+
+// glyphCache caches the color bitmap glyphs of a font. It is shared by
+// the hinted versions and instances of the font, which may be used
+// from several goroutines.
+type glyphCache struct {
+	mu sync.Mutex
+	// bitmaps records whether glyphs have a bitmap at a size, to
+	// avoid searching the strikes for every glyph shaped.
+	bitmaps map[bitmapKey]bool
+	// images are the decoded bitmap glyphs, by strike.
+	images map[bitmapKey]*glyphImage
+}
+
+// glyphImage is a decoded bitmap glyph.
+type glyphImage struct {
+	img paint.ImageOp
+	// rect is the area of the image relative to the glyph origin,
+	// in pixels of its strike.
+	rect image.Rectangle
+	ppem int
+}
+
+// paintColorGlyphs adds the operations for drawing the color glyphs of
+// str to ops, with the start of the text at off.
+func paintColorGlyphs(ops *op.Ops, buf *sfnt.Buffer, ppem fixed.Int26_6, f *opentype, off f32.Point, str []text.Glyph) {
+	if !f.Tables.hasColorGlyphs() {
+		return
+	}
+	order := text.VisualOrder(str)
+	var x fixed.Int26_6
+	for i := range str {
+		g := str[i]
+		if order != nil {
+			g = str[order[i]]
+		}
+		pos := f32.Point{
+			X: off.X + float32(x+g.Offset.X)/64,
+			Y: off.Y + float32(g.Offset.Y)/64,
+		}
+		x += g.Advance
+		id := sfnt.GlyphIndex(g.ID)
+		if layers := f.Tables.colorLayers(id); layers != nil {
+			for _, l := range layers {
+				paintLayer(ops, buf, ppem, f, pos, l)
+			}
+			continue
+		}
+		if img := f.image(id, ppem); img != nil {
+			// Scale the strike to the text size.
+			scale := float32(ppem) / 64 / float32(img.ppem)
+			r := img.rect
+			stack := op.Push(ops)
+			img.img.Add(ops)
+			paint.PaintOp{Rect: f32.Rectangle{
+				Min: pos.Add(f32.Point{X: float32(r.Min.X) * scale, Y: float32(r.Min.Y) * scale}),
+				Max: pos.Add(f32.Point{X: float32(r.Max.X) * scale, Y: float32(r.Max.Y) * scale}),
+			}}.Add(ops)
+			stack.Pop()
+		}
+	}
+}
+
+// paintLayer draws a layer of a COLR glyph with its origin at pos.
+func paintLayer(ops *op.Ops, buf *sfnt.Buffer, ppem fixed.Int26_6, f *opentype, pos f32.Point, l colorLayer) {
+	var col color.RGBA
+	if l.palette != foregroundColor {
+		c, ok := f.Tables.paletteColor(l.palette)
+		if !ok {
+			return
+		}
+		col = c
+	}
+	segs, ok := f.LoadGlyph(buf, ppem, l.glyph)
+	if !ok || len(segs) == 0 {
+		return
+	}
+	stack := op.Push(ops)
+	if l.palette != foregroundColor {
+		paint.ColorOp{Color: col}.Add(ops)
+	}
+	var p clip.Path
+	p.Begin(ops)
+	bounds := outlineGlyph(&p, segs, pos)
+	p.End().Add(ops)
+	paint.PaintOp{Rect: bounds}.Add(ops)
+	stack.Pop()
+}
+
+// isColorGlyph reports whether g is drawn as a color glyph at the
+// size ppem.
+func (f *opentype) isColorGlyph(ppem fixed.Int26_6, g sfnt.GlyphIndex) bool {
+	if !f.Tables.hasColorGlyphs() {
+		return false
+	}
+	if f.Tables.colorLayers(g) != nil {
+		return true
+	}
+	c := f.Cache
+	key := bitmapKey{glyph: g, ppem: ppem.Round()}
+	c.mu.Lock()
+	ok, found := c.bitmaps[key]
+	c.mu.Unlock()
+	if !found {
+		_, ok = f.Tables.bitmap(g, key.ppem, f.Font.NumGlyphs())
+		c.mu.Lock()
+		c.bitmaps[key] = ok
+		c.mu.Unlock()
+	}
+	return ok
+}
+
+// image returns the decoded bitmap of g at the size ppem, or nil if g
+// has no bitmap or it fails to decode.
+func (f *opentype) image(g sfnt.GlyphIndex, ppem fixed.Int26_6) *glyphImage {
+	b, ok := f.Tables.bitmap(g, ppem.Round(), f.Font.NumGlyphs())
+	if !ok {
+		return nil
+	}
+	c := f.Cache
+	key := bitmapKey{glyph: g, ppem: b.ppem}
+	c.mu.Lock()
+	img, ok := c.images[key]
+	c.mu.Unlock()
+	if ok {
+		return img
+	}
+	if src, err := png.Decode(bytes.NewReader(b.data)); err == nil {
+		sz := src.Bounds().Size()
+		min := b.origin
+		if b.bottomUp {
+			min.Y -= sz.Y
+		}
+		img = &glyphImage{
+			img:  paint.NewImageOp(src),
+			rect: image.Rectangle{Min: min, Max: min.Add(sz)},
+			ppem: b.ppem,
+		}
+	}
+	c.mu.Lock()
+	c.images[key] = img
+	c.mu.Unlock()
+	return img
+}
+
+func (t *layoutTables) hasColorGlyphs() bool {
+	return t != nil && (t.colr != nil || t.cblc != nil || t.sbix != nil)
+}
+
+// colorLayers returns the layers of the COLR glyph g from bottom to
+// top, or nil if g is not a layered color glyph. Only the version 0
+// layers are supported, not the paint graphs of version 1.
+func (t *layoutTables) colorLayers(g sfnt.GlyphIndex) []colorLayer {
+	colr := t.colr
+	if colr == nil || t.cpal == nil {
+		return nil
+	}
+	n := int(colr.u16(2))
+	bases := colr.at(int(colr.u32(4)))
+	i := sort.Search(n, func(i int) bool {
+		return sfnt.GlyphIndex(bases.u16(6*i)) >= g
+	})
+	if i == n || sfnt.GlyphIndex(bases.u16(6*i)) != g {
+		return nil
+	}
+	first, count := int(bases.u16(6*i+2)), int(bases.u16(6*i+4))
+	if count == 0 || first+count > int(colr.u16(12)) {
+		return nil
+	}
+	recs := colr.at(int(colr.u32(8)))
+	layers := make([]colorLayer, count)
+	for j := range layers {
+		r := 4 * (first + j)
+		layers[j] = colorLayer{
+			glyph:   sfnt.GlyphIndex(recs.u16(r)),
+			palette: recs.u16(r + 2),
+		}
+	}
+	return layers
+}
+
+// paletteColor returns color i of the first CPAL palette.
+func (t *layoutTables) paletteColor(i uint16) (color.RGBA, bool) {
+	cpal := t.cpal
+	if int(i) >= int(cpal.u16(2)) || cpal.u16(4) == 0 {
+		return color.RGBA{}, false
+	}
+	rec := int(cpal.u16(12)) + int(i)
+	if rec >= int(cpal.u16(6)) {
+		return color.RGBA{}, false
+	}
+	c := cpal.slice(int(cpal.u32(8))+4*rec, 4)
+	if c == nil {
+		return color.RGBA{}, false
+	}
+	// Palette colors are stored in BGRA order without
+	// premultiplied alpha.
+	nc := color.NRGBA{B: c[0], G: c[1], R: c[2], A: c[3]}
+	return color.RGBAModel.Convert(nc).(color.RGBA), true
+}
+
+// bitmap returns the color bitmap of g from the CBDT or sbix strike
+// closest to ppem.
+func (t *layoutTables) bitmap(g sfnt.GlyphIndex, ppem, numGlyphs int) (bitmap, bool) {
+	if b, ok := t.cbdtBitmap(g, ppem); ok {
+		return b, true
+	}
+	return t.sbixBitmap(g, ppem, numGlyphs)
+}
+
+// cbdtBitmap returns the bitmap of g from the CBDT table. Only PNG
+// images with their metrics in CBDT are supported.
+func (t *layoutTables) cbdtBitmap(g sfnt.GlyphIndex, ppem int) (bitmap, bool) {
+	cblc := t.cblc
+	if cblc == nil || t.cbdt == nil {
+		return bitmap{}, false
+	}
+	const sizeLen = 48
+	n := int(cblc.u32(4))
+	if max := (len(cblc) - 8) / sizeLen; n > max {
+		n = max
+	}
+	strike := func(i int) table {
+		return cblc.at(8 + sizeLen*i)
+	}
+	i := pickStrike(n, ppem, func(i int) int {
+		s := strike(i)
+		if g < sfnt.GlyphIndex(s.u16(40)) || g > sfnt.GlyphIndex(s.u16(42)) {
+			return 0
+		}
+		return int(s.u8(45))
+	})
+	if i == -1 {
+		return bitmap{}, false
+	}
+	s := strike(i)
+	arr := cblc.at(int(s.u32(0)))
+	nsub := int(s.u32(8))
+	if max := len(arr) / 8; nsub > max {
+		nsub = max
+	}
+	for j := 0; j < nsub; j++ {
+		first, last := sfnt.GlyphIndex(arr.u16(8*j)), sfnt.GlyphIndex(arr.u16(8*j+2))
+		if g < first || g > last {
+			continue
+		}
+		sub := arr.at(int(arr.u32(8*j + 4)))
+		idx := int(g - first)
+		var start, end int
+		switch sub.u16(0) {
+		case 1:
+			start, end = int(sub.u32(8+4*idx)), int(sub.u32(12+4*idx))
+		case 3:
+			start, end = int(sub.u16(8+2*idx)), int(sub.u16(10+2*idx))
+		case 4:
+			ng := int(sub.u32(8))
+			k := sort.Search(ng, func(k int) bool {
+				return sfnt.GlyphIndex(sub.u16(12+4*k)) >= g
+			})
+			if k == ng || sfnt.GlyphIndex(sub.u16(12+4*k)) != g {
+				return bitmap{}, false
+			}
+			start, end = int(sub.u16(14+4*k)), int(sub.u16(18+4*k))
+		default:
+			return bitmap{}, false
+		}
+		data := t.cbdt.slice(int(sub.u32(4))+start, end-start)
+		// Both small and big glyph metrics start with the height,
+		// width and horizontal bearings.
+		b := bitmap{
+			origin: image.Point{X: int(int8(data.u8(2))), Y: -int(int8(data.u8(3)))},
+			ppem:   int(s.u8(45)),
+		}
+		switch sub.u16(2) {
+		case 17:
+			b.data = data.slice(9, int(data.u32(5)))
+		case 18:
+			b.data = data.slice(12, int(data.u32(8)))
+		}
+		return b, b.data != nil
+	}
+	return bitmap{}, false
+}
+
+// sbixBitmap returns the bitmap of g from the sbix table. Only PNG
+// images are supported.
+func (t *layoutTables) sbixBitmap(g sfnt.GlyphIndex, ppem, numGlyphs int) (bitmap, bool) {
+	sbix := t.sbix
+	if sbix == nil || int(g) >= numGlyphs {
+		return bitmap{}, false
+	}
+	n := int(sbix.u32(4))
+	if max := (len(sbix) - 8) / 4; n > max {
+		n = max
+	}
+	strike := func(i int) table {
+		return sbix.at(int(sbix.u32(8 + 4*i)))
+	}
+	glyph := func(s table, g sfnt.GlyphIndex) table {
+		start, end := int(s.u32(4+4*int(g))), int(s.u32(8+4*int(g)))
+		return s.slice(start, end-start)
+	}
+	i := pickStrike(n, ppem, func(i int) int {
+		s := strike(i)
+		if len(glyph(s, g)) == 0 {
+			return 0
+		}
+		return int(s.u16(0))
+	})
+	if i == -1 {
+		return bitmap{}, false
+	}
+	s := strike(i)
+	data := glyph(s, g)
+	if data.tag(4) == "dupe" {
+		// The glyph is drawn as another glyph.
+		dup := sfnt.GlyphIndex(data.u16(8))
+		if int(dup) >= numGlyphs {
+			return bitmap{}, false
+		}
+		data = glyph(s, dup)
+	}
+	if data.tag(4) != "png " {
+		return bitmap{}, false
+	}
+	return bitmap{
+		data:     data.at(8),
+		origin:   image.Point{X: int(data.i16(0)), Y: -int(data.i16(2))},
+		bottomUp: true,
+		ppem:     int(s.u16(0)),
+	}, true
+}
+
+// pickStrike returns the index of the smallest of n strikes not
+// smaller than ppem, or of the largest strike if every strike is
+// smaller. Strikes of size zero are skipped, and pickStrike returns -1
+// if no strike remains.
+func pickStrike(n, ppem int, size func(i int) int) int {
+	best, bestSize := -1, 0
+	for i := 0; i < n; i++ {
+		s := size(i)
+		if s == 0 {
+			continue
+		}
+		switch {
+		case best == -1,
+			bestSize < ppem && s > bestSize,
+			s >= ppem && s < bestSize:
+			best, bestSize = i, s
+		}
+	}
+	return best
+}
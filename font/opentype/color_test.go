@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"sort"
+	"sync"
+	"testing"
+
+	"gioui.org/text"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestCOLR(t *testing.T) {
+	fnt := parseTestFont(t, nil)
+	a, b, c := glyphIndex(t, fnt, 'A'), glyphIndex(t, fnt, 'B'), glyphIndex(t, fnt, 'C')
+	fnt = parseTestFont(t, map[string][]byte{
+		// A base glyph record for A with the layers B and C.
+		"COLR": data(uint16(0), uint16(1), uint32(14), uint32(20), uint16(2),
+			uint16(a), uint16(0), uint16(2),
+			uint16(b), uint16(0), uint16(c), uint16(foregroundColor)),
+		// A palette of a translucent red.
+		"CPAL": data(uint16(0), uint16(1), uint16(1), uint16(1), uint32(14), uint16(0),
+			[]byte{0x00, 0x00, 0xff, 0x80}),
+	})
+	exp := []colorLayer{{glyph: b}, {glyph: c, palette: foregroundColor}}
+	layers := fnt.tables.colorLayers(a)
+	if len(layers) != len(exp) || layers[0] != exp[0] || layers[1] != exp[1] {
+		t.Errorf("got layers %v, expected %v", layers, exp)
+	}
+	if l := fnt.tables.colorLayers(b); l != nil {
+		t.Errorf("got layers %v for a plain glyph", l)
+	}
+	col, ok := fnt.tables.paletteColor(0)
+	if expCol := (color.RGBA{R: 0x80, A: 0x80}); !ok || col != expCol {
+		t.Errorf("got color %v, expected %v", col, expCol)
+	}
+	if _, ok := fnt.tables.paletteColor(1); ok {
+		t.Error("got color beyond the palette")
+	}
+	shapeTestFont(t, fnt, "ABC")
+}
+
+func TestCBDT(t *testing.T) {
+	fnt := parseTestFont(t, nil)
+	a := glyphIndex(t, fnt, 'A')
+	img := testPNG(t)
+	fnt = parseTestFont(t, map[string][]byte{
+		"CBLC": data(uint16(3), uint16(0), uint32(1),
+			// A 10 ppem strike for A.
+			uint32(56), uint32(16), uint32(1), uint32(0), make([]byte, 24),
+			uint16(a), uint16(a), uint8(10), uint8(10), uint8(32), uint8(1),
+			// The index subtable array and its format 1 subtable.
+			uint16(a), uint16(a), uint32(8),
+			uint16(1), uint16(17), uint32(4), uint32(0), uint32(9+len(img))),
+		// An image with small metrics of a 4x3 image at bearing
+		// (1, 3).
+		"CBDT": data(uint16(3), uint16(0),
+			uint8(3), uint8(4), uint8(1), uint8(3), uint8(5), uint32(len(img)), img),
+	})
+	checkBitmap(t, fnt, a, image.Rect(1, -3, 5, 0))
+}
+
+func TestSbix(t *testing.T) {
+	fnt := parseTestFont(t, nil)
+	a := glyphIndex(t, fnt, 'A')
+	img := testPNG(t)
+	n := fnt.font.NumGlyphs()
+	glyph := data(int16(1), int16(2), []byte("png "), img)
+	strike := data(uint16(10), uint16(72))
+	hdr := 4 + 4*(n+1)
+	for i := 0; i <= n; i++ {
+		off := hdr
+		if i > int(a) {
+			off += len(glyph)
+		}
+		strike = append(strike, data(uint32(off))...)
+	}
+	strike = append(strike, glyph...)
+	fnt = parseTestFont(t, map[string][]byte{
+		"sbix": data(uint16(1), uint16(1), uint32(1), uint32(12), strike),
+	})
+	// The origin of the image is its bottom left corner.
+	checkBitmap(t, fnt, a, image.Rect(1, -5, 5, -2))
+	if _, ok := fnt.tables.bitmap(a+1, 10, n); ok {
+		t.Error("got a bitmap for a glyph without one")
+	}
+}
+
+func TestPickStrike(t *testing.T) {
+	sizes := []int{20, 0, 40, 10}
+	size := func(i int) int { return sizes[i] }
+	tests := []struct{ ppem, exp int }{
+		{5, 3}, {10, 3}, {15, 0}, {30, 2}, {50, 2},
+	}
+	for _, test := range tests {
+		if got := pickStrike(len(sizes), test.ppem, size); got != test.exp {
+			t.Errorf("ppem %d: got strike %d, expected %d", test.ppem, got, test.exp)
+		}
+	}
+	if got := pickStrike(0, 10, size); got != -1 {
+		t.Errorf("got strike %d, expected none", got)
+	}
+}
+
+func checkBitmap(t *testing.T, fnt *Font, g sfnt.GlyphIndex, exp image.Rectangle) {
+	t.Helper()
+	o := &opentype{Font: fnt.font, Tables: fnt.tables, Cache: fnt.cache}
+	if !o.isColorGlyph(fixed.I(20), g) {
+		t.Fatal("glyph is not a color glyph")
+	}
+	img := o.image(g, fixed.I(20))
+	if img == nil {
+		t.Fatal("no image for glyph")
+	}
+	if img.rect != exp || img.ppem != 10 {
+		t.Errorf("got image at %v from strike %d, expected %v from strike 10", img.rect, img.ppem, exp)
+	}
+	if img2 := o.image(g, fixed.I(20)); img2 != img {
+		t.Error("image not cached")
+	}
+	if color, ok := fnt.cache.bitmaps[bitmapKey{glyph: g, ppem: 20}]; !ok || !color {
+		t.Error("color glyph not cached")
+	}
+	shapeTestFont(t, fnt, "ABC")
+	// The hinted versions of a font share its cache.
+	var wg sync.WaitGroup
+	for i, h := range []text.Hinting{text.HintingNone, text.HintingVertical, text.HintingFull} {
+		wg.Add(1)
+		go func(f text.Face, ppem fixed.Int26_6) {
+			defer wg.Done()
+			lines, err := f.Layout(ppem, 1000, bytes.NewReader([]byte("ABC")))
+			if err == nil {
+				f.Shape(ppem, lines[0].Layout)
+			}
+		}(fnt.Hinted(h), fixed.I(10+i))
+	}
+	wg.Wait()
+}
+
+// parseTestFont parses the Go Regular font with extra tables.
+func parseTestFont(t *testing.T, tables map[string][]byte) *Font {
+	t.Helper()
+	fnt, err := Parse(addTables(goregular.TTF, tables))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fnt
+}
+
+func shapeTestFont(t *testing.T, fnt *Font, str string) {
+	t.Helper()
+	lines, err := fnt.Layout(fixed.I(20), 1000, bytes.NewReader([]byte(str)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fnt.Shape(fixed.I(20), lines[0].Layout)
+}
+
+func glyphIndex(t *testing.T, fnt *Font, r rune) sfnt.GlyphIndex {
+	t.Helper()
+	g, err := fnt.font.GlyphIndex(&fnt.buf, r)
+	if err != nil || g == 0 {
+		t.Fatalf("no glyph for %q", r)
+	}
+	return g
+}
+
+// addTables returns the SFNT font src with tables added to its table
+// directory.
+func addTables(src []byte, tables map[string][]byte) []byte {
+	recs := readTables(src)
+	for tag, data := range tables {
+		recs = append(recs, sfntTable{tag: tag, data: data})
+	}
+	sort.Slice(recs, func(i, j int) bool {
+		return recs[i].tag < recs[j].tag
+	})
+	out := append([]byte{}, src[:12]...)
+	binary.BigEndian.PutUint16(out[4:], uint16(len(recs)))
+	off := 12 + 16*len(recs)
+	for _, r := range recs {
+		out = append(out, r.tag...)
+		out = append(out, data(uint32(0), uint32(off), uint32(len(r.data)))...)
+		off += (len(r.data) + 3) &^ 3
+	}
+	for _, r := range recs {
+		out = append(out, r.data...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+	return out
+}
+
+// readTables returns the tables of the SFNT font src.
+func readTables(src []byte) []sfntTable {
+	n := int(binary.BigEndian.Uint16(src[4:]))
+	var tables []sfntTable
+	for i := 0; i < n; i++ {
+		rec := src[12+16*i:]
+		off, size := binary.BigEndian.Uint32(rec[8:]), binary.BigEndian.Uint32(rec[12:])
+		tables = append(tables, sfntTable{tag: string(rec[:4]), data: src[off : off+size]})
+	}
+	return tables
+}
+
+// data encodes a sequence of big-endian integers and byte slices.
+func data(vals ...interface{}) []byte {
+	var b bytes.Buffer
+	for _, v := range vals {
+		if d, ok := v.([]byte); ok {
+			b.Write(d)
+			continue
+		}
+		binary.Write(&b, binary.BigEndian, v)
+	}
+	return b.Bytes()
+}
+
+// testPNG encodes a 4x3 image.
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
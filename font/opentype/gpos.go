@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+// GPOS lookup types.
+const (
+	gposSingle       = 1
+	gposPair         = 2
+	gposMarkBase     = 4
+	gposMarkLigature = 5
+	gposMarkMark     = 6
+	gposContext      = 7
+	gposChainContext = 8
+)
+
+// valueRecord is a GPOS value record, in font units.
+type valueRecord struct {
+	xOff, yOff, xAdv int
+}
+
+// applyGPOS applies a positioning subtable at glyph i. Cursive
+// attachment is not supported.
+func (s *runShaper) applyGPOS(t *lookupTable, l *lookup, st table, i int) (int, bool) {
+	id := s.glyphs[i].id
+	switch l.typ {
+	case gposSingle:
+		cov := st.sub(2).coverageIndex(id)
+		if cov == -1 {
+			return 0, false
+		}
+		format := st.u16(4)
+		switch st.u16(0) {
+		case 1:
+			s.adjust(i, readValue(st, 6, format))
+		case 2:
+			if cov >= int(st.u16(6)) {
+				return 0, false
+			}
+			s.adjust(i, readValue(st, 8+cov*valueSize(format), format))
+		default:
+			return 0, false
+		}
+		return i + 1, true
+	case gposPair:
+		cov := st.sub(2).coverageIndex(id)
+		if cov == -1 {
+			return 0, false
+		}
+		j := s.next(l, i)
+		if j == -1 {
+			return 0, false
+		}
+		f1, f2 := st.u16(4), st.u16(6)
+		size1, size2 := valueSize(f1), valueSize(f2)
+		var rec table
+		switch st.u16(0) {
+		case 1:
+			if cov >= int(st.u16(8)) {
+				return 0, false
+			}
+			set := st.sub(10 + 2*cov)
+			second := s.glyphs[j].id
+			size := 2 + size1 + size2
+			for k, n := 0, int(set.u16(0)); k < n; k++ {
+				r := set.at(2 + k*size)
+				if int(r.u16(0)) == int(second) {
+					rec = r.at(2)
+					break
+				}
+			}
+		case 2:
+			c1 := st.sub(8).class(id)
+			c2 := st.sub(10).class(s.glyphs[j].id)
+			n1, n2 := int(st.u16(12)), int(st.u16(14))
+			if c1 >= n1 || c2 >= n2 {
+				return 0, false
+			}
+			rec = st.at(16 + (c1*n2+c2)*(size1+size2))
+		}
+		if rec == nil {
+			return 0, false
+		}
+		s.adjust(i, readValue(rec, 0, f1))
+		s.adjust(j, readValue(rec, size1, f2))
+		if f2 != 0 {
+			return j + 1, true
+		}
+		return j, true
+	case gposMarkBase, gposMarkLigature:
+		if s.glyphs[i].class != classMark {
+			return 0, false
+		}
+		markCov := st.sub(2).coverageIndex(id)
+		if markCov == -1 {
+			return 0, false
+		}
+		// Find the base glyph, skipping marks.
+		j := i - 1
+		for j >= 0 && s.glyphs[j].class == classMark {
+			j--
+		}
+		if j == -1 {
+			return 0, false
+		}
+		baseCov := st.sub(4).coverageIndex(s.glyphs[j].id)
+		if baseCov == -1 {
+			return 0, false
+		}
+		classes := int(st.u16(6))
+		marks := st.sub(8)
+		class := int(marks.u16(2 + 4*markCov))
+		if class >= classes {
+			return 0, false
+		}
+		markAnchor := marks.sub(2 + 4*markCov + 2)
+		var baseAnchor table
+		if l.typ == gposMarkBase {
+			bases := st.sub(10)
+			baseAnchor = bases.sub(2 + 2*(baseCov*classes+class))
+		} else {
+			// Attach to the last component of the ligature.
+			lig := st.sub(10).sub(2 + 2*baseCov)
+			comps := int(lig.u16(0))
+			if comps == 0 {
+				return 0, false
+			}
+			baseAnchor = lig.sub(2 + 2*((comps-1)*classes+class))
+		}
+		return s.attach(i, j, baseAnchor, markAnchor)
+	case gposMarkMark:
+		if s.glyphs[i].class != classMark {
+			return 0, false
+		}
+		markCov := st.sub(2).coverageIndex(id)
+		if markCov == -1 {
+			return 0, false
+		}
+		j := s.prev(l, i)
+		if j == -1 || s.glyphs[j].class != classMark {
+			return 0, false
+		}
+		mark2Cov := st.sub(4).coverageIndex(s.glyphs[j].id)
+		if mark2Cov == -1 {
+			return 0, false
+		}
+		classes := int(st.u16(6))
+		marks := st.sub(8)
+		class := int(marks.u16(2 + 4*markCov))
+		if class >= classes {
+			return 0, false
+		}
+		markAnchor := marks.sub(2 + 4*markCov + 2)
+		mark2Anchor := st.sub(10).sub(2 + 2*(mark2Cov*classes+class))
+		return s.attach(i, j, mark2Anchor, markAnchor)
+	case gposContext:
+		return s.applyContext(t, l, st, i)
+	case gposChainContext:
+		return s.applyChainContext(t, l, st, i)
+	}
+	return 0, false
+}
+
+// attach attaches mark i to glyph j such that their anchors
+// coincide.
+func (s *runShaper) attach(i, j int, base, mark table) (int, bool) {
+	if base == nil || mark == nil {
+		return 0, false
+	}
+	g := &s.glyphs[i]
+	g.attach = j
+	g.xOff = int(base.i16(2)) - int(mark.i16(2))
+	g.yOff = int(base.i16(4)) - int(mark.i16(4))
+	return i + 1, true
+}
+
+// adjust adds a value record to the positioning of glyph i.
+func (s *runShaper) adjust(i int, v valueRecord) {
+	g := &s.glyphs[i]
+	g.xOff += v.xOff
+	g.yOff += v.yOff
+	g.xAdv += v.xAdv
+}
+
+// valueSize returns the size of a value record in the format.
+func valueSize(format uint16) int {
+	n := 0
+	for f := format & 0xff; f != 0; f >>= 1 {
+		n += int(f & 1)
+	}
+	return 2 * n
+}
+
+// readValue reads the value record at off in the format. Device
+// tables and vertical advances are ignored.
+func readValue(t table, off int, format uint16) valueRecord {
+	var v valueRecord
+	for bit := uint16(1); bit <= 0x80; bit <<= 1 {
+		if format&bit == 0 {
+			continue
+		}
+		val := int(t.i16(off))
+		off += 2
+		switch bit {
+		case 0x1:
+			v.xOff = val
+		case 0x2:
+			v.yOff = val
+		case 0x4:
+			v.xAdv = val
+		}
+	}
+	return v
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"golang.org/x/image/font/sfnt"
+)
+
+// GSUB lookup types.
+const (
+	gsubSingle       = 1
+	gsubMultiple     = 2
+	gsubAlternate    = 3
+	gsubLigature     = 4
+	gsubContext      = 5
+	gsubChainContext = 6
+)
+
+// applyGSUB applies a substitution subtable at glyph i.
+func (s *runShaper) applyGSUB(t *lookupTable, l *lookup, st table, i int) (int, bool) {
+	id := s.glyphs[i].id
+	switch l.typ {
+	case gsubSingle:
+		cov := st.sub(2).coverageIndex(id)
+		if cov == -1 {
+			return 0, false
+		}
+		switch st.u16(0) {
+		case 1:
+			s.substitute(i, id+sfnt.GlyphIndex(st.i16(4)))
+		case 2:
+			if cov >= int(st.u16(4)) {
+				return 0, false
+			}
+			s.substitute(i, sfnt.GlyphIndex(st.u16(6+2*cov)))
+		default:
+			return 0, false
+		}
+		return i + 1, true
+	case gsubMultiple:
+		cov := st.sub(2).coverageIndex(id)
+		if cov == -1 || cov >= int(st.u16(4)) {
+			return 0, false
+		}
+		seq := st.sub(6 + 2*cov)
+		n := int(seq.u16(0))
+		if n == 0 {
+			return 0, false
+		}
+		ids := make([]sfnt.GlyphIndex, n)
+		for k := range ids {
+			ids[k] = sfnt.GlyphIndex(seq.u16(2 + 2*k))
+		}
+		s.substituteMultiple(i, ids)
+		return i + n, true
+	case gsubAlternate:
+		cov := st.sub(2).coverageIndex(id)
+		if cov == -1 || cov >= int(st.u16(4)) {
+			return 0, false
+		}
+		set := st.sub(6 + 2*cov)
+		if set.u16(0) == 0 {
+			return 0, false
+		}
+		s.substitute(i, sfnt.GlyphIndex(set.u16(2)))
+		return i + 1, true
+	case gsubLigature:
+		cov := st.sub(2).coverageIndex(id)
+		if cov == -1 || cov >= int(st.u16(4)) {
+			return 0, false
+		}
+		set := st.sub(6 + 2*cov)
+		for k, n := 0, int(set.u16(0)); k < n; k++ {
+			lig := set.sub(2 + 2*k)
+			comps := int(lig.u16(2))
+			if comps == 0 {
+				continue
+			}
+			if pos, ok := s.matchInput(l, i, comps-1, glyphMatch(lig, 4)); ok {
+				s.ligate(pos, sfnt.GlyphIndex(lig.u16(0)))
+				return i + 1, true
+			}
+		}
+	case gsubContext:
+		return s.applyContext(t, l, st, i)
+	case gsubChainContext:
+		return s.applyChainContext(t, l, st, i)
+	}
+	return 0, false
+}
+
+// substitute replaces glyph i with id.
+func (s *runShaper) substitute(i int, id sfnt.GlyphIndex) {
+	g := &s.glyphs[i]
+	g.id = id
+	if c := s.gdef.glyphClass(id); c != 0 {
+		g.class = c
+	}
+}
+
+// substituteMultiple replaces glyph i with a sequence of glyphs of
+// the same cluster.
+func (s *runShaper) substituteMultiple(i int, ids []sfnt.GlyphIndex) {
+	g := s.glyphs[i]
+	seq := make([]glyphInfo, len(ids))
+	for k, id := range ids {
+		seq[k] = g
+		seq[k].id = id
+		if c := s.gdef.glyphClass(id); c != 0 {
+			seq[k].class = c
+		}
+	}
+	s.glyphs = append(s.glyphs[:i], append(seq, s.glyphs[i+1:]...)...)
+}
+
+// ligate replaces the glyphs at pos with a ligature glyph. The
+// glyphs skipped during matching, such as marks, follow the ligature
+// and join its cluster.
+func (s *runShaper) ligate(pos []int, id sfnt.GlyphIndex) {
+	first, last := pos[0], pos[len(pos)-1]
+	g := &s.glyphs[first]
+	g.id = id
+	g.class = classLigature
+	if c := s.gdef.glyphClass(id); c != 0 {
+		g.class = c
+	}
+	for k := first + 1; k <= last; k++ {
+		s.glyphs[k].cluster = g.cluster
+	}
+	// Remove the components following the first.
+	glyphs := s.glyphs[:first+1]
+	for k, p := first+1, 1; k < len(s.glyphs); k++ {
+		if p < len(pos) && k == pos[p] {
+			p++
+			continue
+		}
+		glyphs = append(glyphs, s.glyphs[k])
+	}
+	s.glyphs = glyphs
+}
@@ -0,0 +1,329 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"golang.org/x/image/font/sfnt"
+)
+
+// runShaper applies GSUB and GPOS lookups to the glyphs of a run of
+// text.
+type runShaper struct {
+	gdef   *gdef
+	glyphs []glyphInfo
+	// gpos is set while applying positioning lookups.
+	gpos bool
+	// depth is the nesting depth of contextual lookups.
+	depth int
+}
+
+// glyphInfo is a glyph of a run being shaped.
+type glyphInfo struct {
+	id sfnt.GlyphIndex
+	// cluster is the index of the first rune of the text of the
+	// glyph.
+	cluster int
+	// mask selects the features that apply to the glyph.
+	mask  uint32
+	class uint16
+	// xAdv, xOff and yOff are the positioning adjustments of the
+	// glyph, in font units.
+	xAdv, xOff, yOff int
+	// attach is the index of the glyph a mark is attached to, or
+	// -1. The offsets of attached marks are relative to the glyph.
+	attach int
+}
+
+// maxContextDepth limits the nesting of contextual lookups.
+const maxContextDepth = 8
+
+// applyLookups applies the lookups of t referenced by refs to the
+// glyphs.
+func (s *runShaper) applyLookups(t *lookupTable, refs []lookupRef) {
+	for _, ref := range refs {
+		l := &t.lookups[ref.index]
+		for i := 0; i < len(s.glyphs); {
+			if s.glyphs[i].mask&ref.mask == 0 || s.ignored(l, i) {
+				i++
+				continue
+			}
+			if next, ok := s.apply(t, l, i); ok {
+				i = next
+			} else {
+				i++
+			}
+		}
+	}
+}
+
+// apply applies the first matching subtable of l at glyph i and
+// returns the index of the glyph to continue from.
+func (s *runShaper) apply(t *lookupTable, l *lookup, i int) (int, bool) {
+	for _, st := range l.subtables {
+		var next int
+		var ok bool
+		if s.gpos {
+			next, ok = s.applyGPOS(t, l, st, i)
+		} else {
+			next, ok = s.applyGSUB(t, l, st, i)
+		}
+		if ok {
+			return next, true
+		}
+	}
+	return 0, false
+}
+
+// ignored reports whether l skips glyph i according to the lookup
+// flags.
+func (s *runShaper) ignored(l *lookup, i int) bool {
+	g := &s.glyphs[i]
+	switch g.class {
+	case classBase:
+		return l.flag&flagIgnoreBase != 0
+	case classLigature:
+		return l.flag&flagIgnoreLigature != 0
+	case classMark:
+		if l.flag&flagIgnoreMarks != 0 {
+			return true
+		}
+		if l.flag&flagMarkSet != 0 {
+			return !s.gdef.inMarkSet(l.markSet, g.id)
+		}
+		if t := l.flag & flagMarkAttachType >> 8; t != 0 {
+			return uint16(s.gdef.attachClasses.class(g.id)) != t
+		}
+	}
+	return false
+}
+
+// next returns the index of the first glyph after i not skipped by
+// l, or -1.
+func (s *runShaper) next(l *lookup, i int) int {
+	for i++; i < len(s.glyphs); i++ {
+		if !s.ignored(l, i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// prev returns the index of the last glyph before i not skipped by
+// l, or -1.
+func (s *runShaper) prev(l *lookup, i int) int {
+	for i--; i >= 0; i-- {
+		if !s.ignored(l, i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// matchFunc reports whether glyph g matches element k of a sequence.
+type matchFunc func(k int, g sfnt.GlyphIndex) bool
+
+// matchInput matches the n glyphs following glyph i and returns the
+// indices of i and the matched glyphs.
+func (s *runShaper) matchInput(l *lookup, i, n int, match matchFunc) ([]int, bool) {
+	pos := make([]int, 1, n+1)
+	pos[0] = i
+	for k := 0; k < n; k++ {
+		i = s.next(l, i)
+		if i == -1 || !match(k, s.glyphs[i].id) {
+			return nil, false
+		}
+		pos = append(pos, i)
+	}
+	return pos, true
+}
+
+// matchBacktrack matches the n glyphs preceding glyph i, starting
+// with the closest.
+func (s *runShaper) matchBacktrack(l *lookup, i, n int, match matchFunc) bool {
+	for k := 0; k < n; k++ {
+		i = s.prev(l, i)
+		if i == -1 || !match(k, s.glyphs[i].id) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchLookahead matches the n glyphs following glyph i.
+func (s *runShaper) matchLookahead(l *lookup, i, n int, match matchFunc) bool {
+	for k := 0; k < n; k++ {
+		i = s.next(l, i)
+		if i == -1 || !match(k, s.glyphs[i].id) {
+			return false
+		}
+	}
+	return true
+}
+
+// glyphMatch matches glyph ids stored at off in t.
+func glyphMatch(t table, off int) matchFunc {
+	return func(k int, g sfnt.GlyphIndex) bool {
+		return sfnt.GlyphIndex(t.u16(off+2*k)) == g
+	}
+}
+
+// classMatch matches classes stored at off in t.
+func classMatch(t table, off int, classes table) matchFunc {
+	return func(k int, g sfnt.GlyphIndex) bool {
+		return int(t.u16(off+2*k)) == classes.class(g)
+	}
+}
+
+// coverageMatch matches coverage tables with offsets stored at off in
+// t.
+func coverageMatch(t table, off int) matchFunc {
+	return func(k int, g sfnt.GlyphIndex) bool {
+		return t.sub(off+2*k).coverageIndex(g) != -1
+	}
+}
+
+// applyContext applies a contextual subtable at glyph i.
+func (s *runShaper) applyContext(t *lookupTable, l *lookup, st table, i int) (int, bool) {
+	id := s.glyphs[i].id
+	switch st.u16(0) {
+	case 1, 2:
+		cov := st.sub(2).coverageIndex(id)
+		if cov == -1 {
+			return 0, false
+		}
+		var set table
+		var classes table
+		if st.u16(0) == 1 {
+			set = st.sub(6 + 2*cov)
+		} else {
+			classes = st.sub(4)
+			set = st.sub(8 + 2*classes.class(id))
+		}
+		for r, n := 0, int(set.u16(0)); r < n; r++ {
+			rule := set.sub(2 + 2*r)
+			in := int(rule.u16(0))
+			if in == 0 {
+				continue
+			}
+			match := glyphMatch(rule, 4)
+			if classes != nil {
+				match = classMatch(rule, 4, classes)
+			}
+			if pos, ok := s.matchInput(l, i, in-1, match); ok {
+				recs := rule.at(4 + 2*(in-1))
+				return s.applyRecords(t, pos, recs, int(rule.u16(2))), true
+			}
+		}
+	case 3:
+		in := int(st.u16(2))
+		if in == 0 || st.sub(6).coverageIndex(id) == -1 {
+			return 0, false
+		}
+		if pos, ok := s.matchInput(l, i, in-1, coverageMatch(st, 8)); ok {
+			return s.applyRecords(t, pos, st.at(6+2*in), int(st.u16(4))), true
+		}
+	}
+	return 0, false
+}
+
+// applyChainContext applies a chained contextual subtable at glyph
+// i.
+func (s *runShaper) applyChainContext(t *lookupTable, l *lookup, st table, i int) (int, bool) {
+	id := s.glyphs[i].id
+	switch st.u16(0) {
+	case 1, 2:
+		cov := st.sub(2).coverageIndex(id)
+		if cov == -1 {
+			return 0, false
+		}
+		var set, btClasses, inClasses, laClasses table
+		if st.u16(0) == 1 {
+			set = st.sub(6 + 2*cov)
+		} else {
+			btClasses, inClasses, laClasses = st.sub(4), st.sub(6), st.sub(8)
+			set = st.sub(12 + 2*inClasses.class(id))
+		}
+		for r, n := 0, int(set.u16(0)); r < n; r++ {
+			rule := set.sub(2 + 2*r)
+			bt := int(rule.u16(0))
+			inOff := 2 + 2*bt
+			in := int(rule.u16(inOff))
+			if in == 0 {
+				continue
+			}
+			laOff := inOff + 2 + 2*(in-1)
+			la := int(rule.u16(laOff))
+			recOff := laOff + 2 + 2*la
+			var btMatch, inMatch, laMatch matchFunc
+			if st.u16(0) == 1 {
+				btMatch = glyphMatch(rule, 2)
+				inMatch = glyphMatch(rule, inOff+2)
+				laMatch = glyphMatch(rule, laOff+2)
+			} else {
+				btMatch = classMatch(rule, 2, btClasses)
+				inMatch = classMatch(rule, inOff+2, inClasses)
+				laMatch = classMatch(rule, laOff+2, laClasses)
+			}
+			if next, ok := s.applyChain(t, l, i, bt, in, la, btMatch, inMatch, laMatch, rule.at(recOff)); ok {
+				return next, true
+			}
+		}
+	case 3:
+		bt := int(st.u16(2))
+		inOff := 4 + 2*bt
+		in := int(st.u16(inOff))
+		if in == 0 || st.sub(inOff+2).coverageIndex(id) == -1 {
+			return 0, false
+		}
+		laOff := inOff + 2 + 2*in
+		la := int(st.u16(laOff))
+		recOff := laOff + 2 + 2*la
+		return s.applyChain(t, l, i, bt, in, la, coverageMatch(st, 4), coverageMatch(st, inOff+4), coverageMatch(st, laOff+2), st.at(recOff))
+	}
+	return 0, false
+}
+
+// applyChain matches a chained context rule at glyph i and applies
+// its lookup records. The records start with their count.
+func (s *runShaper) applyChain(t *lookupTable, l *lookup, i, bt, in, la int, btMatch, inMatch, laMatch matchFunc, recs table) (int, bool) {
+	if !s.matchBacktrack(l, i, bt, btMatch) {
+		return 0, false
+	}
+	pos, ok := s.matchInput(l, i, in-1, inMatch)
+	if !ok || !s.matchLookahead(l, pos[len(pos)-1], la, laMatch) {
+		return 0, false
+	}
+	return s.applyRecords(t, pos, recs.at(2), int(recs.u16(0))), true
+}
+
+// applyRecords applies n sequence lookup records to the matched
+// glyphs at pos and returns the index following the match.
+func (s *runShaper) applyRecords(t *lookupTable, pos []int, recs table, n int) int {
+	end := pos[len(pos)-1] + 1
+	if s.depth >= maxContextDepth {
+		return end
+	}
+	s.depth++
+	defer func() { s.depth-- }()
+	for r := 0; r < n; r++ {
+		seq, idx := int(recs.u16(4*r)), int(recs.u16(4*r+2))
+		if seq >= len(pos) || idx >= len(t.lookups) {
+			continue
+		}
+		i := pos[seq]
+		if i >= len(s.glyphs) {
+			continue
+		}
+		before := len(s.glyphs)
+		s.apply(t, &t.lookups[idx], i)
+		// Adjust the positions following substitutions that
+		// change the number of glyphs.
+		if d := len(s.glyphs) - before; d != 0 {
+			for k := seq + 1; k < len(pos); k++ {
+				pos[k] += d
+			}
+			end += d
+		}
+	}
+	return end
+}
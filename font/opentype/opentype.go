@@ -5,8 +5,8 @@
 package opentype
 
 import (
+	"bytes"
 	"io"
-
 	"unicode"
 	"unicode/utf8"
 
@@ -19,30 +19,50 @@ import (
 	"golang.org/x/image/math/fixed"
 )
 
-// Font implements text.Face.
+// Font implements text.Face, text.FallbackFace, text.ColorFace and
+// text.HintingFace. Fonts are fully hinted unless changed by Hinted.
 type Font struct {
-	font *sfnt.Font
-	buf  sfnt.Buffer
+	font   *sfnt.Font
+	tables *layoutTables
+	buf    sfnt.Buffer
+	// cache caches the color bitmap glyphs.
+	cache *glyphCache
+	// coords are the normalized variation coordinates of an
+	// instance of a variable font, or nil for the default instance.
+	coords  []int16
+	hinting font.Hinting
 }
 
 // Collection is a collection of one or more fonts.
 type Collection struct {
 	coll *sfnt.Collection
+	src  io.ReaderAt
 }
 
 type opentype struct {
 	Font    *sfnt.Font
+	Tables  *layoutTables
 	Hinting font.Hinting
+	Cache   *glyphCache
+	Coords  []int16
 }
 
 // NewFont parses an SFNT font, such as TTF or OTF data, from a []byte
-// data source.
+// data source. WOFF and WOFF2 data is decoded to SFNT data.
 func Parse(src []byte) (*Font, error) {
+	src, err := decodeWebFont(src)
+	if err != nil {
+		return nil, err
+	}
 	fnt, err := sfnt.Parse(src)
 	if err != nil {
 		return nil, err
 	}
-	return &Font{font: fnt}, nil
+	tables, err := readLayoutTables(bytes.NewReader(src), 0)
+	if err != nil {
+		return nil, err
+	}
+	return newFont(fnt, tables), nil
 }
 
 // ParseCollection parses an SFNT font collection, such as TTC or OTC data,
@@ -50,12 +70,18 @@ func Parse(src []byte) (*Font, error) {
 //
 // If passed data for a single font, a TTF or OTF instead of a TTC or OTC,
 // it will return a collection containing 1 font.
+//
+// WOFF and WOFF2 data is decoded to SFNT data.
 func ParseCollection(src []byte) (*Collection, error) {
+	src, err := decodeWebFont(src)
+	if err != nil {
+		return nil, err
+	}
 	c, err := sfnt.ParseCollection(src)
 	if err != nil {
 		return nil, err
 	}
-	return &Collection{c}, nil
+	return &Collection{coll: c, src: bytes.NewReader(src)}, nil
 }
 
 // ParseCollectionReaderAt parses an SFNT collection, such as TTC or OTC data,
@@ -63,12 +89,40 @@ func ParseCollection(src []byte) (*Collection, error) {
 //
 // If passed data for a single font, a TTF or OTF instead of a TTC or OTC, it
 // will return a collection containing 1 font.
+//
+// WOFF and WOFF2 data is read in full and decoded to SFNT data.
 func ParseCollectionReaderAt(src io.ReaderAt) (*Collection, error) {
+	src, err := readWebFont(src)
+	if err != nil {
+		return nil, err
+	}
 	c, err := sfnt.ParseCollectionReaderAt(src)
 	if err != nil {
 		return nil, err
 	}
-	return &Collection{c}, nil
+	return &Collection{coll: c, src: src}, nil
+}
+
+func newFont(fnt *sfnt.Font, tables *layoutTables) *Font {
+	return &Font{
+		font:   fnt,
+		tables: tables,
+		cache: &glyphCache{
+			bitmaps: make(map[bitmapKey]bool),
+			images:  make(map[bitmapKey]*glyphImage),
+		},
+		hinting: font.HintingFull,
+	}
+}
+
+func (f *Font) face() *opentype {
+	return &opentype{
+		Font:    f.font,
+		Tables:  f.tables,
+		Hinting: f.hinting,
+		Cache:   f.cache,
+		Coords:  f.coords,
+	}
 }
 
 // NumFonts returns the number of fonts in the collection.
@@ -82,27 +136,79 @@ func (c *Collection) Font(i int) (*Font, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Font{font: fnt}, nil
+	off, err := fontOffset(c.src, i)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := readLayoutTables(c.src, off)
+	if err != nil {
+		return nil, err
+	}
+	return newFont(fnt, tables), nil
 }
 
 func (f *Font) Layout(ppem fixed.Int26_6, maxWidth int, txt io.Reader) ([]text.Line, error) {
-	glyphs, err := readGlyphs(txt)
+	runes, err := readRunes(txt)
 	if err != nil {
 		return nil, err
 	}
-	return layoutText(&f.buf, ppem, maxWidth, &opentype{Font: f.font, Hinting: font.HintingFull}, glyphs)
+	return layoutText(&f.buf, ppem, maxWidth, f.face(), runes)
 }
 
 func (f *Font) Shape(ppem fixed.Int26_6, str []text.Glyph) op.CallOp {
-	return textPath(&f.buf, ppem, &opentype{Font: f.font, Hinting: font.HintingFull}, str)
+	return textPath(&f.buf, ppem, f.face(), str)
+}
+
+// HasGlyph reports whether the font has a glyph for r.
+func (f *Font) HasGlyph(r rune) bool {
+	g, err := f.font.GlyphIndex(&f.buf, r)
+	return err == nil && g != 0
+}
+
+// Hinted returns the font with hinting h. Hinting rounds the advances
+// and metrics of the font to whole pixels; the outlines of the glyphs
+// are not grid fitted.
+func (f *Font) Hinted(h text.Hinting) text.Face {
+	var hinting font.Hinting
+	switch h {
+	case text.HintingDefault:
+		return f
+	case text.HintingNone:
+		hinting = font.HintingNone
+	case text.HintingVertical:
+		hinting = font.HintingVertical
+	case text.HintingFull:
+		hinting = font.HintingFull
+	}
+	return &Font{font: f.font, tables: f.tables, cache: f.cache, coords: f.coords, hinting: hinting}
+}
+
+// Outline adds the outlines of str to p, with the start of the text
+// at off.
+func (f *Font) Outline(p *clip.Path, ppem fixed.Int26_6, off f32.Point, str []text.Glyph) {
+	appendOutline(p, &f.buf, ppem, f.face(), off, str)
+}
+
+// Paint adds the operations for drawing the color glyphs of str to
+// ops, with the start of the text at off.
+func (f *Font) Paint(ops *op.Ops, ppem fixed.Int26_6, off f32.Point, str []text.Glyph) {
+	paintColorGlyphs(ops, &f.buf, ppem, f.face(), off, str)
 }
 
 func (f *Font) Metrics(ppem fixed.Int26_6) font.Metrics {
-	o := &opentype{Font: f.font, Hinting: font.HintingFull}
+	o := f.face()
 	return o.Metrics(&f.buf, ppem)
 }
 
-func layoutText(sbuf *sfnt.Buffer, ppem fixed.Int26_6, maxWidth int, f *opentype, glyphs []text.Glyph) ([]text.Line, error) {
+// DecorationMetrics returns the position and thickness of the
+// underline from the post table and of the strikethrough from the OS/2
+// table.
+func (f *Font) DecorationMetrics(ppem fixed.Int26_6) text.DecorationMetrics {
+	o := f.face()
+	return o.DecorationMetrics(ppem)
+}
+
+func layoutText(sbuf *sfnt.Buffer, ppem fixed.Int26_6, maxWidth int, f *opentype, runes []rune) ([]text.Line, error) {
 	m := f.Metrics(sbuf, ppem)
 	lineTmpl := text.Line{
 		Ascent: m.Ascent,
@@ -111,139 +217,127 @@ func layoutText(sbuf *sfnt.Buffer, ppem fixed.Int26_6, maxWidth int, f *opentype
 		Descent: m.Height - m.Ascent,
 		Bounds:  f.Bounds(sbuf, ppem),
 	}
+	glyphs := f.shape(sbuf, ppem, runes)
+	// Lay out the paragraphs and let the text package wrap them.
 	var lines []text.Line
-	maxDotX := fixed.I(maxWidth)
-	type state struct {
-		r     rune
-		adv   fixed.Int26_6
-		x     fixed.Int26_6
-		idx   int
-		len   int
-		valid bool
-	}
-	var prev, word state
-	endLine := func() {
-		line := lineTmpl
-		line.Layout = glyphs[:prev.idx:prev.idx]
-		line.Len = prev.len
-		line.Width = prev.x + prev.adv
-		line.Bounds.Max.X += prev.x
-		lines = append(lines, line)
-		glyphs = glyphs[prev.idx:]
-		prev = state{}
-		word = state{}
-	}
-	for prev.idx < len(glyphs) {
-		g := &glyphs[prev.idx]
-		a, valid := f.GlyphAdvance(sbuf, ppem, g.Rune)
-		next := state{
-			r:     g.Rune,
-			idx:   prev.idx + 1,
-			len:   prev.len + utf8.RuneLen(g.Rune),
-			x:     prev.x + prev.adv,
-			adv:   a,
-			valid: valid,
-		}
-		if g.Rune == '\n' {
-			// The newline is zero width; use the previous
-			// character for line measurements.
-			prev.idx = next.idx
-			prev.len = next.len
-			endLine()
-			continue
-		}
-		var k fixed.Int26_6
-		if prev.valid {
-			k = f.Kern(sbuf, ppem, prev.r, next.r)
+	for {
+		n := len(glyphs)
+		for i, g := range glyphs {
+			if g.Rune == '\n' {
+				n = i + 1
+				break
+			}
 		}
-		// Break the line if we're out of space.
-		if prev.idx > 0 && next.x+next.adv+k > maxDotX {
-			// If the line contains no word breaks, break off the last rune.
-			if word.idx == 0 {
-				word = prev
+		line := lineTmpl
+		line.Layout = glyphs[:n:n]
+		var lastAdv fixed.Int26_6
+		for _, g := range line.Layout {
+			line.Len += g.ClusterLen()
+			line.Width += g.Advance
+			if g.Rune != '\n' {
+				lastAdv = g.Advance
 			}
-			next.x -= word.x + word.adv
-			next.idx -= word.idx
-			next.len -= word.len
-			prev = word
-			endLine()
-		} else if k != 0 {
-			glyphs[prev.idx-1].Advance += k
-			next.x += k
 		}
-		g.Advance = next.adv
-		if unicode.IsSpace(g.Rune) {
-			word = next
+		line.Bounds.Max.X += line.Width - lastAdv
+		lines = append(lines, line)
+		glyphs = glyphs[n:]
+		if n == 0 || line.Layout[n-1].Rune != '\n' {
+			break
 		}
-		prev = next
 	}
-	endLine()
-	return lines, nil
+	return text.WrapLines(lines, maxWidth, text.WrapHeuristically), nil
 }
 
+// textPath returns the operations for drawing the color glyphs of str
+// followed by the clip path of the other glyphs.
 func textPath(buf *sfnt.Buffer, ppem fixed.Int26_6, f *opentype, str []text.Glyph) op.CallOp {
-	var lastPos f32.Point
 	var builder clip.Path
 	ops := new(op.Ops)
 	m := op.Record(ops)
-	var x fixed.Int26_6
+	paintColorGlyphs(ops, buf, ppem, f, f32.Point{}, str)
 	builder.Begin(ops)
-	for _, g := range str {
-		if !unicode.IsSpace(g.Rune) {
-			segs, ok := f.LoadGlyph(buf, ppem, g.Rune)
+	appendOutline(&builder, buf, ppem, f, f32.Point{}, str)
+	builder.End().Add(ops)
+	return m.Stop()
+}
+
+// appendOutline adds the glyph outlines of str to builder, with the
+// start of the text at off. Color glyphs are left out.
+func appendOutline(builder *clip.Path, buf *sfnt.Buffer, ppem fixed.Int26_6, f *opentype, off f32.Point, str []text.Glyph) {
+	order := text.VisualOrder(str)
+	var x fixed.Int26_6
+	for i := range str {
+		g := str[i]
+		if order != nil {
+			g = str[order[i]]
+		}
+		id := sfnt.GlyphIndex(g.ID)
+		if !unicode.IsSpace(g.Rune) && !f.isColorGlyph(ppem, id) {
+			segs, ok := f.LoadGlyph(buf, ppem, id)
 			if !ok {
+				x += g.Advance
 				continue
 			}
 			// Move to glyph position.
 			pos := f32.Point{
-				X: float32(x) / 64,
-			}
-			builder.Move(pos.Sub(lastPos))
-			lastPos = pos
-			var lastArg f32.Point
-			// Convert sfnt.Segments to relative segments.
-			for _, fseg := range segs {
-				nargs := 1
-				switch fseg.Op {
-				case sfnt.SegmentOpQuadTo:
-					nargs = 2
-				case sfnt.SegmentOpCubeTo:
-					nargs = 3
-				}
-				var args [3]f32.Point
-				for i := 0; i < nargs; i++ {
-					a := f32.Point{
-						X: float32(fseg.Args[i].X) / 64,
-						Y: float32(fseg.Args[i].Y) / 64,
-					}
-					args[i] = a.Sub(lastArg)
-					if i == nargs-1 {
-						lastArg = a
-					}
-				}
-				switch fseg.Op {
-				case sfnt.SegmentOpMoveTo:
-					builder.Move(args[0])
-				case sfnt.SegmentOpLineTo:
-					builder.Line(args[0])
-				case sfnt.SegmentOpQuadTo:
-					builder.Quad(args[0], args[1])
-				case sfnt.SegmentOpCubeTo:
-					builder.Cube(args[0], args[1], args[2])
-				default:
-					panic("unsupported segment op")
-				}
+				X: off.X + float32(x+g.Offset.X)/64,
+				Y: off.Y + float32(g.Offset.Y)/64,
 			}
-			lastPos = lastPos.Add(lastArg)
+			outlineGlyph(builder, segs, pos)
 		}
 		x += g.Advance
 	}
-	builder.End().Add(ops)
-	return m.Stop()
 }
 
-func readGlyphs(r io.Reader) ([]text.Glyph, error) {
-	var glyphs []text.Glyph
+// outlineGlyph adds the glyph outline segs to builder, with the glyph
+// origin at pos. It returns the bounds of the outline points.
+func outlineGlyph(builder *clip.Path, segs []sfnt.Segment, pos f32.Point) f32.Rectangle {
+	builder.Move(pos.Sub(builder.Pos()))
+	bounds := f32.Rectangle{Min: pos, Max: pos}
+	var lastArg f32.Point
+	// Convert sfnt.Segments to relative segments.
+	for i, fseg := range segs {
+		nargs := 1
+		switch fseg.Op {
+		case sfnt.SegmentOpQuadTo:
+			nargs = 2
+		case sfnt.SegmentOpCubeTo:
+			nargs = 3
+		}
+		var args [3]f32.Point
+		for j := 0; j < nargs; j++ {
+			a := f32.Point{
+				X: float32(fseg.Args[j].X) / 64,
+				Y: float32(fseg.Args[j].Y) / 64,
+			}
+			if p := pos.Add(a); i == 0 && j == 0 {
+				bounds = f32.Rectangle{Min: p, Max: p}
+			} else {
+				bounds = bounds.Union(f32.Rectangle{Min: p, Max: p})
+			}
+			args[j] = a.Sub(lastArg)
+			if j == nargs-1 {
+				lastArg = a
+			}
+		}
+		switch fseg.Op {
+		case sfnt.SegmentOpMoveTo:
+			builder.Move(args[0])
+		case sfnt.SegmentOpLineTo:
+			builder.Line(args[0])
+		case sfnt.SegmentOpQuadTo:
+			builder.Quad(args[0], args[1])
+		case sfnt.SegmentOpCubeTo:
+			builder.Cube(args[0], args[1], args[2])
+		default:
+			panic("unsupported segment op")
+		}
+	}
+	return bounds
+}
+
+func readRunes(r io.Reader) ([]rune, error) {
+	var runes []rune
 	buf := make([]byte, 0, 1024)
 	for {
 		n, err := r.Read(buf[len(buf):cap(buf)])
@@ -257,7 +351,7 @@ func readGlyphs(r io.Reader) ([]text.Glyph, error) {
 		for i < lim {
 			c, s := utf8.DecodeRune(buf[i:])
 			i += s
-			glyphs = append(glyphs, text.Glyph{Rune: c})
+			runes = append(runes, c)
 		}
 		n = copy(buf, buf[i:])
 		buf = buf[:n]
@@ -268,48 +362,64 @@ func readGlyphs(r io.Reader) ([]text.Glyph, error) {
 			return nil, err
 		}
 	}
-	return glyphs, nil
+	return runes, nil
 }
 
-func (f *opentype) GlyphAdvance(buf *sfnt.Buffer, ppem fixed.Int26_6, r rune) (advance fixed.Int26_6, ok bool) {
-	g, err := f.Font.GlyphIndex(buf, r)
-	if err != nil {
-		return 0, false
+func (f *opentype) Metrics(buf *sfnt.Buffer, ppem fixed.Int26_6) font.Metrics {
+	// The metrics are vertical and rounded by vertical hinting too.
+	h := f.Hinting
+	if h == font.HintingVertical {
+		h = font.HintingFull
 	}
-	adv, err := f.Font.GlyphAdvance(buf, g, ppem, f.Hinting)
-	return adv, err == nil
+	m, _ := f.Font.Metrics(buf, ppem, h)
+	return m
 }
 
-func (f *opentype) Kern(buf *sfnt.Buffer, ppem fixed.Int26_6, r0, r1 rune) fixed.Int26_6 {
-	g0, err := f.Font.GlyphIndex(buf, r0)
-	if err != nil {
-		return 0
+func (f *opentype) DecorationMetrics(ppem fixed.Int26_6) text.DecorationMetrics {
+	upem := int16(f.Font.UnitsPerEm())
+	var under, underSize, strike, strikeSize int16
+	if t := f.Tables; t != nil {
+		under, underSize = t.underline, t.underlineSize
+		strike, strikeSize = t.strikeout, t.strikeoutSize
 	}
-	g1, err := f.Font.GlyphIndex(buf, r1)
-	if err != nil {
-		return 0
+	// Use typical metrics if the font lacks them.
+	if underSize <= 0 {
+		under, underSize = -upem/10, upem/14
 	}
-	adv, err := f.Font.Kern(buf, g0, g1, ppem, f.Hinting)
-	if err != nil {
-		return 0
+	if strikeSize <= 0 {
+		strike, strikeSize = upem/4+upem/28, upem/14
+	}
+	scale := func(v int16) fixed.Int26_6 {
+		return f.roundY(fixed.Int26_6(int64(v) * int64(ppem) / int64(upem)))
+	}
+	thickness := func(v int16) fixed.Int26_6 {
+		// Keep hinted lines visible.
+		t := scale(v)
+		if f.Hinting != font.HintingNone && t < fixed.I(1) {
+			t = fixed.I(1)
+		}
+		return t
+	}
+	return text.DecorationMetrics{
+		Underline:              -scale(under),
+		UnderlineThickness:     thickness(underSize),
+		Strikethrough:          -scale(strike),
+		StrikethroughThickness: thickness(strikeSize),
 	}
-	return adv
-}
-
-func (f *opentype) Metrics(buf *sfnt.Buffer, ppem fixed.Int26_6) font.Metrics {
-	m, _ := f.Font.Metrics(buf, ppem, f.Hinting)
-	return m
 }
 
 func (f *opentype) Bounds(buf *sfnt.Buffer, ppem fixed.Int26_6) fixed.Rectangle26_6 {
 	r, _ := f.Font.Bounds(buf, ppem, f.Hinting)
+	if f.Hinting == font.HintingVertical {
+		r.Min.Y = fixed.I(r.Min.Y.Floor())
+		r.Max.Y = fixed.I(r.Max.Y.Ceil())
+	}
 	return r
 }
 
-func (f *opentype) LoadGlyph(buf *sfnt.Buffer, ppem fixed.Int26_6, r rune) ([]sfnt.Segment, bool) {
-	g, err := f.Font.GlyphIndex(buf, r)
-	if err != nil {
-		return nil, false
+func (f *opentype) LoadGlyph(buf *sfnt.Buffer, ppem fixed.Int26_6, g sfnt.GlyphIndex) ([]sfnt.Segment, bool) {
+	if f.Coords != nil && f.Tables.gvar != nil {
+		return f.loadVariedGlyph(ppem, g)
 	}
 	segs, err := f.Font.LoadGlyph(buf, g, ppem, nil)
 	if err != nil {
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"strings"
+	"testing"
+
+	"gioui.org/text"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestDecorationMetrics(t *testing.T) {
+	fnt, err := Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fnt.tables.underlineSize == 0 || fnt.tables.strikeoutSize == 0 {
+		t.Fatal("missing decoration metrics")
+	}
+	m := fnt.DecorationMetrics(fixed.I(20))
+	if m.Underline <= 0 || m.Strikethrough >= 0 {
+		t.Errorf("got underline at %v and strikethrough at %v, expected below and above the baseline", m.Underline, m.Strikethrough)
+	}
+	if m.UnderlineThickness < fixed.I(1) || m.StrikethroughThickness < fixed.I(1) {
+		t.Errorf("got thicknesses %v and %v, expected at least a pixel", m.UnderlineThickness, m.StrikethroughThickness)
+	}
+}
+
+func TestHinting(t *testing.T) {
+	fnt, err := Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := fnt.Hinted(text.HintingDefault); f != fnt {
+		t.Errorf("got face %v for the default hinting", f)
+	}
+	whole := func(v fixed.Int26_6) bool {
+		return v&63 == 0
+	}
+	ppem := fixed.I(13)
+	tests := []struct {
+		hinting          text.Hinting
+		advances, metric bool
+	}{
+		{text.HintingNone, false, false},
+		{text.HintingVertical, false, true},
+		{text.HintingFull, true, true},
+	}
+	for _, test := range tests {
+		f := fnt.Hinted(test.hinting)
+		lines, err := f.Layout(ppem, 1000, strings.NewReader("Hello, world"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		advances := true
+		for _, g := range lines[0].Layout {
+			advances = advances && whole(g.Advance)
+		}
+		if advances != test.advances {
+			t.Errorf("%v: got whole pixel advances %v, expected %v", test.hinting, advances, test.advances)
+		}
+		m := f.Metrics(ppem)
+		b := lines[0].Bounds
+		metric := whole(m.Ascent) && whole(m.Descent) && whole(b.Min.Y) && whole(b.Max.Y)
+		if metric != test.metric {
+			t.Errorf("%v: got whole pixel metrics %v, expected %v", test.hinting, metric, test.metric)
+		}
+	}
+	// Instances keep the hinting of their font.
+	vf := parseVariableFont(t, 0, nil).Hinted(text.HintingVertical).(*Font)
+	inst, ok := vf.Instance(text.Bold, text.Regular).(*Font)
+	if !ok || inst.hinting != vf.hinting {
+		t.Errorf("got instance %v, expected an instance with the hinting of its font", inst)
+	}
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"gioui.org/internal/bidi"
+	"gioui.org/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// maskGlobal selects the features that apply to every glyph.
+const maskGlobal uint32 = 1
+
+// gsubFeatures are the substitution features applied by the shaper.
+var gsubFeatures = []feature{
+	{"ccmp", maskGlobal},
+	{"locl", maskGlobal},
+	{"isol", maskIsol},
+	{"fina", maskFina},
+	{"medi", maskMedi},
+	{"init", maskInit},
+	{"rlig", maskGlobal},
+	{"rclt", maskGlobal},
+	{"calt", maskGlobal},
+	{"liga", maskGlobal},
+	{"clig", maskGlobal},
+}
+
+// gposFeatures are the positioning features applied by the shaper.
+var gposFeatures = []feature{
+	{"kern", maskGlobal},
+	{"mark", maskGlobal},
+	{"mkmk", maskGlobal},
+	{"dist", maskGlobal},
+	{"abvm", maskGlobal},
+	{"blwm", maskGlobal},
+}
+
+var kernFeatures = []feature{
+	{"kern", maskGlobal},
+}
+
+// scripts maps Unicode scripts to OpenType script tags.
+var scripts = []struct {
+	table *unicode.RangeTable
+	tag   string
+}{
+	{unicode.Latin, "latn"},
+	{unicode.Arabic, "arab"},
+	{unicode.Hebrew, "hebr"},
+	{unicode.Cyrillic, "cyrl"},
+	{unicode.Greek, "grek"},
+	{unicode.Armenian, "armn"},
+	{unicode.Georgian, "geor"},
+	{unicode.Syriac, "syrc"},
+	{unicode.Thaana, "thaa"},
+	{unicode.Thai, "thai"},
+	{unicode.Lao, "lao "},
+	{unicode.Devanagari, "deva"},
+	{unicode.Bengali, "beng"},
+	{unicode.Tamil, "taml"},
+	{unicode.Han, "hani"},
+	{unicode.Hiragana, "kana"},
+	{unicode.Katakana, "kana"},
+	{unicode.Hangul, "hang"},
+}
+
+// scriptTag returns the OpenType script tag of r, or the empty
+// string for runes common to several scripts.
+func scriptTag(r rune) string {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' {
+			return "latn"
+		}
+		return ""
+	}
+	for _, s := range scripts {
+		if unicode.Is(s.table, r) {
+			return s.tag
+		}
+	}
+	return ""
+}
+
+// shape maps the runes of a text to glyphs and applies the GSUB and
+// GPOS features of the font. The glyphs are in logical order, and the
+// text of every paragraph is shaped in runs of the same script and
+// direction.
+func (f *opentype) shape(buf *sfnt.Buffer, ppem fixed.Int26_6, runes []rune) []text.Glyph {
+	var glyphs []text.Glyph
+	for len(runes) > 0 {
+		n := len(runes)
+		for i, r := range runes {
+			if r == '\n' {
+				n = i
+				break
+			}
+		}
+		glyphs = f.shapeParagraph(glyphs, buf, ppem, runes[:n])
+		if n < len(runes) {
+			// The newline is zero width.
+			glyphs = append(glyphs, text.Glyph{Rune: '\n', Len: 1})
+			n++
+		}
+		runes = runes[n:]
+	}
+	return glyphs
+}
+
+// shapeParagraph appends the glyphs of a paragraph to glyphs.
+func (f *opentype) shapeParagraph(glyphs []text.Glyph, buf *sfnt.Buffer, ppem fixed.Int26_6, runes []rune) []text.Glyph {
+	levels, _ := bidi.Paragraph(runes)
+	tags := make([]string, len(runes))
+	tag := ""
+	for i, r := range runes {
+		if t := scriptTag(r); t != "" {
+			tag = t
+		}
+		tags[i] = tag
+	}
+	// Common runes before the first script take its script.
+	for i := range tags {
+		if tags[i] != "" {
+			for j := 0; j < i; j++ {
+				tags[j] = tags[i]
+			}
+			break
+		}
+	}
+	for start := 0; start < len(runes); {
+		end := start + 1
+		for end < len(runes) && levels[end] == levels[start] && tags[end] == tags[start] {
+			end++
+		}
+		glyphs = append(glyphs, f.shapeRun(buf, ppem, runes[start:end], levels[start], tags[start])...)
+		start = end
+	}
+	return glyphs
+}
+
+// shapeRun shapes a run of runes of the same script and embedding
+// level.
+func (f *opentype) shapeRun(buf *sfnt.Buffer, ppem fixed.Int26_6, runes []rune, level uint8, script string) []text.Glyph {
+	if script == "" {
+		script = "DFLT"
+	}
+	s := &runShaper{
+		gdef:   new(gdef),
+		glyphs: make([]glyphInfo, len(runes)),
+	}
+	tables := f.Tables
+	if tables != nil {
+		s.gdef = &tables.gdef
+	}
+	for i, r := range runes {
+		if level&1 == 1 {
+			r = bidi.Mirror(r)
+		}
+		id, _ := f.Font.GlyphIndex(buf, r)
+		s.glyphs[i] = glyphInfo{id: id, cluster: i, mask: maskGlobal, attach: -1}
+		s.glyphs[i].class = s.gdef.glyphClass(id)
+		if s.gdef.classes == nil {
+			s.glyphs[i].class = classBase
+			if unicode.Is(unicode.Mn, r) {
+				s.glyphs[i].class = classMark
+			}
+		}
+	}
+	if script == "arab" || script == "syrc" {
+		arabicForms(runes, s.glyphs)
+	}
+	kerned := false
+	if tables != nil {
+		if t := tables.gsub; t != nil {
+			s.applyLookups(t, t.features(script, gsubFeatures))
+		}
+		if t := tables.gpos; t != nil {
+			s.gpos = true
+			s.applyLookups(t, t.features(script, gposFeatures))
+			kerned = len(t.features(script, kernFeatures)) > 0
+		}
+	}
+	upem := fixed.Int26_6(f.Font.UnitsPerEm())
+	scale := func(v int) fixed.Int26_6 {
+		return fixed.Int26_6(int64(v) * int64(ppem) / int64(upem))
+	}
+	glyphs := make([]text.Glyph, len(s.glyphs))
+	for i, g := range s.glyphs {
+		adv, err := f.glyphAdvance(buf, g.id, ppem)
+		if err != nil {
+			adv = 0
+		}
+		if g.xAdv != 0 {
+			adv += f.round(scale(g.xAdv))
+		}
+		if g.attach != -1 {
+			adv = 0
+		}
+		if !kerned && i > 0 && g.class != classMark {
+			// Fall back to the kern table.
+			if k, err := f.Font.Kern(buf, s.glyphs[i-1].id, g.id, ppem, f.Hinting); err == nil {
+				glyphs[i-1].Advance += k
+			}
+		}
+		glyphs[i] = text.Glyph{
+			ID:      text.GlyphID(g.id),
+			Rune:    runes[g.cluster],
+			Advance: adv,
+		}
+	}
+	// Position the glyphs and record the text of the clusters.
+	for i, g := range s.glyphs {
+		off := fixed.Point26_6{X: scale(g.xOff), Y: -scale(g.yOff)}
+		if j := g.attach; j != -1 {
+			// Attached marks are positioned relative to the
+			// glyph they are attached to.
+			off = off.Add(glyphs[j].Offset)
+			if level&1 == 0 {
+				for k := j; k < i; k++ {
+					off.X -= glyphs[k].Advance
+				}
+			} else {
+				for k := j + 1; k <= i; k++ {
+					off.X += glyphs[k].Advance
+				}
+			}
+		}
+		glyphs[i].Offset = off
+		if i > 0 && s.glyphs[i-1].cluster == g.cluster {
+			glyphs[i].Continuation = true
+			continue
+		}
+		end := len(runes)
+		for _, g2 := range s.glyphs[i+1:] {
+			if g2.cluster != g.cluster {
+				end = g2.cluster
+				break
+			}
+		}
+		for _, r := range runes[g.cluster:end] {
+			glyphs[i].Len += utf8.RuneLen(r)
+		}
+	}
+	return glyphs
+}
+
+// round rounds the horizontal distance v to whole pixels if the font
+// is fully hinted.
+func (f *opentype) round(v fixed.Int26_6) fixed.Int26_6 {
+	if f.Hinting != font.HintingFull {
+		return v
+	}
+	return fixed.I(v.Round())
+}
+
+// roundY rounds the vertical distance v to whole pixels if the font
+// is hinted.
+func (f *opentype) roundY(v fixed.Int26_6) fixed.Int26_6 {
+	if f.Hinting == font.HintingNone {
+		return v
+	}
+	return fixed.I(v.Round())
+}
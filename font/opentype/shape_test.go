@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"strings"
+	"testing"
+
+	"gioui.org/text"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// u16s encodes values as a sequence of big endian 16-bit values.
+func u16s(vals ...int) table {
+	t := make(table, 0, 2*len(vals))
+	for _, v := range vals {
+		t = append(t, byte(v>>8), byte(v))
+	}
+	return t
+}
+
+// lookupTableData returns a GSUB or GPOS table with a single feature
+// for the default script, with a single lookup with a single
+// subtable.
+func lookupTableData(feature string, typ int, subtable table) table {
+	var t table
+	// Header: version, script list, feature list and lookup list.
+	t = append(t, u16s(1, 0, 10, 30, 44)...)
+	// Script list with the default script.
+	t = append(t, u16s(1)...)
+	t = append(t, "DFLT"...)
+	t = append(t, u16s(8)...)
+	// Script with a default language system.
+	t = append(t, u16s(4, 0)...)
+	// Language system with feature 0.
+	t = append(t, u16s(0, 0xffff, 1, 0)...)
+	// Feature list.
+	t = append(t, u16s(1)...)
+	t = append(t, feature...)
+	t = append(t, u16s(8)...)
+	// Feature with lookup 0.
+	t = append(t, u16s(0, 1, 0)...)
+	// Lookup list.
+	t = append(t, u16s(1, 4)...)
+	// Lookup.
+	t = append(t, u16s(typ, 0, 1, 8)...)
+	return append(t, subtable...)
+}
+
+func newTestShaper(ids ...sfnt.GlyphIndex) *runShaper {
+	s := &runShaper{gdef: new(gdef)}
+	for i, id := range ids {
+		s.glyphs = append(s.glyphs, glyphInfo{id: id, cluster: i, mask: maskGlobal, class: classBase, attach: -1})
+	}
+	return s
+}
+
+func TestLigature(t *testing.T) {
+	// Ligature subtable substituting glyph 10 for glyphs 1 and 2.
+	sub := u16s(
+		1, 8, 1, 14,
+		// Coverage of glyph 1.
+		1, 1, 1,
+		// Ligature set.
+		1, 4,
+		// Ligature.
+		10, 2, 2,
+	)
+	gsub := parseLookupTable(lookupTableData("liga", gsubLigature, sub), gsubExtension)
+	s := newTestShaper(1, 2, 3, 1)
+	s.applyLookups(gsub, gsub.features("latn", gsubFeatures))
+	exp := []glyphInfo{
+		{id: 10, cluster: 0},
+		{id: 3, cluster: 2},
+		{id: 1, cluster: 3},
+	}
+	if len(s.glyphs) != len(exp) {
+		t.Fatalf("got %d glyphs, expected %d", len(s.glyphs), len(exp))
+	}
+	for i, g := range s.glyphs {
+		if g.id != exp[i].id || g.cluster != exp[i].cluster {
+			t.Errorf("glyph %d: got id %d in cluster %d, expected id %d in cluster %d", i, g.id, g.cluster, exp[i].id, exp[i].cluster)
+		}
+	}
+}
+
+func TestPairKerning(t *testing.T) {
+	// Pair adjustment subtable moving glyph 3 closer to glyph 10.
+	sub := u16s(
+		1, 12, 0x4, 0, 1, 18,
+		// Coverage of glyph 10.
+		1, 1, 10,
+		// Pair set with the advance adjustment of the first glyph.
+		1, 3, 0xffce,
+	)
+	gpos := parseLookupTable(lookupTableData("kern", gposPair, sub), gposExtension)
+	s := newTestShaper(10, 3, 10)
+	s.gpos = true
+	s.applyLookups(gpos, gpos.features("latn", gposFeatures))
+	for i, exp := range []int{-50, 0, 0} {
+		if got := s.glyphs[i].xAdv; got != exp {
+			t.Errorf("glyph %d: got advance adjustment %d, expected %d", i, got, exp)
+		}
+	}
+}
+
+func TestMarkAttachment(t *testing.T) {
+	tests := []struct {
+		typ  int
+		sub  table
+		x, y int
+	}{
+		{
+			typ: gposMarkBase,
+			sub: u16s(
+				1, 12, 18, 1, 24, 36,
+				// Coverage of mark 20 and base 10.
+				1, 1, 20,
+				1, 1, 10,
+				// Mark array with the anchor (100, 0).
+				1, 0, 6,
+				1, 100, 0,
+				// Base array with the anchor (300, 700).
+				1, 4,
+				1, 300, 700,
+			),
+			x: 200, y: 700,
+		},
+		{
+			typ: gposMarkLigature,
+			sub: u16s(
+				1, 12, 18, 1, 24, 36,
+				// Coverage of mark 20 and ligature 10.
+				1, 1, 20,
+				1, 1, 10,
+				// Mark array with the anchor (100, 0).
+				1, 0, 6,
+				1, 100, 0,
+				// Ligature array with a ligature of two
+				// components with the anchors (100, 600) and
+				// (400, 600).
+				1, 4,
+				2, 6, 12,
+				1, 100, 600,
+				1, 400, 600,
+			),
+			// Marks attach to the last component.
+			x: 300, y: 600,
+		},
+	}
+	for _, test := range tests {
+		gpos := parseLookupTable(lookupTableData("mark", test.typ, test.sub), gposExtension)
+		s := newTestShaper(10, 20)
+		s.glyphs[1].class = classMark
+		s.gpos = true
+		s.applyLookups(gpos, gpos.features("latn", gposFeatures))
+		if g := s.glyphs[0]; g.attach != -1 || g.xOff != 0 || g.yOff != 0 {
+			t.Errorf("type %d: got base attached to %d at (%d, %d)", test.typ, g.attach, g.xOff, g.yOff)
+		}
+		if g := s.glyphs[1]; g.attach != 0 || g.xOff != test.x || g.yOff != test.y {
+			t.Errorf("type %d: got mark attached to %d at (%d, %d), expected 0 at (%d, %d)", test.typ, g.attach, g.xOff, g.yOff, test.x, test.y)
+		}
+	}
+}
+
+func TestLayoutKerning(t *testing.T) {
+	fnt := parseTestFont(t, nil)
+	a, v := glyphIndex(t, fnt, 'A'), glyphIndex(t, fnt, 'V')
+	// Pair adjustment subtable moving V closer to A.
+	sub := u16s(
+		1, 12, 0x4, 0, 1, 18,
+		1, 1, int(a),
+		1, int(v), -200,
+	)
+	fnt = parseTestFont(t, map[string][]byte{
+		"GPOS": lookupTableData("kern", gposPair, sub),
+	})
+	// Compare unrounded advances.
+	face := fnt.Hinted(text.HintingNone)
+	ppem := fixed.I(20)
+	advances := func(str string) []fixed.Int26_6 {
+		lines, err := face.Layout(ppem, 1000, strings.NewReader(str))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var advs []fixed.Int26_6
+		for _, g := range lines[0].Layout {
+			advs = append(advs, g.Advance)
+		}
+		return advs
+	}
+	plain := advances("A")[0]
+	kern := fixed.Int26_6(-200 * int64(ppem) / int64(fnt.font.UnitsPerEm()))
+	if got, exp := advances("AV")[0], plain+kern; got != exp {
+		t.Errorf("got kerned advance %v, expected %v", got, exp)
+	}
+	if got := advances("AA")[0]; got != plain {
+		t.Errorf("got advance %v for an unkerned pair, expected %v", got, plain)
+	}
+}
+
+func TestArabicForms(t *testing.T) {
+	tests := []struct {
+		text  string
+		forms []uint32
+	}{
+		{"بسم", []uint32{maskInit, maskMedi, maskFina}},
+		// Alef doesn't join the following letter.
+		{"اب", []uint32{maskIsol, maskIsol}},
+		{"با", []uint32{maskInit, maskFina}},
+		// Marks are transparent.
+		{"بِسم", []uint32{maskInit, 0, maskMedi, maskFina}},
+	}
+	for _, test := range tests {
+		runes := []rune(test.text)
+		glyphs := make([]glyphInfo, len(runes))
+		arabicForms(runes, glyphs)
+		for i, g := range glyphs {
+			if g.mask != test.forms[i] {
+				t.Errorf("%q: rune %d: got mask %#x, expected %#x", test.text, i, g.mask, test.forms[i])
+			}
+		}
+	}
+}
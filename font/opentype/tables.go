@@ -0,0 +1,475 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+// table is the data of an OpenType table or of a part of it. Reads
+// outside the data return zero, so malformed tables are harmless.
+type table []byte
+
+// layoutTables are the tables for glyph substitution and positioning
+// of a font, along with the metrics of its decoration lines and its
+// color glyph tables.
+type layoutTables struct {
+	gdef gdef
+	gsub *lookupTable
+	gpos *lookupTable
+	// underline and underlineSize are the position and thickness
+	// of the underline from the post table, and strikeout and
+	// strikeoutSize the position and thickness of the strikeout from
+	// the OS/2 table, in font units. Positions are relative to the
+	// baseline with positive Y up.
+	underline, underlineSize int16
+	strikeout, strikeoutSize int16
+	// colr and cpal are the layered color glyphs and their
+	// palettes.
+	colr, cpal table
+	// cblc and cbdt are the location and data of color bitmap
+	// glyphs, and sbix the color bitmap glyphs in Apple's format.
+	cblc, cbdt table
+	sbix       table
+	// axes are the variation axes from the fvar table, avar maps
+	// their coordinates and gvar varies the glyph outlines of glyf
+	// located by loca. The glyf and loca tables are located only for
+	// variable fonts, and their glyphs are read on demand.
+	axes       []axis
+	avar, gvar table
+	glyf, loca tableData
+	// longLoca is set if loca has 32-bit offsets.
+	longLoca bool
+}
+
+// tableData is a table read on demand from the font source.
+type tableData struct {
+	src       io.ReaderAt
+	off, size int64
+}
+
+// read returns the n bytes of the table from off, or nil if the table
+// is too short or they fail to read.
+func (t tableData) read(off, n int64) table {
+	if t.src == nil || off < 0 || n < 0 || off+n > t.size {
+		return nil
+	}
+	data := make(table, n)
+	if _, err := t.src.ReadAt(data, t.off+off); err != nil {
+		return nil
+	}
+	return data
+}
+
+// gdef is the glyph definition table.
+type gdef struct {
+	classes       table
+	attachClasses table
+	markSets      table
+}
+
+// lookupTable is a GSUB or GPOS table.
+type lookupTable struct {
+	data    table
+	lookups []lookup
+	// refs caches the lookups of features, keyed by the script
+	// and feature tags.
+	refs map[string][]lookupRef
+}
+
+type lookup struct {
+	typ  uint16
+	flag uint16
+	// markSet is the mark filtering set of the lookup.
+	markSet   uint16
+	subtables []table
+}
+
+// feature is an OpenType feature and the mask of the glyphs it
+// applies to.
+type feature struct {
+	tag  string
+	mask uint32
+}
+
+// lookupRef is a lookup and the mask of the glyphs it applies to.
+type lookupRef struct {
+	index int
+	mask  uint32
+}
+
+// Glyph classes.
+const (
+	classBase      = 1
+	classLigature  = 2
+	classMark      = 3
+	classComponent = 4
+)
+
+// Lookup flags.
+const (
+	flagIgnoreBase     = 0x0002
+	flagIgnoreLigature = 0x0004
+	flagIgnoreMarks    = 0x0008
+	flagMarkSet        = 0x0010
+	flagMarkAttachType = 0xff00
+)
+
+// Extension lookup types.
+const (
+	gsubExtension = 7
+	gposExtension = 9
+)
+
+// maxTableSize limits the size of tables read from fonts.
+const maxTableSize = 1 << 24
+
+func (t table) u8(off int) uint8 {
+	if off < 0 || off >= len(t) {
+		return 0
+	}
+	return t[off]
+}
+
+func (t table) u16(off int) uint16 {
+	if off < 0 || off+2 > len(t) {
+		return 0
+	}
+	return binary.BigEndian.Uint16(t[off:])
+}
+
+func (t table) i16(off int) int16 {
+	return int16(t.u16(off))
+}
+
+func (t table) u32(off int) uint32 {
+	if off < 0 || off+4 > len(t) {
+		return 0
+	}
+	return binary.BigEndian.Uint32(t[off:])
+}
+
+func (t table) tag(off int) string {
+	if off < 0 || off+4 > len(t) {
+		return ""
+	}
+	return string(t[off : off+4])
+}
+
+// at returns the data from off.
+func (t table) at(off int) table {
+	if off < 0 || off > len(t) {
+		return nil
+	}
+	return t[off:]
+}
+
+// slice returns the n bytes of data from off, or nil if t is too
+// short.
+func (t table) slice(off, n int) table {
+	if off < 0 || n < 0 || off+n > len(t) {
+		return nil
+	}
+	return t[off : off+n]
+}
+
+// sub returns the table at the 16-bit offset stored at off, or nil
+// for null offsets.
+func (t table) sub(off int) table {
+	o := int(t.u16(off))
+	if o == 0 {
+		return nil
+	}
+	return t.at(o)
+}
+
+// coverageIndex returns the index of g in the coverage table t, or
+// -1 if t doesn't cover g.
+func (t table) coverageIndex(g sfnt.GlyphIndex) int {
+	switch t.u16(0) {
+	case 1:
+		n := int(t.u16(2))
+		i := sort.Search(n, func(i int) bool {
+			return sfnt.GlyphIndex(t.u16(4+2*i)) >= g
+		})
+		if i < n && sfnt.GlyphIndex(t.u16(4+2*i)) == g {
+			return i
+		}
+	case 2:
+		n := int(t.u16(2))
+		i := sort.Search(n, func(i int) bool {
+			return sfnt.GlyphIndex(t.u16(4+6*i+2)) >= g
+		})
+		if i < n {
+			r := 4 + 6*i
+			if start := sfnt.GlyphIndex(t.u16(r)); start <= g {
+				return int(t.u16(r+4)) + int(g-start)
+			}
+		}
+	}
+	return -1
+}
+
+// class returns the class of g in the class definition table t.
+func (t table) class(g sfnt.GlyphIndex) int {
+	switch t.u16(0) {
+	case 1:
+		start := sfnt.GlyphIndex(t.u16(2))
+		if g >= start && int(g-start) < int(t.u16(4)) {
+			return int(t.u16(6 + 2*int(g-start)))
+		}
+	case 2:
+		n := int(t.u16(2))
+		i := sort.Search(n, func(i int) bool {
+			return sfnt.GlyphIndex(t.u16(4+6*i+2)) >= g
+		})
+		if i < n {
+			r := 4 + 6*i
+			if sfnt.GlyphIndex(t.u16(r)) <= g {
+				return int(t.u16(r + 4))
+			}
+		}
+	}
+	return 0
+}
+
+// fontOffset returns the offset of the table directory of font i of
+// the font file or collection in src.
+func fontOffset(src io.ReaderAt, i int) (int64, error) {
+	var hdr [12]byte
+	if _, err := src.ReadAt(hdr[:], 0); err != nil {
+		return 0, err
+	}
+	if string(hdr[:4]) != "ttcf" {
+		return 0, nil
+	}
+	if n := binary.BigEndian.Uint32(hdr[8:]); uint32(i) >= n {
+		return 0, errors.New("opentype: font index out of range")
+	}
+	var off [4]byte
+	if _, err := src.ReadAt(off[:], 12+4*int64(i)); err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint32(off[:])), nil
+}
+
+// readLayoutTables reads the GDEF, GSUB and GPOS tables, the
+// decoration metrics of the post and OS/2 tables, the color glyph
+// tables and the variation tables of the font with its table directory
+// at off in src.
+func readLayoutTables(src io.ReaderAt, off int64) (*layoutTables, error) {
+	var hdr [12]byte
+	if _, err := src.ReadAt(hdr[:], off); err != nil {
+		return nil, err
+	}
+	n := int(binary.BigEndian.Uint16(hdr[4:]))
+	dir := make([]byte, 16*n)
+	if _, err := src.ReadAt(dir, off+12); err != nil {
+		return nil, err
+	}
+	variable := false
+	for i := 0; i < n; i++ {
+		if table(dir[16*i:]).tag(0) == "gvar" {
+			variable = true
+		}
+	}
+	t := new(layoutTables)
+	for i := 0; i < n; i++ {
+		rec := table(dir[16*i:])
+		tag := rec.tag(0)
+		size := rec.u32(12)
+		switch tag {
+		case "GDEF", "GSUB", "GPOS", "COLR", "CPAL", "CBLC", "CBDT", "sbix",
+			"fvar", "avar", "gvar":
+		case "post", "OS/2":
+			// Only the decoration metrics are needed.
+			if size > 32 {
+				size = 32
+			}
+		case "glyf", "loca":
+			if variable {
+				d := tableData{src: src, off: int64(rec.u32(8)), size: int64(size)}
+				if tag == "glyf" {
+					t.glyf = d
+				} else {
+					t.loca = d
+				}
+			}
+			continue
+		case "head":
+			if !variable {
+				continue
+			}
+			// Only the format of loca is needed.
+			if size > 54 {
+				size = 54
+			}
+		default:
+			continue
+		}
+		if size > maxTableSize {
+			// The tables are optional. Leave out oversized tables,
+			// such as the bitmaps of large emoji fonts, rather
+			// than failing.
+			continue
+		}
+		data := make(table, size)
+		if _, err := src.ReadAt(data, int64(rec.u32(8))); err != nil {
+			return nil, err
+		}
+		switch tag {
+		case "GDEF":
+			t.gdef = parseGDEF(data)
+		case "GSUB":
+			t.gsub = parseLookupTable(data, gsubExtension)
+		case "GPOS":
+			t.gpos = parseLookupTable(data, gposExtension)
+		case "post":
+			t.underline, t.underlineSize = data.i16(8), data.i16(10)
+		case "OS/2":
+			t.strikeoutSize, t.strikeout = data.i16(26), data.i16(28)
+		case "COLR":
+			t.colr = data
+		case "CPAL":
+			t.cpal = data
+		case "CBLC":
+			t.cblc = data
+		case "CBDT":
+			t.cbdt = data
+		case "sbix":
+			t.sbix = data
+		case "fvar":
+			t.axes = parseAxes(data)
+		case "avar":
+			t.avar = data
+		case "gvar":
+			t.gvar = data
+		case "head":
+			t.longLoca = data.i16(50) != 0
+		}
+	}
+	return t, nil
+}
+
+func parseGDEF(t table) gdef {
+	g := gdef{
+		classes:       t.sub(4),
+		attachClasses: t.sub(10),
+	}
+	if t.u16(2) >= 2 {
+		g.markSets = t.sub(12)
+	}
+	return g
+}
+
+// glyphClass returns the class of g, or 0 if the font doesn't
+// define glyph classes.
+func (g *gdef) glyphClass(id sfnt.GlyphIndex) uint16 {
+	return uint16(g.classes.class(id))
+}
+
+// inMarkSet reports whether id is in the mark filtering set.
+func (g *gdef) inMarkSet(set uint16, id sfnt.GlyphIndex) bool {
+	if int(set) >= int(g.markSets.u16(2)) {
+		return false
+	}
+	cov := g.markSets.at(int(g.markSets.u32(4 + 4*int(set))))
+	return cov.coverageIndex(id) != -1
+}
+
+// parseLookupTable parses the lookup list of a GSUB or GPOS table.
+// Extension lookups are replaced by the lookups they wrap.
+func parseLookupTable(data table, extType uint16) *lookupTable {
+	t := &lookupTable{data: data}
+	list := data.sub(8)
+	n := int(list.u16(0))
+	t.lookups = make([]lookup, n)
+	for i := range t.lookups {
+		lt := list.sub(2 + 2*i)
+		l := &t.lookups[i]
+		typ := lt.u16(0)
+		l.typ = typ
+		l.flag = lt.u16(2)
+		nsub := int(lt.u16(4))
+		if l.flag&flagMarkSet != 0 {
+			l.markSet = lt.u16(6 + 2*nsub)
+		}
+		for j := 0; j < nsub; j++ {
+			st := lt.sub(6 + 2*j)
+			if typ == extType && st.u16(0) == 1 {
+				l.typ = st.u16(2)
+				st = st.at(int(st.u32(4)))
+			}
+			l.subtables = append(l.subtables, st)
+		}
+	}
+	return t
+}
+
+// features returns the lookups of a set of features for a script, in
+// lookup list order. The default language system of the script is
+// used, or of the default script if the font doesn't support script.
+func (t *lookupTable) features(script string, feats []feature) []lookupRef {
+	key := script
+	for _, f := range feats {
+		key += f.tag
+	}
+	if refs, ok := t.refs[key]; ok {
+		return refs
+	}
+	scripts := t.data.sub(4)
+	var langSys table
+	for _, tag := range []string{script, "DFLT", "dflt", "latn"} {
+		for i, n := 0, int(scripts.u16(0)); i < n; i++ {
+			if scripts.tag(2+6*i) == tag {
+				langSys = scripts.sub(2 + 6*i + 4).sub(0)
+				break
+			}
+		}
+		if langSys != nil {
+			break
+		}
+	}
+	masks := make(map[int]uint32)
+	list := t.data.sub(6)
+	addFeature := func(idx int, mask uint32) {
+		if idx >= int(list.u16(0)) {
+			return
+		}
+		f := list.sub(2 + 6*idx + 4)
+		for i, n := 0, int(f.u16(2)); i < n; i++ {
+			if l := int(f.u16(4 + 2*i)); l < len(t.lookups) {
+				masks[l] |= mask
+			}
+		}
+	}
+	if req := langSys.u16(2); langSys != nil && req != 0xffff {
+		addFeature(int(req), ^uint32(0))
+	}
+	for i, n := 0, int(langSys.u16(4)); i < n; i++ {
+		idx := int(langSys.u16(6 + 2*i))
+		tag := list.tag(2 + 6*idx)
+		for _, f := range feats {
+			if f.tag == tag {
+				addFeature(idx, f.mask)
+			}
+		}
+	}
+	refs := make([]lookupRef, 0, len(masks))
+	for idx, mask := range masks {
+		refs = append(refs, lookupRef{index: idx, mask: mask})
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].index < refs[j].index
+	})
+	if t.refs == nil {
+		t.refs = make(map[string][]lookupRef)
+	}
+	t.refs[key] = refs
+	return refs
+}
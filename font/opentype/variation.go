@@ -0,0 +1,764 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"math"
+
+	"gioui.org/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// axis is a variation axis of the fvar table.
+type axis struct {
+	tag           string
+	min, def, max float32
+}
+
+// glyphPoint is a point of a TrueType glyph outline, in font units.
+type glyphPoint struct {
+	x, y float32
+	on   bool
+}
+
+// outline is a TrueType glyph outline.
+type outline struct {
+	points []glyphPoint
+	// ends are the indices of the last points of the contours.
+	ends []int
+}
+
+// component is a glyph of a composite glyph, transformed by the
+// matrix [xx yx; xy yy] and offset by (dx, dy).
+type component struct {
+	glyph          sfnt.GlyphIndex
+	xx, xy, yx, yy float32
+	dx, dy         float32
+}
+
+// maxComponentDepth limits the nesting of composite glyphs.
+const maxComponentDepth = 8
+
+// Glyph flags of simple glyphs.
+const (
+	flagOnCurve      = 0x01
+	flagXShort       = 0x02
+	flagYShort       = 0x04
+	flagRepeat       = 0x08
+	flagXSameOrShort = 0x10
+	flagYSameOrShort = 0x20
+	flagOverlap      = 0x40
+)
+
+// Component flags of composite glyphs.
+const (
+	flagArgsAreWords = 0x0001
+	flagArgsAreXY    = 0x0002
+	flagScale        = 0x0008
+	flagMoreComps    = 0x0020
+	flagXYScale      = 0x0040
+	flagTwoByTwo     = 0x0080
+	flagInstructions = 0x0100
+)
+
+// Flags of glyph variation data and of its tuple variation headers.
+const (
+	flagSharedPoints  = 0x8000
+	tupleCountMask    = 0x0fff
+	flagEmbeddedPeak  = 0x8000
+	flagIntermediate  = 0x4000
+	flagPrivatePoints = 0x2000
+	tupleIndexMask    = 0x0fff
+)
+
+// Control bytes of packed point numbers and deltas.
+const (
+	pointsAreWords = 0x80
+	pointRunMask   = 0x7f
+	deltasAreZero  = 0x80
+	deltasAreWords = 0x40
+	deltaRunMask   = 0x3f
+)
+
+// Instance returns the instance of a variable font for a weight and a
+// style, or nil if the font has no variation axes. The weight selects
+// the position on the wght axis, where text.Bold selects the bold
+// weight 700, and the Italic style the position on the ital and slnt
+// axes. Other axes are left at their defaults.
+//
+// Only the glyph outlines and advances vary; the font metrics and the
+// positioning of glyphs by GPOS are those of the default instance.
+func (f *Font) Instance(weight text.Weight, style text.Style) text.Face {
+	t := f.tables
+	if len(t.axes) == 0 {
+		return nil
+	}
+	coords := make([]int16, len(t.axes))
+	for i, a := range t.axes {
+		v := a.def
+		switch a.tag {
+		case "wght":
+			switch weight {
+			case 0:
+			case text.Bold:
+				// The wght axis has the CSS weights, where
+				// text.Bold is semi-bold.
+				v = 700
+			default:
+				v = float32(weight)
+			}
+		case "ital":
+			if style == text.Italic {
+				v = a.max
+			}
+		case "slnt":
+			// Negative angles lean to the right.
+			if style == text.Italic {
+				v = a.min
+			}
+		}
+		coords[i] = t.normalize(i, v)
+	}
+	return &Font{font: f.font, tables: t, cache: f.cache, coords: coords, hinting: f.hinting}
+}
+
+// glyphAdvance returns the advance of g, varied by the coordinates of
+// the font.
+func (f *opentype) glyphAdvance(buf *sfnt.Buffer, g sfnt.GlyphIndex, ppem fixed.Int26_6) (fixed.Int26_6, error) {
+	if f.Coords == nil || f.Tables.gvar == nil {
+		return f.Font.GlyphAdvance(buf, g, ppem, f.Hinting)
+	}
+	// Measure the advance in font units.
+	upem := f.Font.UnitsPerEm()
+	adv, err := f.Font.GlyphAdvance(buf, g, fixed.Int26_6(upem), font.HintingNone)
+	if err != nil {
+		return 0, err
+	}
+	origin, advance := f.Tables.phantomDeltas(g, f.Coords)
+	v := (float32(adv) + advance - origin) * float32(ppem) / float32(upem)
+	return f.round(fixed.Int26_6(math.Round(float64(v)))), nil
+}
+
+// loadVariedGlyph returns the outline of g varied by the coordinates
+// of the font.
+func (f *opentype) loadVariedGlyph(ppem fixed.Int26_6, g sfnt.GlyphIndex) ([]sfnt.Segment, bool) {
+	o, origin, _, ok := f.Tables.loadOutline(g, f.Coords, 0)
+	if !ok {
+		return nil, false
+	}
+	return o.segments(ppem, int(f.Font.UnitsPerEm()), origin), true
+}
+
+// parseAxes parses the axis records of an fvar table.
+func parseAxes(fvar table) []axis {
+	off := int(fvar.u16(4))
+	n := int(fvar.u16(8))
+	size := int(fvar.u16(10))
+	var axes []axis
+	for i := 0; i < n; i++ {
+		rec := fvar.slice(off+size*i, 20)
+		if rec == nil {
+			break
+		}
+		axes = append(axes, axis{
+			tag: rec.tag(0),
+			min: fixed16(rec.u32(4)),
+			def: fixed16(rec.u32(8)),
+			max: fixed16(rec.u32(12)),
+		})
+	}
+	return axes
+}
+
+// fixed16 converts a 16.16 fixed point number.
+func fixed16(v uint32) float32 {
+	return float32(int32(v)) / 0x10000
+}
+
+// normalize maps the position v on axis i to the range [-1;1], in the
+// F2DOT14 format of variation coordinates.
+func (t *layoutTables) normalize(i int, v float32) int16 {
+	a := t.axes[i]
+	var n float32
+	switch {
+	case v < a.def && a.def > a.min:
+		if v < a.min {
+			v = a.min
+		}
+		n = (v - a.def) / (a.def - a.min)
+	case v > a.def && a.max > a.def:
+		if v > a.max {
+			v = a.max
+		}
+		n = (v - a.def) / (a.max - a.def)
+	}
+	n = t.mapAxis(i, n)
+	return int16(math.Round(float64(n) * 0x4000))
+}
+
+// mapAxis maps the normalized position v on axis i through the avar
+// segment map of the axis.
+func (t *layoutTables) mapAxis(i int, v float32) float32 {
+	avar := t.avar
+	if avar == nil || i >= int(avar.u16(6)) {
+		return v
+	}
+	off := 8
+	for j := 0; j < i; j++ {
+		off += 2 + 4*int(avar.u16(off))
+	}
+	n := int(avar.u16(off))
+	maps := avar.at(off + 2)
+	f2dot14 := func(k int) float32 {
+		return float32(maps.i16(k)) / 0x4000
+	}
+	for k := 1; k < n; k++ {
+		from0, from1 := f2dot14(4*(k-1)), f2dot14(4*k)
+		if v > from1 {
+			continue
+		}
+		to0, to1 := f2dot14(4*(k-1)+2), f2dot14(4*k+2)
+		if from1 == from0 {
+			return to1
+		}
+		return to0 + (v-from0)/(from1-from0)*(to1-to0)
+	}
+	return v
+}
+
+// glyphData returns the glyf data of g.
+func (t *layoutTables) glyphData(g sfnt.GlyphIndex) table {
+	var start, end int64
+	if t.longLoca {
+		loc := t.loca.read(4*int64(g), 8)
+		start, end = int64(loc.u32(0)), int64(loc.u32(4))
+	} else {
+		loc := t.loca.read(2*int64(g), 4)
+		start, end = 2*int64(loc.u16(0)), 2*int64(loc.u16(2))
+	}
+	return t.glyf.read(start, end-start)
+}
+
+// loadOutline returns the outline of g in font units with the
+// variations at coords applied, along with the horizontal variations
+// of its origin and advance.
+func (t *layoutTables) loadOutline(g sfnt.GlyphIndex, coords []int16, depth int) (o outline, origin, advance float32, ok bool) {
+	data := t.glyphData(g)
+	contours := int(data.i16(0))
+	var comps []component
+	var pts []glyphPoint
+	switch {
+	case len(data) == 0:
+	case contours >= 0:
+		o, ok = parseSimpleGlyph(data, contours)
+		if !ok {
+			return outline{}, 0, 0, false
+		}
+		pts = o.points
+	default:
+		comps, ok = parseCompositeGlyph(data)
+		if !ok {
+			return outline{}, 0, 0, false
+		}
+		// The variations of composite glyphs move their components.
+		pts = make([]glyphPoint, len(comps))
+		for i, c := range comps {
+			pts[i] = glyphPoint{x: c.dx, y: c.dy}
+		}
+	}
+	n := len(pts)
+	// Add the phantom points of the horizontal and vertical metrics.
+	pts = append(pts[:n:n], make([]glyphPoint, 4)...)
+	dx, dy := t.glyphDeltas(g, coords, pts, o.ends)
+	if dx != nil {
+		origin, advance = dx[n], dx[n+1]
+	}
+	if comps == nil {
+		if dx != nil {
+			for i := range o.points {
+				o.points[i].x += dx[i]
+				o.points[i].y += dy[i]
+			}
+		}
+		return o, origin, advance, true
+	}
+	if depth >= maxComponentDepth {
+		return outline{}, 0, 0, false
+	}
+	for i, c := range comps {
+		if dx != nil {
+			c.dx += dx[i]
+			c.dy += dy[i]
+		}
+		co, _, _, ok := t.loadOutline(c.glyph, coords, depth+1)
+		if !ok {
+			return outline{}, 0, 0, false
+		}
+		base := len(o.points)
+		for _, p := range co.points {
+			o.points = append(o.points, glyphPoint{
+				x:  c.xx*p.x + c.yx*p.y + c.dx,
+				y:  c.xy*p.x + c.yy*p.y + c.dy,
+				on: p.on,
+			})
+		}
+		for _, e := range co.ends {
+			o.ends = append(o.ends, base+e)
+		}
+	}
+	return o, origin, advance, true
+}
+
+// phantomDeltas returns the horizontal variations of the origin and
+// advance of g at coords.
+func (t *layoutTables) phantomDeltas(g sfnt.GlyphIndex, coords []int16) (origin, advance float32) {
+	data := t.glyphData(g)
+	n := 0
+	switch contours := int(data.i16(0)); {
+	case len(data) == 0:
+	case contours > 0:
+		n = int(data.u16(10+2*(contours-1))) + 1
+	case contours < 0:
+		comps, _ := parseCompositeGlyph(data)
+		n = len(comps)
+	}
+	// Phantom points are never interpolated, so the outline
+	// points are not needed.
+	dx, _ := t.glyphDeltas(g, coords, make([]glyphPoint, n+4), nil)
+	if dx == nil {
+		return 0, 0
+	}
+	return dx[n], dx[n+1]
+}
+
+// parseSimpleGlyph parses the outline of a glyph with contours.
+func parseSimpleGlyph(data table, contours int) (outline, bool) {
+	o := outline{ends: make([]int, contours)}
+	prev := -1
+	for i := range o.ends {
+		e := int(data.u16(10 + 2*i))
+		if e <= prev {
+			return outline{}, false
+		}
+		o.ends[i] = e
+		prev = e
+	}
+	off := 10 + 2*contours
+	off += 2 + int(data.u16(off))
+	o.points = make([]glyphPoint, prev+1)
+	flags := make([]uint8, len(o.points))
+	for i := 0; i < len(flags); {
+		f := data.u8(off)
+		off++
+		flags[i] = f
+		i++
+		if f&flagRepeat != 0 {
+			for r := data.u8(off); r > 0 && i < len(flags); r-- {
+				flags[i] = f
+				i++
+			}
+			off++
+		}
+	}
+	var x, y int
+	for i, f := range flags {
+		switch {
+		case f&flagXShort != 0:
+			d := int(data.u8(off))
+			off++
+			if f&flagXSameOrShort == 0 {
+				d = -d
+			}
+			x += d
+		case f&flagXSameOrShort == 0:
+			x += int(data.i16(off))
+			off += 2
+		}
+		o.points[i].x = float32(x)
+		o.points[i].on = f&flagOnCurve != 0
+	}
+	for i, f := range flags {
+		switch {
+		case f&flagYShort != 0:
+			d := int(data.u8(off))
+			off++
+			if f&flagYSameOrShort == 0 {
+				d = -d
+			}
+			y += d
+		case f&flagYSameOrShort == 0:
+			y += int(data.i16(off))
+			off += 2
+		}
+		o.points[i].y = float32(y)
+	}
+	if off > len(data) {
+		return outline{}, false
+	}
+	return o, true
+}
+
+// parseCompositeGlyph parses the components of a composite glyph.
+// Components positioned by matching points are not supported and are
+// placed at the origin.
+func parseCompositeGlyph(data table) ([]component, bool) {
+	var comps []component
+	off := 10
+	for {
+		flags := data.u16(off)
+		c := component{glyph: sfnt.GlyphIndex(data.u16(off + 2)), xx: 1, yy: 1}
+		off += 4
+		var dx, dy int
+		if flags&flagArgsAreWords != 0 {
+			dx, dy = int(data.i16(off)), int(data.i16(off+2))
+			off += 4
+		} else {
+			dx, dy = int(int8(data.u8(off))), int(int8(data.u8(off+1)))
+			off += 2
+		}
+		if flags&flagArgsAreXY != 0 {
+			c.dx, c.dy = float32(dx), float32(dy)
+		}
+		f2dot14 := func(off int) float32 {
+			return float32(data.i16(off)) / 0x4000
+		}
+		switch {
+		case flags&flagScale != 0:
+			c.xx = f2dot14(off)
+			c.yy = c.xx
+			off += 2
+		case flags&flagXYScale != 0:
+			c.xx, c.yy = f2dot14(off), f2dot14(off+2)
+			off += 4
+		case flags&flagTwoByTwo != 0:
+			c.xx, c.xy, c.yx, c.yy = f2dot14(off), f2dot14(off+2), f2dot14(off+4), f2dot14(off+6)
+			off += 8
+		}
+		if off > len(data) {
+			return nil, false
+		}
+		comps = append(comps, c)
+		if flags&flagMoreComps == 0 {
+			return comps, true
+		}
+	}
+}
+
+// glyphDeltas returns the gvar deltas at coords of the points of g,
+// which include its phantom points. The deltas of outline points
+// without explicit deltas are interpolated from the points of their
+// contour, given by ends. It returns nil deltas if g doesn't vary.
+func (t *layoutTables) glyphDeltas(g sfnt.GlyphIndex, coords []int16, pts []glyphPoint, ends []int) (dx, dy []float32) {
+	gvar := t.gvar
+	var start, end int
+	if int(g) >= int(gvar.u16(12)) {
+		return nil, nil
+	}
+	if gvar.u16(14)&1 != 0 {
+		start, end = int(gvar.u32(20+4*int(g))), int(gvar.u32(24+4*int(g)))
+	} else {
+		start, end = 2*int(gvar.u16(20+2*int(g))), 2*int(gvar.u16(22+2*int(g)))
+	}
+	d := gvar.slice(int(gvar.u32(16))+start, end-start)
+	if d == nil {
+		return nil, nil
+	}
+	axisCount := int(gvar.u16(4))
+	shared := gvar.at(int(gvar.u32(8)))
+	n := len(pts)
+	dx, dy = make([]float32, n), make([]float32, n)
+	count := int(d.u16(0))
+	data := d.at(int(d.u16(2)))
+	var sharedPoints []int
+	if count&flagSharedPoints != 0 {
+		var k int
+		sharedPoints, k = readPoints(data)
+		data = data.at(k)
+	}
+	hdr, off := 4, 0
+	for i := 0; i < count&tupleCountMask; i++ {
+		size, idx := int(d.u16(hdr)), d.u16(hdr+2)
+		hdr += 4
+		var peak, lo, hi table
+		if idx&flagEmbeddedPeak != 0 {
+			peak = d.at(hdr)
+			hdr += 2 * axisCount
+		} else {
+			peak = shared.at(2 * axisCount * int(idx&tupleIndexMask))
+		}
+		if idx&flagIntermediate != 0 {
+			lo, hi = d.at(hdr), d.at(hdr+2*axisCount)
+			hdr += 4 * axisCount
+		}
+		tuple := data.slice(off, size)
+		off += size
+		s := tupleScalar(coords, axisCount, peak, lo, hi)
+		if s == 0 || tuple == nil {
+			continue
+		}
+		points := sharedPoints
+		if idx&flagPrivatePoints != 0 {
+			var k int
+			points, k = readPoints(tuple)
+			tuple = tuple.at(k)
+		}
+		np := n
+		if points != nil {
+			np = len(points)
+		}
+		xs, k := readDeltas(tuple, np)
+		ys, _ := readDeltas(tuple.at(k), np)
+		if points == nil {
+			for j := range dx {
+				dx[j] += s * xs[j]
+				dy[j] += s * ys[j]
+			}
+			continue
+		}
+		tx, ty := make([]float32, n), make([]float32, n)
+		touched := make([]bool, n)
+		for j, p := range points {
+			if p < n {
+				tx[p], ty[p] = xs[j], ys[j]
+				touched[p] = true
+			}
+		}
+		interpolateDeltas(pts, ends, tx, ty, touched)
+		for j := range dx {
+			dx[j] += s * tx[j]
+			dy[j] += s * ty[j]
+		}
+	}
+	return dx, dy
+}
+
+// tupleScalar returns the contribution at coords of a tuple variation
+// with its peak and optional intermediate region from lo to hi.
+func tupleScalar(coords []int16, axisCount int, peak, lo, hi table) float32 {
+	s := float32(1)
+	for i := 0; i < axisCount; i++ {
+		p := int(peak.i16(2 * i))
+		if p == 0 {
+			continue
+		}
+		v := 0
+		if i < len(coords) {
+			v = int(coords[i])
+		}
+		if v == p {
+			continue
+		}
+		if lo != nil {
+			start, end := int(lo.i16(2*i)), int(hi.i16(2*i))
+			if start > p || p > end || start < 0 && end > 0 {
+				// Invalid regions don't depend on the axis.
+				continue
+			}
+			if v < start || v > end {
+				return 0
+			}
+			if v < p {
+				s *= float32(v-start) / float32(p-start)
+			} else {
+				s *= float32(end-v) / float32(end-p)
+			}
+			continue
+		}
+		if v == 0 || (v < 0) != (p < 0) || v < 0 && v < p || v > 0 && v > p {
+			return 0
+		}
+		s *= float32(v) / float32(p)
+	}
+	return s
+}
+
+// readPoints reads packed point numbers and returns them along with
+// the number of bytes read. It returns nil points if the numbers
+// refer to all points.
+func readPoints(t table) ([]int, int) {
+	n := int(t.u8(0))
+	off := 1
+	if n == 0 {
+		return nil, off
+	}
+	if n&0x80 != 0 {
+		n = (n&0x7f)<<8 | int(t.u8(1))
+		off++
+	}
+	points := make([]int, 0, n)
+	p := 0
+	for len(points) < n && off < len(t) {
+		ctl := t.u8(off)
+		off++
+		for r := int(ctl&pointRunMask) + 1; r > 0 && len(points) < n; r-- {
+			if ctl&pointsAreWords != 0 {
+				p += int(t.u16(off))
+				off += 2
+			} else {
+				p += int(t.u8(off))
+				off++
+			}
+			points = append(points, p)
+		}
+	}
+	return points, off
+}
+
+// readDeltas reads n packed deltas and returns them along with the
+// number of bytes read.
+func readDeltas(t table, n int) ([]float32, int) {
+	deltas := make([]float32, 0, n)
+	off := 0
+	for len(deltas) < n && off < len(t) {
+		ctl := t.u8(off)
+		off++
+		for r := int(ctl&deltaRunMask) + 1; r > 0 && len(deltas) < n; r-- {
+			var d int
+			switch {
+			case ctl&deltasAreZero != 0:
+			case ctl&deltasAreWords != 0:
+				d = int(t.i16(off))
+				off += 2
+			default:
+				d = int(int8(t.u8(off)))
+				off++
+			}
+			deltas = append(deltas, float32(d))
+		}
+	}
+	// Malformed data leaves the missing deltas at zero.
+	return deltas[:n:n], off
+}
+
+// interpolateDeltas infers the deltas of the untouched points of every
+// contour from the nearest touched points before and after them.
+func interpolateDeltas(pts []glyphPoint, ends []int, dx, dy []float32, touched []bool) {
+	start := 0
+	for _, end := range ends {
+		if end >= len(pts) {
+			break
+		}
+		next := func(i int) int {
+			if i == end {
+				return start
+			}
+			return i + 1
+		}
+		var refs []int
+		for i := start; i <= end; i++ {
+			if touched[i] {
+				refs = append(refs, i)
+			}
+		}
+		for k, a := range refs {
+			b := refs[(k+1)%len(refs)]
+			for i := next(a); i != b; i = next(i) {
+				dx[i] = interpolate(pts[i].x, pts[a].x, pts[b].x, dx[a], dx[b])
+				dy[i] = interpolate(pts[i].y, pts[a].y, pts[b].y, dy[a], dy[b])
+			}
+		}
+		start = end + 1
+	}
+}
+
+// interpolate the delta of the coordinate v from the coordinates v1
+// and v2 with the deltas d1 and d2.
+func interpolate(v, v1, v2, d1, d2 float32) float32 {
+	if v1 == v2 {
+		if d1 == d2 {
+			return d1
+		}
+		return 0
+	}
+	if v1 > v2 {
+		v1, v2 = v2, v1
+		d1, d2 = d2, d1
+	}
+	switch {
+	case v <= v1:
+		return d1
+	case v >= v2:
+		return d2
+	}
+	return d1 + (v-v1)/(v2-v1)*(d2-d1)
+}
+
+// segments converts the outline to segments scaled to ppem, with the
+// origin moved right by origin font units and positive Y down. The
+// segments match those of sfnt.Font.LoadGlyph.
+func (o outline) segments(ppem fixed.Int26_6, upem int, origin float32) []sfnt.Segment {
+	scale := float32(ppem) / float32(upem)
+	point := func(p glyphPoint) fixed.Point26_6 {
+		return fixed.Point26_6{
+			X: fixed.Int26_6(math.Round(float64((p.x - origin) * scale))),
+			Y: -fixed.Int26_6(math.Round(float64(p.y * scale))),
+		}
+	}
+	mid := func(p, q glyphPoint) glyphPoint {
+		return glyphPoint{x: (p.x + q.x) / 2, y: (p.y + q.y) / 2, on: true}
+	}
+	var segs []sfnt.Segment
+	add := func(op sfnt.SegmentOp, args ...glyphPoint) {
+		seg := sfnt.Segment{Op: op}
+		for i, a := range args {
+			seg.Args[i] = point(a)
+		}
+		segs = append(segs, seg)
+	}
+	start := 0
+	for _, end := range o.ends {
+		if end >= len(o.points) {
+			break
+		}
+		var first, firstOff, lastOff glyphPoint
+		hasFirst, hasFirstOff, hasLastOff := false, false, false
+		for _, p := range o.points[start : end+1] {
+			switch {
+			case !hasFirst:
+				switch {
+				case p.on:
+					first, hasFirst = p, true
+					add(sfnt.SegmentOpMoveTo, first)
+				case !hasFirstOff:
+					firstOff, hasFirstOff = p, true
+				default:
+					first, hasFirst = mid(firstOff, p), true
+					lastOff, hasLastOff = p, true
+					add(sfnt.SegmentOpMoveTo, first)
+				}
+			case !hasLastOff:
+				if p.on {
+					add(sfnt.SegmentOpLineTo, p)
+				} else {
+					lastOff, hasLastOff = p, true
+				}
+			case p.on:
+				add(sfnt.SegmentOpQuadTo, lastOff, p)
+				hasLastOff = false
+			default:
+				add(sfnt.SegmentOpQuadTo, lastOff, mid(lastOff, p))
+				lastOff = p
+			}
+		}
+		start = end + 1
+		if !hasFirst {
+			continue
+		}
+		// Close the contour.
+		if hasFirstOff && hasLastOff {
+			add(sfnt.SegmentOpQuadTo, lastOff, mid(lastOff, firstOff))
+			hasLastOff = false
+		}
+		switch {
+		case hasLastOff:
+			add(sfnt.SegmentOpQuadTo, lastOff, first)
+		case hasFirstOff:
+			add(sfnt.SegmentOpQuadTo, firstOff, first)
+		default:
+			add(sfnt.SegmentOpLineTo, first)
+		}
+	}
+	return segs
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"testing"
+
+	"gioui.org/text"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestVariedOutlines(t *testing.T) {
+	fnt := parseVariableFont(t, 0, nil)
+	inst := fnt.Instance(text.Normal, text.Regular).(*Font)
+	o := inst.face()
+	ppem := fixed.I(100)
+	// The outlines of the default instance match the outlines
+	// loaded by sfnt, up to rounding. Note that sfnt truncates
+	// implied on-curve points to whole font units.
+	for g := 0; g < fnt.font.NumGlyphs(); g++ {
+		exp, err := fnt.font.LoadGlyph(&fnt.buf, sfnt.GlyphIndex(g), ppem, nil)
+		if err != nil {
+			continue
+		}
+		got, ok := o.LoadGlyph(&inst.buf, ppem, sfnt.GlyphIndex(g))
+		if !ok {
+			t.Fatalf("glyph %d: failed to load outline", g)
+		}
+		if !sameSegments(got, exp, 2) {
+			t.Fatalf("glyph %d: got segments %v, expected %v", g, got, exp)
+		}
+	}
+}
+
+func TestGlyphVariations(t *testing.T) {
+	fnt := parseTestFont(t, nil)
+	l := glyphIndex(t, fnt, 'l')
+	n := pointCount(t, l)
+	// Move the outline of l right by 100 units and widen its
+	// advance by 50 units at the maximum weight.
+	dx := make([]int, n+4)
+	for i := 0; i < n; i++ {
+		dx[i] = 100
+	}
+	dx[n+1] = 50
+	fnt = parseVariableFont(t, l, dx)
+	upem := fixed.Int26_6(fnt.font.UnitsPerEm())
+	regular := fnt.Instance(text.Normal, text.Regular).(*Font)
+	bold := fnt.Instance(text.Bold, text.Regular).(*Font)
+	// Measure in font units.
+	measure := func(f *Font, g sfnt.GlyphIndex) (fixed.Int26_6, []sfnt.Segment) {
+		o := f.face()
+		o.Hinting = font.HintingNone
+		adv, err := o.glyphAdvance(&f.buf, g, upem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		segs, ok := o.LoadGlyph(&f.buf, upem, g)
+		if !ok {
+			t.Fatal("failed to load outline")
+		}
+		return adv, append([]sfnt.Segment(nil), segs...)
+	}
+	adv0, segs0 := measure(regular, l)
+	// Bold, at weight 700, is 60% of the way from the default to the
+	// maximum.
+	adv, segs := measure(bold, l)
+	if d := adv - adv0; d != 30 {
+		t.Errorf("got advance variation %d, expected 30", d)
+	}
+	for i, s := range segs0 {
+		for j := range s.Args[:segmentArgs(s.Op)] {
+			segs0[i].Args[j].X += 60
+		}
+	}
+	if !sameSegments(segs, segs0, 1) {
+		t.Errorf("got segments %v, expected %v", segs, segs0)
+	}
+	// Other glyphs don't vary.
+	i := glyphIndex(t, fnt, 'i')
+	adv0, segs0 = measure(regular, i)
+	adv, segs = measure(bold, i)
+	if adv != adv0 || !sameSegments(segs, segs0, 0) {
+		t.Error("invariable glyph varied")
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tables := &layoutTables{axes: []axis{{tag: "wght", min: 100, def: 400, max: 900}}}
+	tests := []struct {
+		v   float32
+		exp int16
+	}{
+		{0, -0x4000}, {100, -0x4000}, {250, -0x2000}, {400, 0}, {650, 0x2000}, {1000, 0x4000},
+	}
+	for _, test := range tests {
+		if got := tables.normalize(0, test.v); got != test.exp {
+			t.Errorf("normalize(%v) = %#x, expected %#x", test.v, got, test.exp)
+		}
+	}
+	// Map 0.5 to 0.75.
+	tables.avar = data(uint16(1), uint16(0), uint16(0), uint16(1), uint16(4),
+		int16(-0x4000), int16(-0x4000), int16(0), int16(0),
+		int16(0x2000), int16(0x3000), int16(0x4000), int16(0x4000))
+	if got, exp := tables.normalize(0, 650), int16(0x3000); got != exp {
+		t.Errorf("got mapped coordinate %#x, expected %#x", got, exp)
+	}
+	if got, exp := tables.normalize(0, 775), int16(0x3800); got != exp {
+		t.Errorf("got mapped coordinate %#x, expected %#x", got, exp)
+	}
+}
+
+func TestTupleScalar(t *testing.T) {
+	tests := []struct {
+		coord, peak, lo, hi int16
+		exp                 float32
+	}{
+		{0x4000, 0x4000, 0, 0, 1},
+		{0x2000, 0x4000, 0, 0, 0.5},
+		{-0x2000, 0x4000, 0, 0, 0},
+		{0, 0x4000, 0, 0, 0},
+		{0x1000, 0, 0, 0, 1},
+		// Intermediate regions.
+		{0x1000, 0x2000, 0, 0x4000, 0.5},
+		{0x3000, 0x2000, 0, 0x4000, 0.5},
+		{0x4000, 0x2000, 0x1000, 0x3000, 0},
+	}
+	for _, test := range tests {
+		peak := table(data(test.peak))
+		var lo, hi table
+		if test.hi != 0 {
+			lo, hi = data(test.lo), data(test.hi)
+		}
+		if got := tupleScalar([]int16{test.coord}, 1, peak, lo, hi); got != test.exp {
+			t.Errorf("scalar at %#x of peak %#x in [%#x;%#x] = %v, expected %v", test.coord, test.peak, test.lo, test.hi, got, test.exp)
+		}
+	}
+}
+
+func TestPackedData(t *testing.T) {
+	// Two runs of byte and word point numbers.
+	points, n := readPoints(data(uint8(4), uint8(1), uint8(2), uint8(3), uint8(0x81), uint16(300), uint16(1)))
+	if exp := []int{2, 5, 305, 306}; n != 9 || !equalInts(points, exp) {
+		t.Errorf("got points %v (%d bytes), expected %v (9 bytes)", points, n, exp)
+	}
+	if points, n := readPoints(data(uint8(0))); points != nil || n != 1 {
+		t.Errorf("got points %v, expected all points", points)
+	}
+	// Runs of zero, byte and word deltas.
+	deltas, n := readDeltas(data(uint8(0x81), uint8(1), int8(-3), int8(4), uint8(0x40), int16(-300)), 5)
+	if exp := []float32{0, 0, -3, 4, -300}; n != 7 || len(deltas) != len(exp) {
+		t.Errorf("got deltas %v (%d bytes), expected %v (7 bytes)", deltas, n, exp)
+	} else {
+		for i := range exp {
+			if deltas[i] != exp[i] {
+				t.Errorf("got deltas %v, expected %v", deltas, exp)
+				break
+			}
+		}
+	}
+}
+
+func TestInterpolateDeltas(t *testing.T) {
+	pts := []glyphPoint{{x: 0}, {x: 5}, {x: 10}, {x: 20}, {x: 5}}
+	dx := []float32{10, 0, 20, 0, 0}
+	dy := make([]float32, len(pts))
+	touched := []bool{true, false, true, false, false}
+	interpolateDeltas(pts, []int{3, 4}, dx, dy, touched)
+	// Point 1 lies between the touched points, point 3 beyond
+	// them, and the contour of point 4 has no touched points.
+	exp := []float32{10, 15, 20, 20, 0}
+	for i := range exp {
+		if dx[i] != exp[i] {
+			t.Errorf("got deltas %v, expected %v", dx, exp)
+			break
+		}
+	}
+}
+
+// parseVariableFont parses the Go Regular font with a wght axis from
+// 100 to 900, and the horizontal deltas dx, if any, for glyph g at the
+// maximum weight.
+func parseVariableFont(t *testing.T, g sfnt.GlyphIndex, dx []int) *Font {
+	t.Helper()
+	fnt := parseTestFont(t, nil)
+	n := fnt.font.NumGlyphs()
+	var glyph []byte
+	if dx != nil {
+		var xs, ys []byte
+		for i := 0; i < len(dx); i += 64 {
+			run := dx[i:]
+			if len(run) > 64 {
+				run = run[:64]
+			}
+			xs = append(xs, uint8(len(run)-1|deltasAreWords))
+			ys = append(ys, uint8(len(run)-1|deltasAreZero))
+			for _, d := range run {
+				xs = append(xs, data(int16(d))...)
+			}
+		}
+		// All points, followed by the deltas.
+		tuple := append(append([]byte{0}, xs...), ys...)
+		glyph = data(uint16(1), uint16(10),
+			uint16(len(tuple)), uint16(flagEmbeddedPeak|flagPrivatePoints), int16(0x4000),
+			tuple)
+	}
+	gvar := data(uint16(1), uint16(0), uint16(1), uint16(0), uint32(0),
+		uint16(n), uint16(1), uint32(20+4*(n+1)))
+	for i := 0; i <= n; i++ {
+		off := 0
+		if dx != nil && i > int(g) {
+			off = len(glyph)
+		}
+		gvar = append(gvar, data(uint32(off))...)
+	}
+	gvar = append(gvar, glyph...)
+	fnt = parseTestFont(t, map[string][]byte{
+		"fvar": data(uint16(1), uint16(0), uint16(16), uint16(2), uint16(1), uint16(20), uint16(0), uint16(8),
+			[]byte("wght"), uint32(100<<16), uint32(400<<16), uint32(900<<16), uint16(0), uint16(256)),
+		"gvar": gvar,
+	})
+	return fnt
+}
+
+// pointCount returns the number of points of the simple glyph g.
+func pointCount(t *testing.T, g sfnt.GlyphIndex) int {
+	t.Helper()
+	data := parseVariableFont(t, 0, nil).tables.glyphData(g)
+	contours := int(data.i16(0))
+	if contours <= 0 {
+		t.Fatal("not a simple glyph")
+	}
+	return int(data.u16(10+2*(contours-1))) + 1
+}
+
+func segmentArgs(op sfnt.SegmentOp) int {
+	switch op {
+	case sfnt.SegmentOpQuadTo:
+		return 2
+	case sfnt.SegmentOpCubeTo:
+		return 3
+	default:
+		return 1
+	}
+}
+
+func sameSegments(s1, s2 []sfnt.Segment, tolerance fixed.Int26_6) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	near := func(a, b fixed.Int26_6) bool {
+		d := a - b
+		return -tolerance <= d && d <= tolerance
+	}
+	for i := range s1 {
+		if s1[i].Op != s2[i].Op {
+			return false
+		}
+		for j := range s1[i].Args {
+			a, b := s1[i].Args[j], s2[i].Args[j]
+			if !near(a.X, b.X) || !near(a.Y, b.Y) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+)
+
+// sfntTable is a table of a decoded font.
+type sfntTable struct {
+	tag  string
+	data []byte
+}
+
+// sfntFont is a font of a decoded font file, with the indices of its
+// tables.
+type sfntFont struct {
+	flavor uint32
+	tables []int
+}
+
+// maxWebFontSize limits the size of decoded web fonts.
+const maxWebFontSize = 1 << 28
+
+var errInvalidWebFont = errors.New("opentype: invalid WOFF data")
+
+// decodeWebFont decodes src if it is WOFF or WOFF2 data. Other data
+// is returned unchanged.
+func decodeWebFont(src []byte) ([]byte, error) {
+	switch table(src).tag(0) {
+	case "wOFF":
+		return decodeWOFF(src)
+	case "wOF2":
+		return decodeWOFF2(src)
+	default:
+		return src, nil
+	}
+}
+
+// readWebFont is like decodeWebFont for data read from src.
+func readWebFont(src io.ReaderAt) (io.ReaderAt, error) {
+	var hdr [12]byte
+	if _, err := src.ReadAt(hdr[:], 0); err != nil {
+		// Leave the error to the SFNT parser.
+		return src, nil
+	}
+	if tag := string(hdr[:4]); tag != "wOFF" && tag != "wOF2" {
+		return src, nil
+	}
+	n := binary.BigEndian.Uint32(hdr[8:])
+	if n > maxWebFontSize {
+		return nil, errInvalidWebFont
+	}
+	data := make([]byte, n)
+	if _, err := src.ReadAt(data, 0); err != nil {
+		return nil, err
+	}
+	data, err := decodeWebFont(data)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// decodeWOFF decodes WOFF data to SFNT data.
+func decodeWOFF(src []byte) ([]byte, error) {
+	const hdrSize = 44
+	hdr := table(src).slice(0, hdrSize)
+	if hdr == nil || int(hdr.u32(8)) > len(src) || hdr.u16(14) != 0 {
+		return nil, errInvalidWebFont
+	}
+	if hdr.u32(16) > maxWebFontSize {
+		return nil, errInvalidWebFont
+	}
+	flavor := hdr.u32(4)
+	n := int(hdr.u16(12))
+	dir := table(src).slice(hdrSize, 20*n)
+	if dir == nil {
+		return nil, errInvalidWebFont
+	}
+	tables := make([]sfntTable, n)
+	fnt := sfntFont{flavor: flavor}
+	for i := range tables {
+		rec := dir[20*i:]
+		off, compLen, origLen := int(rec.u32(4)), int(rec.u32(8)), int(rec.u32(12))
+		data := table(src).slice(off, compLen)
+		if data == nil || compLen > origLen || origLen > maxWebFontSize {
+			return nil, errInvalidWebFont
+		}
+		if compLen < origLen {
+			var err error
+			data, err = inflate(data, origLen)
+			if err != nil {
+				return nil, err
+			}
+		}
+		tables[i] = sfntTable{tag: rec.tag(0), data: data}
+		fnt.tables = append(fnt.tables, i)
+	}
+	return writeSFNT(tables, []sfntFont{fnt}), nil
+}
+
+// inflate decompresses the zlib data of a table of n bytes.
+func inflate(data []byte, n int) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	out, err := ioutil.ReadAll(io.LimitReader(r, int64(n)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(out) != n {
+		return nil, errInvalidWebFont
+	}
+	return out, nil
+}
+
+// writeSFNT encodes the fonts and their tables to SFNT data, or to
+// an SFNT collection if there is more than one font. The tables are
+// stored once even if shared by several fonts.
+func writeSFNT(tables []sfntTable, fonts []sfntFont) []byte {
+	off := 0
+	if len(fonts) > 1 {
+		off = 12 + 4*len(fonts)
+	}
+	dirOffs := make([]int, len(fonts))
+	for i, f := range fonts {
+		dirOffs[i] = off
+		off += 12 + 16*len(f.tables)
+	}
+	tableOffs := make([]int, len(tables))
+	for i, t := range tables {
+		tableOffs[i] = off
+		off += (len(t.data) + 3) &^ 3
+	}
+	out := make([]byte, off)
+	if len(fonts) > 1 {
+		copy(out, "ttcf")
+		binary.BigEndian.PutUint32(out[4:], 0x00010000)
+		binary.BigEndian.PutUint32(out[8:], uint32(len(fonts)))
+		for i, o := range dirOffs {
+			binary.BigEndian.PutUint32(out[12+4*i:], uint32(o))
+		}
+	}
+	for i, f := range fonts {
+		dir := out[dirOffs[i]:]
+		n := len(f.tables)
+		// The search parameters of the table directory.
+		sel := 0
+		for 2<<uint(sel) <= n {
+			sel++
+		}
+		binary.BigEndian.PutUint32(dir, f.flavor)
+		binary.BigEndian.PutUint16(dir[4:], uint16(n))
+		binary.BigEndian.PutUint16(dir[6:], uint16(16<<uint(sel)))
+		binary.BigEndian.PutUint16(dir[8:], uint16(sel))
+		binary.BigEndian.PutUint16(dir[10:], uint16(16*n-16<<uint(sel)))
+		// Table records are sorted by tag.
+		idx := append([]int(nil), f.tables...)
+		sort.Slice(idx, func(i, j int) bool {
+			return tables[idx[i]].tag < tables[idx[j]].tag
+		})
+		for j, t := range idx {
+			rec := dir[12+16*j:]
+			copy(rec, tables[t].tag)
+			binary.BigEndian.PutUint32(rec[4:], checksum(tables[t]))
+			binary.BigEndian.PutUint32(rec[8:], uint32(tableOffs[t]))
+			binary.BigEndian.PutUint32(rec[12:], uint32(len(tables[t].data)))
+		}
+	}
+	for i, t := range tables {
+		copy(out[tableOffs[i]:], t.data)
+	}
+	return out
+}
+
+// checksum computes the checksum of a table.
+func checksum(t sfntTable) uint32 {
+	var sum uint32
+	data := t.data
+	for len(data) >= 4 {
+		sum += binary.BigEndian.Uint32(data)
+		data = data[4:]
+	}
+	var tail [4]byte
+	copy(tail[:], data)
+	sum += binary.BigEndian.Uint32(tail[:])
+	if t.tag == "head" {
+		// Skip the checksum adjustment.
+		sum -= table(t.data).u32(8)
+	}
+	return sum
+}
@@ -0,0 +1,616 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"github.com/andybalholm/brotli"
+)
+
+// woff2Entry is an entry of the WOFF2 table directory.
+type woff2Entry struct {
+	tag string
+	// length is the length of the decoded table.
+	length int
+	// size is the length of the table in the decompressed data.
+	size        int
+	transformed bool
+}
+
+// woff2Point is a point of a simple glyph, in font units.
+type woff2Point struct {
+	x, y int
+	on   bool
+}
+
+// stream reads the data types of WOFF2 data. Reading past the end of
+// the data sets err and returns zeros.
+type stream struct {
+	data []byte
+	pos  int
+	err  bool
+}
+
+// woff2Tags are the tags of the WOFF2 known table flags.
+var woff2Tags = [...]string{
+	"cmap", "head", "hhea", "hmtx", "maxp", "name", "OS/2", "post",
+	"cvt ", "fpgm", "glyf", "loca", "prep", "CFF ", "VORG", "EBDT",
+	"EBLC", "gasp", "hdmx", "kern", "LTSH", "PCLT", "VDMX", "vhea",
+	"vmtx", "BASE", "GDEF", "GPOS", "GSUB", "EBSC", "JSTF", "MATH",
+	"CBDT", "CBLC", "COLR", "CPAL", "SVG ", "sbix", "acnt", "avar",
+	"bdat", "bloc", "bsln", "cvar", "fdsc", "feat", "fmtx", "fvar",
+	"gvar", "hsty", "just", "lcar", "mort", "morx", "opbd", "prop",
+	"trak", "Zapf", "Silf", "Glat", "Gloc", "Feat", "Sill",
+}
+
+const (
+	// tagMask masks the known table index of a table directory entry.
+	tagMask = 0x3f
+	// transformShift is the shift of the transform version of a table
+	// directory entry.
+	transformShift = 6
+)
+
+// Flags of the hmtx transform.
+const (
+	noProportionalLSBs = 0x01
+	noMonospacedLSBs   = 0x02
+)
+
+var errInvalidWOFF2 = errors.New("opentype: invalid WOFF2 data")
+
+// decodeWOFF2 decodes WOFF2 data to SFNT data, or to an SFNT
+// collection.
+func decodeWOFF2(src []byte) ([]byte, error) {
+	s := &stream{data: src}
+	s.read(4) // Signature.
+	flavor := s.u32()
+	length := s.u32()
+	numTables := int(s.u16())
+	reserved := s.u16()
+	s.u32() // totalSfntSize.
+	compSize := int(s.u32())
+	// Skip the version, metadata and private data fields.
+	s.read(24)
+	if s.err || int(length) > len(src) || reserved != 0 {
+		return nil, errInvalidWOFF2
+	}
+	entries := make([]woff2Entry, numTables)
+	total := 0
+	for i := range entries {
+		flags := s.u8()
+		var tag string
+		if idx := flags & tagMask; idx < uint8(len(woff2Tags)) {
+			tag = woff2Tags[idx]
+		} else {
+			tag = string(s.read(4))
+		}
+		version := flags >> transformShift
+		e := woff2Entry{tag: tag, length: int(s.base128())}
+		switch tag {
+		case "glyf", "loca":
+			// Version 3 is the null transform.
+			if version != 0 && version != 3 {
+				return nil, errInvalidWOFF2
+			}
+			e.transformed = version == 0
+		case "hmtx":
+			if version > 1 {
+				return nil, errInvalidWOFF2
+			}
+			e.transformed = version == 1
+		default:
+			if version != 0 {
+				return nil, errInvalidWOFF2
+			}
+		}
+		e.size = e.length
+		if e.transformed {
+			e.size = int(s.base128())
+		}
+		total += e.size
+		if s.err || e.length > maxWebFontSize || total > maxWebFontSize {
+			return nil, errInvalidWOFF2
+		}
+		entries[i] = e
+	}
+	fonts := []sfntFont{{flavor: flavor}}
+	if string(src[4:8]) == "ttcf" {
+		s.u32() // Version.
+		fonts = make([]sfntFont, s.uint255())
+		for i := range fonts {
+			n := int(s.uint255())
+			fonts[i].flavor = s.u32()
+			for j := 0; j < n; j++ {
+				idx := int(s.uint255())
+				if idx >= numTables {
+					return nil, errInvalidWOFF2
+				}
+				fonts[i].tables = append(fonts[i].tables, idx)
+			}
+		}
+	} else {
+		for i := range entries {
+			fonts[0].tables = append(fonts[0].tables, i)
+		}
+	}
+	compressed := s.read(compSize)
+	if s.err || len(fonts) == 0 {
+		return nil, errInvalidWOFF2
+	}
+	r := brotli.NewReader(bytes.NewReader(compressed))
+	data, err := ioutil.ReadAll(io.LimitReader(r, int64(total)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != total {
+		return nil, errInvalidWOFF2
+	}
+	tables := make([]sfntTable, numTables)
+	for i, e := range entries {
+		tables[i] = sfntTable{tag: e.tag, data: data[:e.size]}
+		data = data[e.size:]
+	}
+	if err := untransform(tables, entries, fonts); err != nil {
+		return nil, err
+	}
+	return writeSFNT(tables, fonts), nil
+}
+
+// untransform reverses the transforms of the glyf, loca and hmtx
+// tables of fonts.
+func untransform(tables []sfntTable, entries []woff2Entry, fonts []sfntFont) error {
+	done := make([]bool, len(tables))
+	// xMins are the minimum x coordinates of the glyphs of the
+	// decoded glyf tables.
+	xMins := make(map[int][]int16)
+	for _, f := range fonts {
+		find := func(tag string) int {
+			for _, t := range f.tables {
+				if tables[t].tag == tag {
+					return t
+				}
+			}
+			return -1
+		}
+		glyf, loca, hmtx := find("glyf"), find("loca"), find("hmtx")
+		if glyf != -1 && entries[glyf].transformed && !done[glyf] {
+			if loca == -1 || !entries[loca].transformed {
+				return errInvalidWOFF2
+			}
+			g, l, x, err := decodeGlyf(tables[glyf].data)
+			if err != nil {
+				return err
+			}
+			tables[glyf].data, tables[loca].data = g, l
+			xMins[glyf] = x
+			done[glyf], done[loca] = true, true
+		} else if loca != -1 && entries[loca].transformed && !done[loca] {
+			return errInvalidWOFF2
+		}
+		if hmtx != -1 && entries[hmtx].transformed && !done[hmtx] {
+			hhea, maxp := find("hhea"), find("maxp")
+			if glyf == -1 || xMins[glyf] == nil || hhea == -1 || maxp == -1 {
+				return errInvalidWOFF2
+			}
+			numHMetrics := int(table(tables[hhea].data).u16(34))
+			numGlyphs := int(table(tables[maxp].data).u16(4))
+			data, err := decodeHmtx(tables[hmtx].data, numGlyphs, numHMetrics, xMins[glyf])
+			if err != nil {
+				return err
+			}
+			tables[hmtx].data = data
+			done[hmtx] = true
+		}
+	}
+	return nil
+}
+
+// decodeGlyf reverses the transform of a glyf table. It returns the
+// glyf and loca tables and the minimum x coordinates of the glyphs.
+func decodeGlyf(data []byte) (glyf, loca []byte, xMins []int16, err error) {
+	s := &stream{data: data}
+	s.u16() // Reserved.
+	options := s.u16()
+	numGlyphs := int(s.u16())
+	longLoca := s.u16() != 0
+	var sizes [7]int
+	for i := range sizes {
+		sizes[i] = int(s.u32())
+	}
+	var streams [7]*stream
+	for i, n := range sizes {
+		streams[i] = &stream{data: s.read(n)}
+	}
+	nContours, nPoints, flags, glyphs, composites, bboxes, instrs := streams[0], streams[1], streams[2], streams[3], streams[4], streams[5], streams[6]
+	bitmap := bboxes.read(4 * ((numGlyphs + 31) / 32))
+	var overlaps []byte
+	if options&1 != 0 {
+		overlaps = s.read((numGlyphs + 7) / 8)
+	}
+	if s.err || bboxes.err {
+		return nil, nil, nil, errInvalidWOFF2
+	}
+	offs := make([]int, numGlyphs+1)
+	xMins = make([]int16, numGlyphs)
+	var pts []woff2Point
+	var ends []int
+	for g := 0; g < numGlyphs; g++ {
+		offs[g] = len(glyf)
+		n := int16(nContours.u16())
+		hasBBox := bitmap[g>>3]&(0x80>>uint(g&7)) != 0
+		var bbox [4]int16
+		if hasBBox {
+			for i := range bbox {
+				bbox[i] = int16(bboxes.u16())
+			}
+		}
+		switch {
+		case n == 0:
+			if hasBBox {
+				return nil, nil, nil, errInvalidWOFF2
+			}
+		case n > 0:
+			ends = ends[:0]
+			total := 0
+			for i := 0; i < int(n); i++ {
+				total += int(nPoints.uint255())
+				ends = append(ends, total-1)
+			}
+			pts = pts[:0]
+			var x, y int
+			for _, f := range flags.read(total) {
+				dx, dy := glyphs.triplet(f &^ 0x80)
+				x, y = x+dx, y+dy
+				pts = append(pts, woff2Point{x: x, y: y, on: f&0x80 == 0})
+			}
+			instr := instrs.read(int(glyphs.uint255()))
+			if !hasBBox {
+				bbox = pointsBounds(pts)
+			}
+			overlap := overlaps != nil && overlaps[g>>3]&(0x80>>uint(g&7)) != 0
+			glyf = appendSimpleGlyph(glyf, bbox, ends, pts, instr, overlap)
+		case n == -1:
+			if !hasBBox {
+				return nil, nil, nil, errInvalidWOFF2
+			}
+			comps, instructions := composites.components()
+			glyf = appendGlyphHeader(glyf, n, bbox)
+			glyf = append(glyf, comps...)
+			if instructions {
+				instr := instrs.read(int(glyphs.uint255()))
+				glyf = appendU16(glyf, uint16(len(instr)))
+				glyf = append(glyf, instr...)
+			}
+		default:
+			return nil, nil, nil, errInvalidWOFF2
+		}
+		xMins[g] = bbox[0]
+		// Pad glyphs for the alignment of loca offsets.
+		align := 2
+		if longLoca {
+			align = 4
+		}
+		for len(glyf)%align != 0 {
+			glyf = append(glyf, 0)
+		}
+		if len(glyf) > maxWebFontSize {
+			return nil, nil, nil, errInvalidWOFF2
+		}
+	}
+	offs[numGlyphs] = len(glyf)
+	for _, st := range streams {
+		if st.err {
+			return nil, nil, nil, errInvalidWOFF2
+		}
+	}
+	for _, o := range offs {
+		if longLoca {
+			loca = appendU32(loca, uint32(o))
+			continue
+		}
+		if o/2 > 0xffff {
+			return nil, nil, nil, errInvalidWOFF2
+		}
+		loca = appendU16(loca, uint16(o/2))
+	}
+	return glyf, loca, xMins, nil
+}
+
+// decodeHmtx reverses the transform of an hmtx table, where left side
+// bearings may be omitted and replaced by the xMins of the glyphs.
+func decodeHmtx(data []byte, numGlyphs, numHMetrics int, xMins []int16) ([]byte, error) {
+	s := &stream{data: data}
+	flags := s.u8()
+	if flags&^(noProportionalLSBs|noMonospacedLSBs) != 0 || flags == 0 ||
+		numHMetrics < 1 || numHMetrics > numGlyphs || len(xMins) < numGlyphs {
+		return nil, errInvalidWOFF2
+	}
+	advances := make([]uint16, numHMetrics)
+	for i := range advances {
+		advances[i] = s.u16()
+	}
+	lsbs := make([]int16, numGlyphs)
+	for i := range lsbs {
+		omitted := noMonospacedLSBs
+		if i < numHMetrics {
+			omitted = noProportionalLSBs
+		}
+		if flags&uint8(omitted) != 0 {
+			lsbs[i] = xMins[i]
+		} else {
+			lsbs[i] = int16(s.u16())
+		}
+	}
+	if s.err {
+		return nil, errInvalidWOFF2
+	}
+	var out []byte
+	for i, lsb := range lsbs {
+		if i < numHMetrics {
+			out = appendU16(out, advances[i])
+		}
+		out = appendU16(out, uint16(lsb))
+	}
+	return out, nil
+}
+
+// pointsBounds returns the bounding box of pts as xMin, yMin, xMax
+// and yMax.
+func pointsBounds(pts []woff2Point) [4]int16 {
+	if len(pts) == 0 {
+		return [4]int16{}
+	}
+	minX, minY, maxX, maxY := pts[0].x, pts[0].y, pts[0].x, pts[0].y
+	for _, p := range pts[1:] {
+		if p.x < minX {
+			minX = p.x
+		}
+		if p.x > maxX {
+			maxX = p.x
+		}
+		if p.y < minY {
+			minY = p.y
+		}
+		if p.y > maxY {
+			maxY = p.y
+		}
+	}
+	return [4]int16{int16(minX), int16(minY), int16(maxX), int16(maxY)}
+}
+
+// appendSimpleGlyph encodes a simple glyph.
+func appendSimpleGlyph(out []byte, bbox [4]int16, ends []int, pts []woff2Point, instr []byte, overlap bool) []byte {
+	out = appendGlyphHeader(out, int16(len(ends)), bbox)
+	for _, e := range ends {
+		out = appendU16(out, uint16(e))
+	}
+	out = appendU16(out, uint16(len(instr)))
+	out = append(out, instr...)
+	var pflags, xs, ys []byte
+	var x, y int
+	for i, p := range pts {
+		var f uint8
+		if p.on {
+			f |= flagOnCurve
+		}
+		if overlap && i == 0 {
+			f |= flagOverlap
+		}
+		var short, same uint8
+		short, same, xs = appendCoord(xs, p.x-x)
+		f |= short*flagXShort | same*flagXSameOrShort
+		short, same, ys = appendCoord(ys, p.y-y)
+		f |= short*flagYShort | same*flagYSameOrShort
+		x, y = p.x, p.y
+		pflags = append(pflags, f)
+	}
+	// Encode runs of flags.
+	for i := 0; i < len(pflags); {
+		f, n := pflags[i], 1
+		for i+n < len(pflags) && pflags[i+n] == f && n <= 255 {
+			n++
+		}
+		if n > 1 {
+			out = append(out, f|flagRepeat, uint8(n-1))
+		} else {
+			out = append(out, f)
+		}
+		i += n
+	}
+	out = append(out, xs...)
+	return append(out, ys...)
+}
+
+// appendCoord encodes the coordinate delta d of a simple glyph and
+// returns whether it is short and whether it is either the same or a
+// positive short delta.
+func appendCoord(out []byte, d int) (short, same uint8, res []byte) {
+	switch {
+	case d == 0:
+		return 0, 1, out
+	case d > -256 && d < 256:
+		if d > 0 {
+			return 1, 1, append(out, uint8(d))
+		}
+		return 1, 0, append(out, uint8(-d))
+	default:
+		return 0, 0, appendU16(out, uint16(d))
+	}
+}
+
+func appendGlyphHeader(out []byte, contours int16, bbox [4]int16) []byte {
+	out = appendU16(out, uint16(contours))
+	for _, v := range bbox {
+		out = appendU16(out, uint16(v))
+	}
+	return out
+}
+
+func appendU16(out []byte, v uint16) []byte {
+	return append(out, uint8(v>>8), uint8(v))
+}
+
+func appendU32(out []byte, v uint32) []byte {
+	return append(out, uint8(v>>24), uint8(v>>16), uint8(v>>8), uint8(v))
+}
+
+// read returns the next n bytes.
+func (s *stream) read(n int) []byte {
+	if s.err || n < 0 || s.pos+n > len(s.data) {
+		s.err = true
+		return nil
+	}
+	b := s.data[s.pos : s.pos+n]
+	s.pos += n
+	return b
+}
+
+func (s *stream) u8() uint8 {
+	if b := s.read(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (s *stream) u16() uint16 {
+	if b := s.read(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (s *stream) u32() uint32 {
+	if b := s.read(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+// base128 reads a UIntBase128 number.
+func (s *stream) base128() uint32 {
+	var v uint32
+	for i := 0; i < 5; i++ {
+		b := s.u8()
+		// Leading zeros and overflows are invalid.
+		if i == 0 && b == 0x80 || v&0xfe000000 != 0 {
+			s.err = true
+			return 0
+		}
+		v = v<<7 | uint32(b&0x7f)
+		if b&0x80 == 0 {
+			return v
+		}
+	}
+	s.err = true
+	return 0
+}
+
+// uint255 reads a 255UInt16 number.
+func (s *stream) uint255() uint16 {
+	const (
+		oneMoreByteCode2 = 254
+		oneMoreByteCode1 = 255
+		wordCode         = 253
+		lowestUCode      = 253
+	)
+	switch c := s.u8(); c {
+	case wordCode:
+		return s.u16()
+	case oneMoreByteCode1:
+		return uint16(s.u8()) + lowestUCode
+	case oneMoreByteCode2:
+		return uint16(s.u8()) + 2*lowestUCode
+	default:
+		return uint16(c)
+	}
+}
+
+// triplet reads the coordinate deltas of a point with the flag f
+// from a stream of triplet encoded coordinates.
+func (s *stream) triplet(f uint8) (dx, dy int) {
+	sign := func(flag uint8, v int) int {
+		if flag&1 != 0 {
+			return v
+		}
+		return -v
+	}
+	switch {
+	case f < 10:
+		b := s.read(1)
+		if b == nil {
+			return 0, 0
+		}
+		return 0, sign(f, int(f&14)<<7+int(b[0]))
+	case f < 20:
+		b := s.read(1)
+		if b == nil {
+			return 0, 0
+		}
+		return sign(f, int((f-10)&14)<<7+int(b[0])), 0
+	case f < 84:
+		b := s.read(1)
+		if b == nil {
+			return 0, 0
+		}
+		b0 := int(f - 20)
+		return sign(f, 1+b0&0x30+int(b[0]>>4)), sign(f>>1, 1+(b0&0x0c)<<2+int(b[0]&0x0f))
+	case f < 120:
+		b := s.read(2)
+		if b == nil {
+			return 0, 0
+		}
+		b0 := int(f - 84)
+		return sign(f, 1+(b0/12)<<8+int(b[0])), sign(f>>1, 1+((b0%12)>>2)<<8+int(b[1]))
+	case f < 124:
+		b := s.read(3)
+		if b == nil {
+			return 0, 0
+		}
+		return sign(f, int(b[0])<<4+int(b[1]>>4)), sign(f>>1, int(b[1]&0x0f)<<8+int(b[2]))
+	default:
+		b := s.read(4)
+		if b == nil {
+			return 0, 0
+		}
+		return sign(f, int(b[0])<<8+int(b[1])), sign(f>>1, int(b[2])<<8+int(b[3]))
+	}
+}
+
+// components reads the components of a composite glyph and reports
+// whether the glyph has instructions.
+func (s *stream) components() ([]byte, bool) {
+	start := s.pos
+	instructions := false
+	for {
+		flags := s.u16()
+		s.u16() // Glyph index.
+		n := 2
+		if flags&flagArgsAreWords != 0 {
+			n = 4
+		}
+		switch {
+		case flags&flagScale != 0:
+			n += 2
+		case flags&flagXYScale != 0:
+			n += 4
+		case flags&flagTwoByTwo != 0:
+			n += 8
+		}
+		s.read(n)
+		if flags&flagInstructions != 0 {
+			instructions = true
+		}
+		if s.err {
+			return nil, false
+		}
+		if flags&flagMoreComps == 0 {
+			return s.data[start:s.pos], instructions
+		}
+	}
+}
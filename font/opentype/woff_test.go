@@ -0,0 +1,475 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestWOFF(t *testing.T) {
+	src := encodeWOFF(t, goregular.TTF)
+	fnt, err := Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkWebFont(t, fnt, goregular.TTF)
+	if _, err := Parse(src[:len(src)/2]); err == nil {
+		t.Error("parsed truncated WOFF data")
+	}
+}
+
+func TestWOFF2(t *testing.T) {
+	for _, ttf := range [][]byte{goregular.TTF, gomono.TTF} {
+		src := encodeWOFF2(t, ttf)
+		fnt, err := Parse(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkWebFont(t, fnt, ttf)
+		// The decoded tables match the original tables, except
+		// for the re-encoded glyf and loca tables.
+		dec, err := decodeWOFF2(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		orig := make(map[string][]byte)
+		for _, tbl := range readTables(ttf) {
+			orig[tbl.tag] = tbl.data
+		}
+		for _, tbl := range readTables(dec) {
+			if tbl.tag != "glyf" && tbl.tag != "loca" && !bytes.Equal(tbl.data, orig[tbl.tag]) {
+				t.Errorf("decoded %s table differs", tbl.tag)
+			}
+		}
+		if _, err := Parse(src[:len(src)/2]); err == nil {
+			t.Error("parsed truncated WOFF2 data")
+		}
+	}
+}
+
+func TestWOFF2Collection(t *testing.T) {
+	src := encodeWOFF2(t, goregular.TTF, gomono.TTF)
+	coll, err := ParseCollectionReaderAt(bytes.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := coll.NumFonts(); n != 2 {
+		t.Fatalf("got %d fonts, expected 2", n)
+	}
+	for i, ttf := range [][]byte{goregular.TTF, gomono.TTF} {
+		fnt, err := coll.Font(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkWebFont(t, fnt, ttf)
+	}
+}
+
+// TestWebFontFiles decodes the WOFF and WOFF2 files of Font Awesome
+// 4.7.0, encoded from its TTF file by the reference tools. The WOFF2
+// file has transformed glyf and loca tables.
+func TestWebFontFiles(t *testing.T) {
+	ttf, err := ioutil.ReadFile(filepath.Join("testdata", "fontawesome.ttf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := make(map[string][]byte)
+	for _, tbl := range readTables(ttf) {
+		orig[tbl.tag] = tbl.data
+	}
+	for _, name := range []string{"fontawesome.woff", "fontawesome.woff2"} {
+		src, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		fnt, err := Parse(src)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		checkWebFont(t, fnt, ttf)
+		dec, err := decodeWebFont(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tables := readTables(dec)
+		if len(tables) != len(orig) {
+			t.Errorf("%s: got %d tables, expected %d", name, len(tables), len(orig))
+		}
+		transformed := filepath.Ext(name) == ".woff2"
+		for _, tbl := range tables {
+			got, exp := tbl.data, orig[tbl.tag]
+			if transformed {
+				switch tbl.tag {
+				case "glyf", "loca":
+					// The tables are re-encoded.
+					continue
+				case "head":
+					// The encoder sets bit 11 of the flags,
+					// which changes the checksum adjustment.
+					got, exp = headWithoutChecksum(got), headWithoutChecksum(exp)
+					got[16] &^= 0x08
+				}
+			}
+			if !bytes.Equal(got, exp) {
+				t.Errorf("%s: decoded %s table differs", name, tbl.tag)
+			}
+		}
+	}
+}
+
+// headWithoutChecksum returns a copy of the head table with a zero
+// checksum adjustment.
+func headWithoutChecksum(head []byte) []byte {
+	head = append([]byte(nil), head...)
+	if len(head) >= 12 {
+		copy(head[8:12], []byte{0, 0, 0, 0})
+	}
+	return head
+}
+
+func TestTriplet(t *testing.T) {
+	tests := []struct {
+		flag   uint8
+		data   []byte
+		dx, dy int
+	}{
+		{0, []byte{10}, 0, -10},
+		{3, []byte{10}, 0, 266},
+		{10, []byte{10}, -10, 0},
+		{13, []byte{10}, 266, 0},
+		{20, []byte{0x12}, -2, -3},
+		{83, []byte{0x12}, 50, 51},
+		{84, []byte{1, 2}, -2, -3},
+		{119, []byte{1, 2}, 514, 515},
+		{120, []byte{0x12, 0x34, 0x56}, -0x123, -0x456},
+		{127, []byte{1, 2, 3, 4}, 0x102, 0x304},
+	}
+	for _, test := range tests {
+		s := &stream{data: test.data}
+		dx, dy := s.triplet(test.flag)
+		if dx != test.dx || dy != test.dy || s.pos != len(test.data) || s.err {
+			t.Errorf("flag %d: got (%d, %d) from %d bytes, expected (%d, %d) from %d bytes",
+				test.flag, dx, dy, s.pos, test.dx, test.dy, len(test.data))
+		}
+	}
+}
+
+func TestWOFF2Numbers(t *testing.T) {
+	tests := []struct {
+		data []byte
+		exp  uint16
+	}{
+		{[]byte{252}, 252},
+		{[]byte{255, 0}, 253},
+		{[]byte{254, 0}, 506},
+		{[]byte{253, 0x12, 0x34}, 0x1234},
+	}
+	for _, test := range tests {
+		s := &stream{data: test.data}
+		if got := s.uint255(); got != test.exp || s.err {
+			t.Errorf("uint255(%v) = %d, expected %d", test.data, got, test.exp)
+		}
+	}
+	s := &stream{data: []byte{0x81, 0x80, 0x00}}
+	if got := s.base128(); got != 1<<14 || s.err {
+		t.Errorf("got base128 %d, expected %d", got, 1<<14)
+	}
+	for _, d := range [][]byte{{0x80, 0x01}, {0x90, 0x80, 0x80, 0x80, 0x00}, {0xff}} {
+		s := &stream{data: d}
+		if s.base128(); !s.err {
+			t.Errorf("decoded invalid base128 %v", d)
+		}
+	}
+}
+
+// checkWebFont compares the glyphs of fnt with the glyphs of the
+// SFNT font ttf.
+func checkWebFont(t *testing.T, fnt *Font, ttf []byte) {
+	t.Helper()
+	exp, err := sfnt.Parse(ttf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := fnt.font.NumGlyphs(); n != exp.NumGlyphs() {
+		t.Fatalf("got %d glyphs, expected %d", n, exp.NumGlyphs())
+	}
+	var buf sfnt.Buffer
+	ppem := fixed.I(50)
+	for i := 0; i < exp.NumGlyphs(); i++ {
+		g := sfnt.GlyphIndex(i)
+		segs, err := exp.LoadGlyph(&buf, g, ppem, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		segs = append([]sfnt.Segment(nil), segs...)
+		got, err := fnt.font.LoadGlyph(&fnt.buf, g, ppem, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sameSegments(got, segs, 0) {
+			t.Fatalf("glyph %d: got segments %v, expected %v", g, got, segs)
+		}
+		adv, _ := exp.GlyphAdvance(&buf, g, ppem, font.HintingNone)
+		if got, _ := fnt.font.GlyphAdvance(&fnt.buf, g, ppem, font.HintingNone); got != adv {
+			t.Fatalf("glyph %d: got advance %v, expected %v", g, got, adv)
+		}
+	}
+	shapeTestFont(t, fnt, "Hello")
+}
+
+// encodeWOFF encodes an SFNT font to WOFF.
+func encodeWOFF(t *testing.T, ttf []byte) []byte {
+	t.Helper()
+	tables := readTables(ttf)
+	hdr := data([]byte("wOFF"), binary.BigEndian.Uint32(ttf), uint32(0), uint16(len(tables)), uint16(0),
+		uint32(len(ttf)), make([]byte, 24))
+	var dir, body []byte
+	off := len(hdr) + 20*len(tables)
+	for _, tbl := range tables {
+		var b bytes.Buffer
+		w := zlib.NewWriter(&b)
+		w.Write(tbl.data)
+		w.Close()
+		comp := b.Bytes()
+		// Store tables that don't compress.
+		if len(comp) >= len(tbl.data) {
+			comp = tbl.data
+		}
+		dir = append(dir, data([]byte(tbl.tag), uint32(off+len(body)), uint32(len(comp)),
+			uint32(len(tbl.data)), checksum(tbl))...)
+		body = append(body, comp...)
+		for len(body)%4 != 0 {
+			body = append(body, 0)
+		}
+	}
+	out := append(append(hdr, dir...), body...)
+	binary.BigEndian.PutUint32(out[8:], uint32(len(out)))
+	return out
+}
+
+// encodeWOFF2 encodes SFNT fonts to WOFF2, with the glyf, loca and hmtx
+// tables transformed. More than one font is encoded as a collection.
+func encodeWOFF2(t *testing.T, ttfs ...[]byte) []byte {
+	t.Helper()
+	var dir, coll, stream []byte
+	ntables := 0
+	for _, ttf := range ttfs {
+		tables := readTables(ttf)
+		coll = append(coll, encodeUint255(uint16(len(tables)))...)
+		coll = append(coll, ttf[:4]...)
+		find := func(tag string) table {
+			for _, tbl := range tables {
+				if tbl.tag == tag {
+					return tbl.data
+				}
+			}
+			t.Fatalf("no %s table", tag)
+			return nil
+		}
+		glyf, xMins := encodeGlyf(t, find("glyf"), find("loca"), find("head"), find("maxp"))
+		hmtx := encodeHmtx(t, find("hmtx"), find("hhea"), find("maxp"), xMins)
+		for _, tbl := range tables {
+			coll = append(coll, encodeUint255(uint16(ntables))...)
+			ntables++
+			var flags uint8 = tagMask
+			for i, tag := range woff2Tags {
+				if tag == tbl.tag {
+					flags = uint8(i)
+				}
+			}
+			d := tbl.data
+			switch tbl.tag {
+			case "glyf":
+				d = glyf
+			case "loca":
+				d = nil
+			case "hmtx":
+				d = hmtx
+				flags |= 1 << transformShift
+			}
+			dir = append(dir, flags)
+			if flags&tagMask == tagMask {
+				dir = append(dir, tbl.tag...)
+			}
+			dir = append(dir, encodeBase128(uint32(len(tbl.data)))...)
+			switch tbl.tag {
+			case "glyf", "loca", "hmtx":
+				dir = append(dir, encodeBase128(uint32(len(d)))...)
+			}
+			stream = append(stream, d...)
+		}
+	}
+	var b bytes.Buffer
+	w := brotli.NewWriter(&b)
+	w.Write(stream)
+	w.Close()
+	flavor := ttfs[0][:4]
+	if len(ttfs) > 1 {
+		flavor = []byte("ttcf")
+		coll = append(append(data(uint32(0x00010000)), encodeUint255(uint16(len(ttfs)))...), coll...)
+	} else {
+		coll = nil
+	}
+	out := data([]byte("wOF2"), flavor, uint32(0), uint16(ntables), uint16(0), uint32(0),
+		uint32(b.Len()), make([]byte, 24), dir, coll, b.Bytes())
+	binary.BigEndian.PutUint32(out[8:], uint32(len(out)))
+	return out
+}
+
+// encodeGlyf transforms a glyf table, and returns the transformed table
+// along with the xMins of the glyphs.
+func encodeGlyf(t *testing.T, glyf, loca, head, maxp table) ([]byte, []int16) {
+	t.Helper()
+	numGlyphs := int(maxp.u16(4))
+	longLoca := head.i16(50) != 0
+	offset := func(i int) int {
+		if longLoca {
+			return int(loca.u32(4 * i))
+		}
+		return 2 * int(loca.u16(2*i))
+	}
+	var nContours, nPoints, flags, glyphs, composites, bboxes, instrs []byte
+	bitmap := make([]byte, 4*((numGlyphs+31)/32))
+	xMins := make([]int16, numGlyphs)
+	for i := 0; i < numGlyphs; i++ {
+		g := glyf[offset(i):offset(i+1)]
+		if len(g) == 0 {
+			nContours = append(nContours, data(int16(0))...)
+			continue
+		}
+		n := g.i16(0)
+		nContours = append(nContours, g[:2]...)
+		xMins[i] = g.i16(2)
+		if n < 0 {
+			// Composite glyphs have explicit bounding boxes.
+			bitmap[i>>3] |= 0x80 >> uint(i&7)
+			bboxes = append(bboxes, g[2:10]...)
+			s := &stream{data: g, pos: 10}
+			comps, instructions := s.components()
+			composites = append(composites, comps...)
+			if instructions {
+				n := int(s.u16())
+				glyphs = append(glyphs, encodeUint255(uint16(n))...)
+				instrs = append(instrs, s.read(n)...)
+			}
+			continue
+		}
+		o, ok := parseSimpleGlyph(g, int(n))
+		if !ok {
+			t.Fatalf("glyph %d: invalid outline", i)
+		}
+		var pts []woff2Point
+		for _, p := range o.points {
+			pts = append(pts, woff2Point{x: int(p.x), y: int(p.y), on: p.on})
+		}
+		if bbox := pointsBounds(pts); !bytes.Equal(data(bbox), g[2:10]) {
+			t.Fatalf("glyph %d: bounding box %v doesn't match its points", i, bbox)
+		}
+		last := -1
+		for c := 0; c < int(n); c++ {
+			end := int(g.u16(10 + 2*c))
+			nPoints = append(nPoints, encodeUint255(uint16(end-last))...)
+			last = end
+		}
+		var x, y int
+		for _, p := range pts {
+			f, d := encodeTriplet(p.x-x, p.y-y)
+			if !p.on {
+				f |= 0x80
+			}
+			flags = append(flags, f)
+			glyphs = append(glyphs, d...)
+			x, y = p.x, p.y
+		}
+		ilen := int(g.u16(10 + 2*int(n)))
+		glyphs = append(glyphs, encodeUint255(uint16(ilen))...)
+		instrs = append(instrs, g.slice(12+2*int(n), ilen)...)
+	}
+	bboxes = append(bitmap, bboxes...)
+	var indexFormat uint16
+	if longLoca {
+		indexFormat = 1
+	}
+	out := data(uint16(0), uint16(0), uint16(numGlyphs), indexFormat)
+	streams := [][]byte{nContours, nPoints, flags, glyphs, composites, bboxes, instrs}
+	for _, s := range streams {
+		out = append(out, data(uint32(len(s)))...)
+	}
+	for _, s := range streams {
+		out = append(out, s...)
+	}
+	return out, xMins
+}
+
+// encodeHmtx transforms an hmtx table where the left side bearings
+// equal the xMins of the glyphs.
+func encodeHmtx(t *testing.T, hmtx, hhea, maxp table, xMins []int16) []byte {
+	t.Helper()
+	numHMetrics := int(hhea.u16(34))
+	numGlyphs := int(maxp.u16(4))
+	out := []byte{noProportionalLSBs | noMonospacedLSBs}
+	for i := 0; i < numGlyphs; i++ {
+		lsb := hmtx.i16(4*numHMetrics + 2*(i-numHMetrics))
+		if i < numHMetrics {
+			out = append(out, hmtx.slice(4*i, 2)...)
+			lsb = hmtx.i16(4*i + 2)
+		}
+		if lsb != xMins[i] {
+			t.Fatalf("glyph %d: left side bearing %d differs from xMin %d", i, lsb, xMins[i])
+		}
+	}
+	return out
+}
+
+// encodeTriplet encodes a point delta with one of the triplet
+// encodings.
+func encodeTriplet(dx, dy int) (uint8, []byte) {
+	abs := func(v int) int {
+		if v < 0 {
+			return -v
+		}
+		return v
+	}
+	var sign uint8
+	if dx >= 0 {
+		sign |= 1
+	}
+	if dy >= 0 {
+		sign |= 2
+	}
+	switch {
+	case dx == 0 && abs(dy) < 256:
+		return sign >> 1, []byte{uint8(abs(dy))}
+	case dy == 0 && abs(dx) < 256:
+		return 10 + sign&1, []byte{uint8(abs(dx))}
+	default:
+		return 124 + sign, data(uint16(abs(dx)), uint16(abs(dy)))
+	}
+}
+
+func encodeUint255(v uint16) []byte {
+	if v < 253 {
+		return []byte{uint8(v)}
+	}
+	return data(uint8(253), v)
+}
+
+func encodeBase128(v uint32) []byte {
+	out := []byte{uint8(v & 0x7f)}
+	for v >>= 7; v > 0; v >>= 7 {
+		out = append([]byte{uint8(v&0x7f) | 0x80}, out...)
+	}
+	return out
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+// +build !linux android
+
+package system
+
+func fontDirs() []string {
+	return nil
+}
+
+func configFile() string {
+	return ""
+}
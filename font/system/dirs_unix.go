@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+// +build linux,!android
+
+package system
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// fontDirs returns the font directories of the XDG base directories
+// along with the standard font directories.
+func fontDirs() []string {
+	dirs := []string{filepath.Join(dataHome(), "fonts")}
+	dataDirs := os.Getenv("XDG_DATA_DIRS")
+	if dataDirs == "" {
+		dataDirs = "/usr/local/share:/usr/share"
+	}
+	for _, d := range filepath.SplitList(dataDirs) {
+		dirs = append(dirs, filepath.Join(d, "fonts"))
+	}
+	dirs = append(dirs, "/usr/share/fonts", "/usr/local/share/fonts")
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, ".fonts"))
+	}
+	return dirs
+}
+
+// configFile returns the path of the fontconfig configuration.
+func configFile() string {
+	if f := os.Getenv("FONTCONFIG_FILE"); f != "" {
+		return f
+	}
+	return "/etc/fonts/fonts.conf"
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package system
+
+import (
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// config is the part of a fontconfig configuration that locates and
+// names fonts.
+type config struct {
+	// dirs are the font directories.
+	dirs []string
+	// aliases maps lower case family names to their aliases.
+	aliases map[string]*alias
+	// loaded are the configuration files already read.
+	loaded map[string]bool
+}
+
+// alias lists the families to use in place of or along with a family.
+type alias struct {
+	// prefer are the families to try before the family, accept
+	// the families to try after it, and def the families to try
+	// last.
+	prefer, accept, def []string
+}
+
+// fcAlias is an alias element.
+type fcAlias struct {
+	Family  []string `xml:"family"`
+	Prefer  []string `xml:"prefer>family"`
+	Accept  []string `xml:"accept>family"`
+	Default []string `xml:"default>family"`
+}
+
+// fcPath is a dir or include element.
+type fcPath struct {
+	Prefix string `xml:"prefix,attr"`
+	Path   string `xml:",chardata"`
+}
+
+// load reads the configuration file at path, or the configuration
+// files of the directory at path in order of their names. Missing and
+// invalid files are ignored.
+func (c *config) load(path string) {
+	if c.loaded == nil {
+		c.loaded = make(map[string]bool)
+		c.aliases = make(map[string]*alias)
+	}
+	if c.loaded[path] {
+		return
+	}
+	c.loaded[path] = true
+	fi, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+	if fi.IsDir() {
+		files, _ := filepath.Glob(filepath.Join(path, "*.conf"))
+		sort.Strings(files)
+		for _, f := range files {
+			c.load(f)
+		}
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	c.parse(f, filepath.Dir(path))
+}
+
+// parse reads the dir, include and alias elements of a configuration,
+// where relative paths are relative to dir. The other elements are
+// ignored.
+func (c *config) parse(r io.Reader, dir string) error {
+	d := xml.NewDecoder(r)
+	depth := 0
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth != 1 {
+				depth++
+				continue
+			}
+			var err error
+			switch t.Name.Local {
+			case "dir":
+				var p fcPath
+				if err = d.DecodeElement(&p, &t); err == nil {
+					c.dirs = append(c.dirs, expandPath(p, dir, dataHome()))
+				}
+			case "include":
+				var p fcPath
+				if err = d.DecodeElement(&p, &t); err == nil {
+					c.load(expandPath(p, dir, configHome()))
+				}
+			case "alias":
+				var a fcAlias
+				if err = d.DecodeElement(&a, &t); err == nil {
+					c.addAlias(a)
+				}
+			default:
+				err = d.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+// addAlias adds an alias element to the aliases of its families. As
+// in fontconfig, the preferred families of later aliases come closer
+// to the family, and so do the accepted families.
+func (c *config) addAlias(a fcAlias) {
+	trim := func(families []string) []string {
+		var res []string
+		for _, f := range families {
+			if f = strings.TrimSpace(f); f != "" {
+				res = append(res, f)
+			}
+		}
+		return res
+	}
+	for _, f := range trim(a.Family) {
+		key := strings.ToLower(f)
+		al := c.aliases[key]
+		if al == nil {
+			al = new(alias)
+			c.aliases[key] = al
+		}
+		al.prefer = append(al.prefer, trim(a.Prefer)...)
+		al.accept = append(trim(a.Accept), al.accept...)
+		al.def = append(al.def, trim(a.Default)...)
+	}
+}
+
+// expandPath returns the path of a dir or include element. Paths with
+// the xdg prefix are relative to xdg, and other relative paths are
+// relative to dir.
+func expandPath(p fcPath, dir, xdg string) string {
+	path := strings.TrimSpace(p.Path)
+	switch {
+	case p.Prefix == "xdg":
+		return filepath.Join(xdg, path)
+	case path == "~" || strings.HasPrefix(path, "~/"):
+		home, _ := os.UserHomeDir()
+		return filepath.Join(home, path[1:])
+	case !filepath.IsAbs(path):
+		return filepath.Join(dir, path)
+	default:
+		return path
+	}
+}
+
+// dataHome returns the XDG base directory for user data.
+func dataHome() string {
+	return xdgDir("XDG_DATA_HOME", ".local/share")
+}
+
+// configHome returns the XDG base directory for user configuration.
+func configHome() string {
+	return xdgDir("XDG_CONFIG_HOME", ".config")
+}
+
+func xdgDir(env, def string) string {
+	if d := os.Getenv(env); d != "" {
+		return d
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, def)
+}
@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package system
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf16"
+
+	"gioui.org/text"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// sfntTable is the data of a table of a font.
+type sfntTable []byte
+
+// Name IDs of the name table.
+const (
+	nameFamily               = 1
+	nameSubfamily            = 2
+	nameTypographicFamily    = 16
+	nameTypographicSubfamily = 17
+)
+
+// Bits of the fsSelection field of the OS/2 table.
+const (
+	selectionItalic  = 1 << 0
+	selectionOblique = 1 << 9
+)
+
+const (
+	// maxFaces limits the number of faces read from a font
+	// collection.
+	maxFaces = 256
+	// maxTableSize limits the size of the tables read from fonts.
+	maxTableSize = 1 << 20
+)
+
+var errInvalidFont = errors.New("system: invalid font file")
+
+// readFaces reads the families, weights and styles of the faces of a
+// font file.
+func readFaces(path string) ([]*faceInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var hdr [12]byte
+	if _, err := f.ReadAt(hdr[:], 0); err != nil {
+		return nil, err
+	}
+	offs := []int64{0}
+	if string(hdr[:4]) == "ttcf" {
+		n := int(binary.BigEndian.Uint32(hdr[8:]))
+		if n > maxFaces {
+			return nil, errInvalidFont
+		}
+		dir := make([]byte, 4*n)
+		if _, err := f.ReadAt(dir, 12); err != nil {
+			return nil, err
+		}
+		offs = offs[:0]
+		for i := 0; i < n; i++ {
+			offs = append(offs, int64(binary.BigEndian.Uint32(dir[4*i:])))
+		}
+	}
+	var faces []*faceInfo
+	for i, off := range offs {
+		info, err := readFace(f, off)
+		if err != nil {
+			continue
+		}
+		info.path, info.index = path, i
+		faces = append(faces, info)
+	}
+	return faces, nil
+}
+
+// readFace reads the family, weight and style of the font with its
+// table directory at off in r.
+func readFace(r io.ReaderAt, off int64) (*faceInfo, error) {
+	tables, err := readTables(r, off, "name", "OS/2", "fvar")
+	if err != nil {
+		return nil, err
+	}
+	name, os2, fvar := tables[0], tables[1], tables[2]
+	info := &faceInfo{
+		family: nameString(name, nameTypographicFamily),
+	}
+	if info.family == "" {
+		info.family = nameString(name, nameFamily)
+	}
+	if info.family == "" {
+		return nil, errInvalidFont
+	}
+	sub := nameString(name, nameTypographicSubfamily)
+	if sub == "" {
+		sub = nameString(name, nameSubfamily)
+	}
+	sub = strings.ToLower(sub)
+	weight := text.Normal
+	if os2 != nil {
+		weight = text.Weight(os2.u16(4))
+		// Some fonts use the weight classes from 1 to 9.
+		if weight > 0 && weight < 10 {
+			weight *= 100
+		}
+		if weight < 1 || weight > 1000 {
+			weight = text.Normal
+		}
+		if os2.u16(62)&(selectionItalic|selectionOblique) != 0 {
+			info.style = text.Italic
+		}
+	} else if strings.Contains(sub, "bold") {
+		weight = 700
+	}
+	if strings.Contains(sub, "italic") || strings.Contains(sub, "oblique") {
+		info.style = text.Italic
+	}
+	info.minWeight, info.maxWeight = weight, weight
+	// Variable fonts cover the range of their weight axis.
+	if fvar != nil {
+		axesOff, n, size := int(fvar.u16(4)), int(fvar.u16(8)), int(fvar.u16(10))
+		for i := 0; i < n; i++ {
+			a := axesOff + size*i
+			if fvar.tag(a) == "wght" {
+				info.minWeight = text.Weight(int32(fvar.u32(a+4)) >> 16)
+				info.maxWeight = text.Weight(int32(fvar.u32(a+12)) >> 16)
+			}
+		}
+	}
+	return info, nil
+}
+
+// readTables reads the tables of the font with its table directory at
+// off in r. Missing tables are nil.
+func readTables(r io.ReaderAt, off int64, tags ...string) ([]sfntTable, error) {
+	var hdr [12]byte
+	if _, err := r.ReadAt(hdr[:], off); err != nil {
+		return nil, err
+	}
+	n := int(binary.BigEndian.Uint16(hdr[4:]))
+	dir := make(sfntTable, 16*n)
+	if _, err := r.ReadAt(dir, off+12); err != nil {
+		return nil, err
+	}
+	tables := make([]sfntTable, len(tags))
+	for i := 0; i < n; i++ {
+		rec := dir[16*i:]
+		for j, tag := range tags {
+			if rec.tag(0) != tag {
+				continue
+			}
+			size := rec.u32(12)
+			if size > maxTableSize {
+				return nil, errInvalidFont
+			}
+			t := make(sfntTable, size)
+			if _, err := r.ReadAt(t, int64(rec.u32(8))); err != nil {
+				return nil, err
+			}
+			tables[j] = t
+		}
+	}
+	return tables, nil
+}
+
+// nameString returns the name with the given ID from a name table, or
+// the empty string if there is none. English Windows names are
+// preferred.
+func nameString(name sfntTable, id uint16) string {
+	count := int(name.u16(2))
+	strs := int(name.u16(4))
+	best, bestScore := "", -1
+	for i := 0; i < count; i++ {
+		rec := 6 + 12*i
+		if name.u16(rec+6) != id {
+			continue
+		}
+		platform, enc, lang := name.u16(rec), name.u16(rec+2), name.u16(rec+4)
+		off, n := strs+int(name.u16(rec+10)), int(name.u16(rec+8))
+		if off+n > len(name) {
+			continue
+		}
+		data := name[off : off+n]
+		var score int
+		var s string
+		switch {
+		case platform == 3 && (enc == 1 || enc == 10):
+			score = 2
+			if lang == 0x409 {
+				score = 3
+			}
+			s = decodeUTF16(data)
+		case platform == 0:
+			score = 1
+			s = decodeUTF16(data)
+		case platform == 1 && enc == 0:
+			score = 0
+			b, err := charmap.Macintosh.NewDecoder().Bytes(data)
+			if err != nil {
+				continue
+			}
+			s = string(b)
+		default:
+			continue
+		}
+		if score > bestScore {
+			best, bestScore = s, score
+		}
+	}
+	return best
+}
+
+func decodeUTF16(data []byte) string {
+	u := make([]uint16, len(data)/2)
+	for i := range u {
+		u[i] = binary.BigEndian.Uint16(data[2*i:])
+	}
+	return string(utf16.Decode(u))
+}
+
+func (t sfntTable) u16(off int) uint16 {
+	if off < 0 || off+2 > len(t) {
+		return 0
+	}
+	return binary.BigEndian.Uint16(t[off:])
+}
+
+func (t sfntTable) u32(off int) uint32 {
+	if off < 0 || off+4 > len(t) {
+		return 0
+	}
+	return binary.BigEndian.Uint32(t[off:])
+}
+
+func (t sfntTable) tag(off int) string {
+	if off < 0 || off+4 > len(t) {
+		return ""
+	}
+	return string(t[off : off+4])
+}
@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+// Package system loads the fonts installed on the system.
+//
+// The fonts are found in the standard font directories and the
+// directories of the fontconfig configuration, and are matched by
+// family name, weight and style. Family names are resolved through the
+// aliases of the fontconfig configuration, such that generic families
+// such as "sans-serif" and "monospace" map to installed fonts.
+//
+// Only Linux font directories are known; on other systems the loader
+// finds no fonts.
+package system
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gioui.org/font"
+	"gioui.org/font/opentype"
+	"gioui.org/text"
+)
+
+// Loader loads the fonts installed on the system on demand. The fonts
+// are indexed in the background from the creation of the Loader, and
+// Load waits for the index if it is not ready. Loader implements
+// text.FaceLoader.
+type Loader struct {
+	dirs   []string
+	config string
+
+	// scanned is closed when the index is ready.
+	scanned chan struct{}
+	// families maps lower case family names to their faces.
+	families map[string][]*faceInfo
+	aliases  map[string]*alias
+
+	mu    sync.Mutex
+	fonts map[*faceInfo]*opentype.Font
+}
+
+// faceInfo describes a face of a font file.
+type faceInfo struct {
+	path string
+	// index is the index of the face in a font collection.
+	index  int
+	family string
+	style  text.Style
+	// minWeight and maxWeight are the range of weights of the face,
+	// which are equal unless the face is a variable font.
+	minWeight, maxWeight text.Weight
+}
+
+// fontExts are the file extensions of indexed font files.
+var fontExts = map[string]bool{
+	".ttf": true,
+	".otf": true,
+	".ttc": true,
+	".otc": true,
+}
+
+// Register sets a Loader for the system fonts as the loader of the
+// central font registry.
+func Register() {
+	font.SetLoader(New())
+}
+
+// New returns a Loader for the fonts of the system font directories
+// and the fontconfig configuration, and starts indexing the fonts.
+func New() *Loader {
+	return newLoader(fontDirs(), configFile())
+}
+
+func newLoader(dirs []string, config string) *Loader {
+	l := &Loader{
+		dirs:    dirs,
+		config:  config,
+		scanned: make(chan struct{}),
+		fonts:   make(map[*faceInfo]*opentype.Font),
+	}
+	go l.scan()
+	return l
+}
+
+// Load returns the installed face that best matches font, or nil if
+// no installed family matches the typeface of font.
+func (l *Loader) Load(font text.Font) text.Face {
+	<-l.scanned
+	if font.Weight == 0 {
+		font.Weight = text.Normal
+	}
+	for _, family := range l.resolve(string(font.Typeface)) {
+		info := matchFace(l.families[strings.ToLower(family)], font.Weight, font.Style)
+		if info == nil {
+			continue
+		}
+		fnt := l.open(info)
+		if fnt == nil {
+			continue
+		}
+		if inst := fnt.Instance(font.Weight, font.Style); inst != nil {
+			return inst
+		}
+		return fnt
+	}
+	return nil
+}
+
+// open parses the font of a face.
+func (l *Loader) open(info *faceInfo) *opentype.Font {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if fnt, ok := l.fonts[info]; ok {
+		return fnt
+	}
+	var fnt *opentype.Font
+	if src, err := ioutil.ReadFile(info.path); err == nil {
+		if coll, err := opentype.ParseCollection(src); err == nil {
+			fnt, _ = coll.Font(info.index)
+		}
+	}
+	// Remember failures too.
+	l.fonts[info] = fnt
+	return fnt
+}
+
+// scan reads the fontconfig configuration and indexes the font files
+// of the font directories.
+func (l *Loader) scan() {
+	defer close(l.scanned)
+	cfg := new(config)
+	if l.config != "" {
+		cfg.load(l.config)
+	}
+	l.aliases = cfg.aliases
+	l.families = make(map[string][]*faceInfo)
+	seen := make(map[string]bool)
+	for _, dir := range append(l.dirs, cfg.dirs...) {
+		dir = filepath.Clean(dir)
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+			if err != nil || fi.IsDir() || !fontExts[strings.ToLower(filepath.Ext(path))] {
+				return nil
+			}
+			if seen[path] {
+				return nil
+			}
+			seen[path] = true
+			faces, err := readFaces(path)
+			if err != nil {
+				return nil
+			}
+			for _, f := range faces {
+				key := strings.ToLower(f.family)
+				l.families[key] = append(l.families[key], f)
+			}
+			return nil
+		})
+	}
+}
+
+// resolve returns the families to try for a family, in order of
+// preference.
+func (l *Loader) resolve(family string) []string {
+	var families []string
+	seen := make(map[string]bool)
+	var expand func(family string)
+	expand = func(family string) {
+		key := strings.ToLower(family)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		a := l.aliases[key]
+		if a == nil {
+			families = append(families, family)
+			return
+		}
+		for _, f := range a.prefer {
+			expand(f)
+		}
+		families = append(families, family)
+		for _, f := range a.accept {
+			expand(f)
+		}
+		for _, f := range a.def {
+			expand(f)
+		}
+	}
+	expand(family)
+	return families
+}
+
+// matchFace returns the face that best matches a weight and a style,
+// or nil if there are no faces. Faces of the style are preferred, and
+// weights are matched as in the CSS font matching algorithm.
+func matchFace(faces []*faceInfo, weight text.Weight, style text.Style) *faceInfo {
+	var best *faceInfo
+	bestStyle, bestTier, bestDist := false, 0, 0
+	for _, f := range faces {
+		sameStyle := f.style == style
+		tier, dist := weightDistance(f.minWeight, f.maxWeight, weight)
+		better := best == nil ||
+			sameStyle && !bestStyle ||
+			sameStyle == bestStyle && (tier < bestTier || tier == bestTier && dist < bestDist)
+		if better {
+			best, bestStyle, bestTier, bestDist = f, sameStyle, tier, dist
+		}
+	}
+	return best
+}
+
+// weightDistance ranks a face with weights from min to max for a
+// weight w. Faces of lower tiers are preferred, and faces of the same
+// tier are ordered by distance.
+func weightDistance(min, max, w text.Weight) (tier, dist int) {
+	c := w
+	if c < min {
+		c = min
+	}
+	if c > max {
+		c = max
+	}
+	d := int(c - w)
+	if d < 0 {
+		d = -d
+	}
+	switch {
+	case c == w:
+		return 0, 0
+	case w >= 400 && w <= 500:
+		// Prefer heavier weights up to 500, then lighter weights,
+		// then heavier weights.
+		switch {
+		case c > w && c <= 500:
+			return 1, d
+		case c < w:
+			return 2, d
+		default:
+			return 3, d
+		}
+	case w < 400:
+		// Prefer lighter weights.
+		if c < w {
+			return 1, d
+		}
+		return 2, d
+	default:
+		// Prefer heavier weights.
+		if c > w {
+			return 1, d
+		}
+		return 2, d
+	}
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package system
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gioui.org/text"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestLoader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fonts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string][]byte{
+		"fonts/go/Go-Regular.ttf": goregular.TTF,
+		"fonts/go/Go-Bold.ttf":    gobold.TTF,
+		"fonts/go/Go-Italic.ttf":  goitalic.TTF,
+		"extra/Go-Mono.ttf":       gomono.TTF,
+		"fonts.conf": []byte(`<?xml version="1.0"?>
+<!DOCTYPE fontconfig SYSTEM "fonts.dtd">
+<fontconfig>
+	<dir>extra</dir>
+	<include ignore_missing="yes">conf.d</include>
+	<alias>
+		<family>sans-serif</family>
+		<prefer><family>Missing Sans</family></prefer>
+		<default><family>Go</family></default>
+	</alias>
+</fontconfig>`),
+		"conf.d/10-mono.conf": []byte(`<fontconfig>
+	<alias>
+		<family>monospace</family>
+		<prefer><family>Go Mono</family></prefer>
+	</alias>
+	<match><test name="family"><string>monospace</string></test></match>
+</fontconfig>`),
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	l := newLoader([]string{filepath.Join(dir, "fonts"), filepath.Join(dir, "missing")}, filepath.Join(dir, "fonts.conf"))
+	tests := []struct {
+		font text.Font
+		exp  string
+	}{
+		{text.Font{Typeface: "Go"}, "Go-Regular.ttf"},
+		{text.Font{Typeface: "go", Weight: text.Bold}, "Go-Bold.ttf"},
+		{text.Font{Typeface: "Go", Style: text.Italic}, "Go-Italic.ttf"},
+		{text.Font{Typeface: "Go", Weight: text.Bold, Style: text.Italic}, "Go-Italic.ttf"},
+		{text.Font{Typeface: "sans-serif", Weight: text.Medium}, "Go-Regular.ttf"},
+		{text.Font{Typeface: "monospace"}, "Go-Mono.ttf"},
+		{text.Font{Typeface: "Missing Sans"}, ""},
+	}
+	for _, test := range tests {
+		face := l.Load(test.font)
+		got := ""
+		for info, fnt := range l.fonts {
+			if face != nil && face == text.Face(fnt) {
+				got = filepath.Base(info.path)
+			}
+		}
+		if face != nil && got == "" {
+			t.Errorf("%v: got a face that wasn't loaded", test.font)
+		}
+		if got != test.exp {
+			t.Errorf("%v: got face %q, expected %q", test.font, got, test.exp)
+		}
+	}
+	// Faces are parsed once.
+	if n := len(l.fonts); n != 4 {
+		t.Errorf("parsed %d fonts, expected 4", n)
+	}
+}
+
+func TestReadFaces(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fonts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "Go-Bold.ttf")
+	if err := ioutil.WriteFile(path, gobold.TTF, 0644); err != nil {
+		t.Fatal(err)
+	}
+	faces, err := readFaces(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := faceInfo{path: path, family: "Go", minWeight: 600, maxWeight: 600}
+	if len(faces) != 1 {
+		t.Fatalf("got %d faces, expected 1", len(faces))
+	}
+	if *faces[0] != exp {
+		t.Errorf("got face %+v, expected %+v", *faces[0], exp)
+	}
+}
+
+func TestMatchFace(t *testing.T) {
+	faces := []*faceInfo{
+		{minWeight: 300, maxWeight: 300},
+		{minWeight: 400, maxWeight: 400},
+		{minWeight: 700, maxWeight: 700},
+		{minWeight: 400, maxWeight: 400, style: text.Italic},
+	}
+	tests := []struct {
+		weight text.Weight
+		style  text.Style
+		exp    int
+	}{
+		{400, text.Regular, 1},
+		{450, text.Regular, 1},
+		{500, text.Regular, 1},
+		{350, text.Regular, 0},
+		{200, text.Regular, 0},
+		{600, text.Regular, 2},
+		{900, text.Regular, 2},
+		{700, text.Italic, 3},
+	}
+	for _, test := range tests {
+		if got := matchFace(faces, test.weight, test.style); got != faces[test.exp] {
+			t.Errorf("weight %d, style %v: got face %v, expected %v", test.weight, test.style, got, faces[test.exp])
+		}
+	}
+	// Variable faces cover a range of weights.
+	faces = append(faces, &faceInfo{minWeight: 500, maxWeight: 800})
+	if got := matchFace(faces, 600, text.Regular); got != faces[4] {
+		t.Errorf("got face %v, expected the variable face", got)
+	}
+	if got := matchFace(nil, 400, text.Regular); got != nil {
+		t.Errorf("got face %v from no faces", got)
+	}
+}
@@ -49,7 +49,8 @@ type ClickEvent struct {
 	Source    pointer.Source
 	Modifiers key.Modifiers
 	// NumClicks records successive clicks occurring
-	// within a short duration of each other.
+	// within a short duration of each other. For TypePress
+	// events, NumClicks includes the click in progress.
 	NumClicks int
 }
 
@@ -72,6 +73,13 @@ type Scroll struct {
 
 type ScrollState uint8
 
+// Drag detects drags of the primary mouse button and
+// of touch pointers.
+type Drag struct {
+	dragging bool
+	pid      pointer.ID
+}
+
 type Axis uint8
 
 const (
@@ -152,7 +160,11 @@ func (c *Click) Events(q event.Queue) []ClickEvent {
 				break
 			}
 			c.state = StatePressed
-			events = append(events, ClickEvent{Type: TypePress, Position: e.Position, Source: e.Source, Modifiers: e.Modifiers})
+			clicks := 1
+			if e.Time-c.clickedAt < doubleClickDuration {
+				clicks = c.clicks + 1
+			}
+			events = append(events, ClickEvent{Type: TypePress, Position: e.Position, Source: e.Source, Modifiers: e.Modifiers, NumClicks: clicks})
 		case pointer.Leave:
 			if c.state == StatePressed {
 				c.state = StateNormal
@@ -251,6 +263,54 @@ func (s *Scroll) Scroll(cfg unit.Converter, q event.Queue, t time.Time, axis Axi
 	return total
 }
 
+// Add the handler to the operation list to receive drag events.
+func (d *Drag) Add(ops *op.Ops) {
+	op := pointer.InputOp{Tag: d}
+	op.Add(ops)
+}
+
+// Events returns the pointer events of drags: a Press that
+// starts a drag, followed by Moves and a Release or Cancel
+// that ends it.
+func (d *Drag) Events(q event.Queue) []pointer.Event {
+	var events []pointer.Event
+	for _, evt := range q.Events(d) {
+		e, ok := evt.(pointer.Event)
+		if !ok {
+			continue
+		}
+		switch e.Type {
+		case pointer.Press:
+			if d.dragging {
+				continue
+			}
+			if e.Source == pointer.Mouse && e.Buttons != pointer.ButtonLeft {
+				continue
+			}
+			d.dragging = true
+			d.pid = e.PointerID
+		case pointer.Move:
+			if !d.dragging || d.pid != e.PointerID {
+				continue
+			}
+		case pointer.Release, pointer.Cancel:
+			if !d.dragging || e.Type == pointer.Release && d.pid != e.PointerID {
+				continue
+			}
+			d.dragging = false
+		default:
+			continue
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// Dragging reports whether a drag is in progress.
+func (d *Drag) Dragging() bool {
+	return d.dragging
+}
+
 func (s *Scroll) val(p f32.Point) float32 {
 	if s.axis == Horizontal {
 		return p.X
@@ -85,3 +85,60 @@ func filterMouseClicks(events []ClickEvent) []ClickEvent {
 	}
 	return clicks
 }
+
+func TestMousePressClicks(t *testing.T) {
+	var click Click
+	var ops op.Ops
+	click.Add(&ops)
+
+	var r router.Router
+	r.Frame(&ops)
+	events := mouseClickEvents(
+		100*time.Millisecond,
+		100*time.Millisecond+doubleClickDuration-1,
+	)
+	// Press the third time shortly after the second click.
+	press := events[0].(pointer.Event)
+	press.Time = 100*time.Millisecond + doubleClickDuration
+	r.Add(append(events, press)...)
+
+	var clicks []int
+	for _, e := range click.Events(&r) {
+		if e.Type == TypePress {
+			clicks = append(clicks, e.NumClicks)
+		}
+	}
+	if len(clicks) != 3 || clicks[0] != 1 || clicks[1] != 2 || clicks[2] != 3 {
+		t.Errorf("got press clicks %v, expected [1 2 3]", clicks)
+	}
+}
+
+func TestDrag(t *testing.T) {
+	var drag Drag
+	var ops op.Ops
+	drag.Add(&ops)
+
+	var r router.Router
+	r.Frame(&ops)
+	r.Add(
+		pointer.Event{Type: pointer.Move, Source: pointer.Mouse},
+		pointer.Event{Type: pointer.Press, Source: pointer.Mouse, Buttons: pointer.ButtonRight},
+		pointer.Event{Type: pointer.Release, Source: pointer.Mouse},
+		pointer.Event{Type: pointer.Press, Source: pointer.Mouse, Buttons: pointer.ButtonLeft},
+		pointer.Event{Type: pointer.Move, Source: pointer.Mouse, Buttons: pointer.ButtonLeft},
+	)
+	var types []pointer.Type
+	for _, e := range drag.Events(&r) {
+		types = append(types, e.Type)
+	}
+	if len(types) != 2 || types[0] != pointer.Press || types[1] != pointer.Move {
+		t.Errorf("got events %v, expected [Press Move]", types)
+	}
+	if !drag.Dragging() {
+		t.Error("drag not in progress")
+	}
+	r.Add(pointer.Event{Type: pointer.Release, Source: pointer.Mouse})
+	if evts := drag.Events(&r); len(evts) != 1 || drag.Dragging() {
+		t.Errorf("drag didn't end after release")
+	}
+}
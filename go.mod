@@ -1,9 +1,11 @@
 module gioui.org
 
-go 1.13
+go 1.18
 
 require (
+	github.com/andybalholm/brotli v1.0.6
 	golang.org/x/exp v0.0.0-20191002040644-a1355ae1e2c3
 	golang.org/x/image v0.0.0-20190802002840-cff245a6509b
-	golang.org/x/sys v0.0.0-20200124204421-9fbb57f87de9
+	golang.org/x/sys v0.20.0
+	golang.org/x/text v0.22.0
 )
@@ -46,6 +46,8 @@ type Device interface {
 }
 
 type ShaderSources struct {
+	// Name is the file name of the shader source.
+	Name      string
 	GLSL100ES string
 	GLSL300ES string
 	GLSL130   string
@@ -121,8 +123,11 @@ type DepthFunc uint8
 type Features uint
 
 type Caps struct {
-	Features       Features
-	MaxTextureSize int
+	// BottomLeftOrigin is true if the driver has the origin in the lower left
+	// corner of framebuffers and textures. The OpenGL convention.
+	BottomLeftOrigin bool
+	Features         Features
+	MaxTextureSize   int
 }
 
 type Program interface {
@@ -143,6 +143,7 @@ func NewBackend(f Functions) (*Backend, error) {
 	if hasExtension(exts, "GL_EXT_disjoint_timer_query_webgl2") || hasExtension(exts, "GL_EXT_disjoint_timer_query") {
 		b.feats.Features |= backend.FeatureTimers
 	}
+	b.feats.BottomLeftOrigin = true
 	b.feats.MaxTextureSize = f.GetInteger(MAX_TEXTURE_SIZE)
 	return b, nil
 }
@@ -22,7 +22,6 @@ import (
 	"gioui.org/internal/f32color"
 	"gioui.org/internal/opconst"
 	"gioui.org/internal/ops"
-	"gioui.org/internal/path"
 	gunsafe "gioui.org/internal/unsafe"
 	"gioui.org/layout"
 	"gioui.org/op"
@@ -49,6 +48,9 @@ type renderer struct {
 	pather        *pather
 	packer        packer
 	intersections packer
+	// layers holds the offscreen textures of layers.
+	layers     fboSet
+	layerSizes []image.Point
 }
 
 type drawOps struct {
@@ -64,30 +66,45 @@ type drawOps struct {
 	zimageOps   []imageOp
 	pathOps     []*pathOp
 	pathOpCache []pathOp
+	// layers are the layers to draw, innermost first.
+	layers     []*layerOp
+	layerCache []layerOp
+	// rectQuads holds the path segments of rectangles
+	// that are clipped by paths because of their
+	// transformation.
+	rectQuads []byte
 }
 
 type drawState struct {
 	clip  f32.Rectangle
-	t     op.TransformOp
+	t     f32.Affine2D
 	cpath *pathOp
 	rect  bool
 	z     int
+	// layer is the layer to draw into, or nil for
+	// the window.
+	layer *layerOp
 
 	matType materialType
 	// Current paint.ImageOp
 	image imageOpData
 	// Current paint.ColorOp, if any.
 	color color.RGBA
+	// Current paint.LinearGradientOp or paint.RadialGradientOp, if any.
+	gradient gradientOpData
 }
 
 type pathOp struct {
 	off f32.Point
+	// trans is the linear part of the transformation
+	// of the path.
+	trans f32.Affine2D
 	// clip is the union of all
 	// later clip rectangles.
 	clip      image.Rectangle
 	pathKey   ops.Key
 	path      bool
-	pathVerts []byte
+	pathQuads []byte
 	parent    *pathOp
 	place     placement
 }
@@ -100,6 +117,22 @@ type imageOp struct {
 	material material
 	clipType clipType
 	place    placement
+	// layer is the layer the image is drawn into,
+	// or nil for the window.
+	layer *layerOp
+}
+
+// layerOp is a group of operations drawn to an
+// offscreen texture and composited with an opacity.
+type layerOp struct {
+	// clip is the union of the clip areas of the
+	// layer contents.
+	clip    image.Rectangle
+	opacity float32
+	// tex and uvTrans locate the layer contents
+	// after the layer is drawn.
+	tex     texture
+	uvTrans f32.Affine2D
 }
 
 type material struct {
@@ -108,9 +141,13 @@ type material struct {
 	// For materialTypeColor.
 	color f32color.RGBA
 	// For materialTypeTexture.
-	texture  *texture
-	uvScale  f32.Point
-	uvOffset f32.Point
+	texture *texture
+	uvTrans f32.Affine2D
+	opacity float32
+	// layer, if set, is the source of the texture.
+	layer *layerOp
+	// For materialTypeGradient.
+	gradient gradientUniforms
 }
 
 // clipOp is the shadow of clip.Op.
@@ -118,6 +155,18 @@ type clipOp struct {
 	bounds f32.Rectangle
 }
 
+// gradientOpData is the shadow of paint.LinearGradientOp and
+// paint.RadialGradientOp.
+type gradientOpData struct {
+	radial bool
+	// p0 and p1 are the start and end points of linear
+	// gradients. For radial gradients, p0 is the center and
+	// p1.X is the radius.
+	p0, p1 f32.Point
+	nstops int
+	stops  [opconst.MaxGradientStops]paint.GradientStop
+}
+
 // imageOpData is the shadow of paint.ImageOp.
 type imageOpData struct {
 	rect   image.Rectangle
@@ -182,6 +231,52 @@ func decodeColorOp(data []byte) color.RGBA {
 	}
 }
 
+func decodeGradientOp(data []byte, g *gradientOpData) {
+	switch opconst.OpType(data[0]) {
+	case opconst.TypeLinearGradient:
+		g.radial = false
+	case opconst.TypeRadialGradient:
+		g.radial = true
+	default:
+		panic("invalid op")
+	}
+	bo := binary.LittleEndian
+	n := int(data[1])
+	if n > len(g.stops) {
+		panic("invalid op")
+	}
+	g.nstops = n
+	g.p0 = f32.Point{
+		X: math.Float32frombits(bo.Uint32(data[2:])),
+		Y: math.Float32frombits(bo.Uint32(data[6:])),
+	}
+	g.p1 = f32.Point{
+		X: math.Float32frombits(bo.Uint32(data[10:])),
+		Y: math.Float32frombits(bo.Uint32(data[14:])),
+	}
+	data = data[18:]
+	for i := 0; i < n; i++ {
+		g.stops[i] = paint.GradientStop{
+			Offset: math.Float32frombits(bo.Uint32(data)),
+			Color: color.RGBA{
+				R: data[4],
+				G: data[5],
+				B: data[6],
+				A: data[7],
+			},
+		}
+		data = data[8:]
+	}
+}
+
+func decodeLayerOp(data []byte) float32 {
+	if opconst.OpType(data[0]) != opconst.TypeLayer {
+		panic("invalid op")
+	}
+	bo := binary.LittleEndian
+	return math.Float32frombits(bo.Uint32(data[1:]))
+}
+
 func decodePaintOp(data []byte) paint.PaintOp {
 	bo := binary.LittleEndian
 	if opconst.OpType(data[0]) != opconst.TypePaint {
@@ -216,17 +311,18 @@ type texture struct {
 type blitter struct {
 	ctx         backend.Device
 	viewport    image.Point
-	prog        [2]*program
+	prog        [3]*program
 	layout      backend.InputLayout
 	colUniforms *blitColUniforms
 	texUniforms *blitTexUniforms
+	grUniforms  *blitGradientUniforms
 	quadVerts   backend.Buffer
 }
 
 type blitColUniforms struct {
 	vert struct {
 		blitUniforms
-		_ [10]byte // Padding to a multiple of 16.
+		_ [12]byte // Padding to a multiple of 16.
 	}
 	frag struct {
 		colorUniforms
@@ -236,7 +332,20 @@ type blitColUniforms struct {
 type blitTexUniforms struct {
 	vert struct {
 		blitUniforms
-		_ [10]byte // Padding to a multiple of 16.
+		_ [12]byte // Padding to a multiple of 16.
+	}
+	frag struct {
+		opacityUniforms
+	}
+}
+
+type blitGradientUniforms struct {
+	vert struct {
+		blitUniforms
+		_ [12]byte // Padding to a multiple of 16.
+	}
+	frag struct {
+		gradientUniforms
 	}
 }
 
@@ -252,15 +361,33 @@ type program struct {
 }
 
 type blitUniforms struct {
-	transform   [4]float32
-	uvTransform [4]float32
-	z           float32
+	transform     [4]float32
+	uvTransformR1 [4]float32
+	uvTransformR2 [4]float32
+	z             float32
 }
 
 type colorUniforms struct {
 	color f32color.RGBA
 }
 
+type opacityUniforms struct {
+	opacity float32
+	_       [12]byte // Padding to a multiple of 16.
+}
+
+// gradientUniforms matches the Gradient uniform block of the
+// gradient shader variants. The color of a gradient is stop color 0
+// blended with the color of stop i by clamp(t*scale[i-1] + bias[i-1])
+// for i = 1, 2, ..., where t is the gradient coordinate.
+type gradientUniforms struct {
+	colors [opconst.MaxGradientStops]f32color.RGBA
+	scale  [opconst.MaxGradientStops]float32
+	bias   [opconst.MaxGradientStops]float32
+	radial float32
+	_      [12]byte // Padding to a multiple of 16.
+}
+
 type materialType uint8
 
 const (
@@ -272,6 +399,7 @@ const (
 const (
 	materialColor materialType = iota
 	materialTexture
+	materialGradient
 )
 
 func New(ctx backend.Device) (*GPU, error) {
@@ -317,10 +445,10 @@ func (g *GPU) Collect(viewport image.Point, frameOps *op.Ops) {
 	}
 	for _, p := range g.drawOps.pathOps {
 		if _, exists := g.pathCache.get(p.pathKey); !exists {
-			data := buildPath(g.ctx, p.pathVerts)
+			data := buildPath(g.ctx, p.trans, p.pathQuads)
 			g.pathCache.put(p.pathKey, data)
 		}
-		p.pathVerts = nil
+		p.pathQuads = nil
 	}
 }
 
@@ -349,11 +477,13 @@ func (g *GPU) BeginFrame() {
 	g.renderer.intersect(g.drawOps.imageOps)
 	g.stencilTimer.end()
 	g.coverTimer.begin()
+	g.renderer.drawLayers(g.drawOps.layers, g.drawOps.imageOps)
 	g.ctx.BindFramebuffer(g.defFBO)
 	g.ctx.Viewport(0, 0, viewport.X, viewport.Y)
-	g.renderer.drawOps(g.drawOps.imageOps)
+	g.renderer.drawOps(g.drawOps.imageOps, nil)
 	g.ctx.SetBlend(false)
 	g.renderer.pather.stenciler.invalidateFBO()
+	g.renderer.layers.invalidate(g.ctx)
 	g.coverTimer.end()
 	g.ctx.BindFramebuffer(g.defFBO)
 }
@@ -409,6 +539,7 @@ func newRenderer(ctx backend.Device) *renderer {
 }
 
 func (r *renderer) release() {
+	r.layers.delete(r.ctx, 0)
 	r.pather.release()
 	r.blitter.release()
 }
@@ -431,8 +562,11 @@ func newBlitter(ctx backend.Device) *blitter {
 	}
 	b.colUniforms = new(blitColUniforms)
 	b.texUniforms = new(blitTexUniforms)
+	b.grUniforms = new(blitGradientUniforms)
 	prog, layout, err := createColorPrograms(ctx, shader_blit_vert, shader_blit_frag,
-		[2]interface{}{&b.colUniforms.vert, &b.texUniforms.vert}, [2]interface{}{&b.colUniforms.frag, nil})
+		[3]interface{}{&b.colUniforms.vert, &b.texUniforms.vert, &b.grUniforms.vert},
+		[3]interface{}{&b.colUniforms.frag, &b.texUniforms.frag, &b.grUniforms.frag},
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -449,44 +583,39 @@ func (b *blitter) release() {
 	b.layout.Release()
 }
 
-func createColorPrograms(b backend.Device, vsSrc backend.ShaderSources, fsSrc [2]backend.ShaderSources, vertUniforms, fragUniforms [2]interface{}) ([2]*program, backend.InputLayout, error) {
-	var progs [2]*program
-	prog, err := b.NewProgram(vsSrc, fsSrc[materialTexture])
-	if err != nil {
-		return progs, nil, err
-	}
-	var vertBuffer *uniformBuffer
-	if u := vertUniforms[materialTexture]; u != nil {
-		vertBuffer = newUniformBuffer(b, u)
-		prog.SetVertexUniforms(vertBuffer.buf)
-	}
-	var fragBuffer *uniformBuffer
-	if u := fragUniforms[materialTexture]; u != nil {
-		fragBuffer = newUniformBuffer(b, u)
-		prog.SetFragmentUniforms(fragBuffer.buf)
-	}
-	progs[materialTexture] = newProgram(prog, vertBuffer, fragBuffer)
-	prog, err = b.NewProgram(vsSrc, fsSrc[materialColor])
-	if err != nil {
-		progs[materialTexture].Release()
-		return progs, nil, err
-	}
-	if u := vertUniforms[materialColor]; u != nil {
-		vertBuffer = newUniformBuffer(b, u)
-		prog.SetVertexUniforms(vertBuffer.buf)
+func createColorPrograms(b backend.Device, vsSrc backend.ShaderSources, fsSrc [3]backend.ShaderSources, vertUniforms, fragUniforms [3]interface{}) ([3]*program, backend.InputLayout, error) {
+	var progs [3]*program
+	release := func() {
+		for _, p := range progs {
+			if p != nil {
+				p.Release()
+			}
+		}
 	}
-	if u := fragUniforms[materialColor]; u != nil {
-		fragBuffer = newUniformBuffer(b, u)
-		prog.SetFragmentUniforms(fragBuffer.buf)
+	for i := range progs {
+		prog, err := b.NewProgram(vsSrc, fsSrc[i])
+		if err != nil {
+			release()
+			return progs, nil, err
+		}
+		var vertBuffer *uniformBuffer
+		if u := vertUniforms[i]; u != nil {
+			vertBuffer = newUniformBuffer(b, u)
+			prog.SetVertexUniforms(vertBuffer.buf)
+		}
+		var fragBuffer *uniformBuffer
+		if u := fragUniforms[i]; u != nil {
+			fragBuffer = newUniformBuffer(b, u)
+			prog.SetFragmentUniforms(fragBuffer.buf)
+		}
+		progs[i] = newProgram(prog, vertBuffer, fragBuffer)
 	}
-	progs[materialColor] = newProgram(prog, vertBuffer, fragBuffer)
 	layout, err := b.NewInputLayout(vsSrc, []backend.InputDesc{
 		{Type: backend.DataTypeFloat, Size: 2, Offset: 0},
 		{Type: backend.DataTypeFloat, Size: 2, Offset: 4 * 2},
 	})
 	if err != nil {
-		progs[materialTexture].Release()
-		progs[materialColor].Release()
+		release()
 		return progs, nil, err
 	}
 	return progs, layout, nil
@@ -659,6 +788,9 @@ func (d *drawOps) reset(cache *resourceCache, viewport image.Point) {
 	d.zimageOps = d.zimageOps[:0]
 	d.pathOps = d.pathOps[:0]
 	d.pathOpCache = d.pathOpCache[:0]
+	d.layers = d.layers[:0]
+	d.layerCache = d.layerCache[:0]
+	d.rectQuads = d.rectQuads[:0]
 }
 
 func (d *drawOps) collect(cache *resourceCache, root *op.Ops, viewport image.Point) {
@@ -680,6 +812,11 @@ func (d *drawOps) newPathOp() *pathOp {
 	return &d.pathOpCache[len(d.pathOpCache)-1]
 }
 
+func (d *drawOps) newLayerOp() *layerOp {
+	d.layerCache = append(d.layerCache, layerOp{})
+	return &d.layerCache[len(d.layerCache)-1]
+}
+
 func (d *drawOps) collectOps(r *ops.Reader, state drawState) int {
 	var aux []byte
 	var auxKey ops.Key
@@ -690,23 +827,23 @@ loop:
 			d.profile = true
 		case opconst.TypeTransform:
 			dop := ops.DecodeTransformOp(encOp.Data)
-			state.t = state.t.Multiply(op.TransformOp(dop))
+			state.t = state.t.Mul(dop)
 		case opconst.TypeAux:
 			aux = encOp.Data[opconst.TypeAuxLen:]
-			// The first data byte stores whether the MaxY
-			// fields have been initialized.
-			maxyFilled := aux[0] == 1
-			aux[0] = 1
-			aux = aux[1:]
-			if !maxyFilled {
-				fillMaxY(aux)
-			}
 			auxKey = encOp.Key
 		case opconst.TypeClip:
 			var op clipOp
 			op.decode(encOp.Data)
-			off := state.t.Transform(f32.Point{})
-			state.clip = state.clip.Intersect(op.bounds.Add(off))
+			trans, off := splitTransform(state.t)
+			if len(aux) == 0 && !isScale(trans) {
+				// Rotated or sheared rectangles are clipped
+				// by their outline.
+				n := len(d.rectQuads)
+				d.rectQuads = appendRectQuads(d.rectQuads, op.bounds)
+				aux = d.rectQuads[n:]
+				auxKey = encOp.Key
+			}
+			state.clip = state.clip.Intersect(transformBounds(state.t, op.bounds))
 			if state.clip.Empty() {
 				continue
 			}
@@ -718,9 +855,12 @@ loop:
 			state.cpath = npath
 			if len(aux) > 0 {
 				state.rect = false
-				state.cpath.pathKey = auxKey
+				// The path data depend on the transformation, except
+				// for the offset.
+				state.cpath.pathKey = auxKey.SetTransform(trans)
 				state.cpath.path = true
-				state.cpath.pathVerts = aux
+				state.cpath.pathQuads = aux
+				state.cpath.trans = trans
 				d.pathOps = append(d.pathOps, state.cpath)
 			}
 			aux = nil
@@ -731,16 +871,64 @@ loop:
 		case opconst.TypeImage:
 			state.matType = materialTexture
 			state.image = decodeImageOp(encOp.Data, encOp.Refs)
+		case opconst.TypeLayer:
+			opacity := decodeLayerOp(encOp.Data)
+			if opacity >= 1 {
+				// Opaque layers are equivalent to drawing
+				// their contents directly.
+				continue
+			}
+			l := d.newLayerOp()
+			l.opacity = opacity
+			lstate := state
+			lstate.layer = l
+			// The layer extends to the end of the current state.
+			state.z = d.collectOps(r, lstate)
+			if l.clip.Empty() || opacity <= 0 {
+				break loop
+			}
+			d.layers = append(d.layers, l)
+			state.z++
+			d.addImageOp(state, imageOp{
+				z:    zValue(state.z),
+				clip: l.clip,
+				material: material{
+					material: materialTexture,
+					opacity:  opacity,
+					layer:    l,
+				},
+			})
+			break loop
+		case opconst.TypeLinearGradient, opconst.TypeRadialGradient:
+			state.matType = materialGradient
+			decodeGradientOp(encOp.Data, &state.gradient)
 		case opconst.TypePaint:
 			op := decodePaintOp(encOp.Data)
-			off := state.t.Transform(f32.Point{})
-			clip := state.clip.Intersect(op.Rect.Add(off))
+			trans, off := splitTransform(state.t)
+			clip := state.clip.Intersect(transformBounds(state.t, op.Rect))
 			if clip.Empty() {
 				continue
 			}
+			rect, cpath := state.rect, state.cpath
+			if !isScale(trans) {
+				// Clip rotated or sheared paint areas by their outline.
+				n := len(d.rectQuads)
+				d.rectQuads = appendRectQuads(d.rectQuads, op.Rect)
+				npath := d.newPathOp()
+				*npath = pathOp{
+					parent:    cpath,
+					off:       off,
+					trans:     trans,
+					pathKey:   encOp.Key.SetTransform(trans),
+					path:      true,
+					pathQuads: d.rectQuads[n:],
+				}
+				d.pathOps = append(d.pathOps, npath)
+				rect, cpath = false, npath
+			}
 			bounds := boundRectF(clip)
-			mat := state.materialFor(d.cache, op.Rect, off, bounds)
-			if bounds.Min == (image.Point{}) && bounds.Max == d.viewport && state.rect && mat.opaque && mat.material == materialColor {
+			mat := state.materialFor(d.cache, op.Rect, state.t, bounds)
+			if bounds.Min == (image.Point{}) && bounds.Max == d.viewport && rect && mat.opaque && mat.material == materialColor && state.layer == nil {
 				// The image is a uniform opaque color and takes up the whole screen.
 				// Scrap images up to and including this image and set clear color.
 				d.zimageOps = d.zimageOps[:0]
@@ -750,21 +938,17 @@ loop:
 				continue
 			}
 			state.z++
-			// Assume 16-bit depth buffer.
-			const zdepth = 1 << 16
-			// Convert z to window-space, assuming depth range [0;1].
-			zf := float32(state.z)*2/zdepth - 1.0
 			img := imageOp{
-				z:        zf,
-				path:     state.cpath,
+				z:        zValue(state.z),
+				path:     cpath,
 				off:      off,
 				clip:     bounds,
 				material: mat,
 			}
-			if state.rect && img.material.opaque {
+			if rect && img.material.opaque && state.layer == nil {
 				d.zimageOps = append(d.zimageOps, img)
 			} else {
-				d.imageOps = append(d.imageOps, img)
+				d.addImageOp(state, img)
 			}
 		case opconst.TypePush:
 			state.z = d.collectOps(r, state)
@@ -775,6 +959,22 @@ loop:
 	return state.z
 }
 
+// addImageOp adds a blended image to the layer of state.
+func (d *drawOps) addImageOp(state drawState, img imageOp) {
+	if l := state.layer; l != nil {
+		img.layer = l
+		l.clip = l.clip.Union(img.clip)
+	}
+	d.imageOps = append(d.imageOps, img)
+}
+
+// zValue converts a z index to window-space, assuming a 16-bit
+// depth buffer and depth range [0;1].
+func zValue(z int) float32 {
+	const zdepth = 1 << 16
+	return float32(z)*2/zdepth - 1.0
+}
+
 func expandPathOp(p *pathOp, clip image.Rectangle) {
 	for p != nil {
 		pclip := p.clip
@@ -786,7 +986,7 @@ func expandPathOp(p *pathOp, clip image.Rectangle) {
 	}
 }
 
-func (d *drawState) materialFor(cache *resourceCache, rect f32.Rectangle, off f32.Point, clip image.Rectangle) material {
+func (d *drawState) materialFor(cache *resourceCache, rect f32.Rectangle, trans f32.Affine2D, clip image.Rectangle) material {
 	var m material
 	switch d.matType {
 	case materialColor:
@@ -795,9 +995,23 @@ func (d *drawState) materialFor(cache *resourceCache, rect f32.Rectangle, off f3
 		m.opaque = m.color.A == 1.0
 	case materialTexture:
 		m.material = materialTexture
-		dr := boundRectF(rect.Add(off))
+		m.opacity = 1
+		tex, exists := cache.get(d.image.handle)
+		if !exists {
+			t := &texture{
+				src: d.image.src,
+			}
+			cache.put(d.image.handle, t)
+			tex = t
+		}
+		m.texture = tex.(*texture)
 		sz := d.image.src.Bounds().Size()
 		sr := layout.FRect(d.image.rect)
+		if sx, hx, _, hy, sy, _ := trans.Elems(); hx != 0 || hy != 0 || sx < 0 || sy < 0 {
+			m.uvTrans = textureTransform(sr, sz, rect, trans, clip)
+			break
+		}
+		dr := boundRectF(transformBounds(trans, rect))
 		if dx := float32(dr.Dx()); dx != 0 {
 			// Don't clip 1 px width sources.
 			if sdx := sr.Dx(); sdx > 1 {
@@ -812,20 +1026,75 @@ func (d *drawState) materialFor(cache *resourceCache, rect f32.Rectangle, off f3
 				sr.Max.Y -= (float32(dr.Max.Y-clip.Max.Y)*sdy + dy/2) / dy
 			}
 		}
-		tex, exists := cache.get(d.image.handle)
-		if !exists {
-			t := &texture{
-				src: d.image.src,
+		uvScale, uvOffset := texSpaceTransform(sr, sz)
+		m.uvTrans = f32.NewAffine2D(uvScale.X, 0, uvOffset.X, 0, uvScale.Y, uvOffset.Y)
+	case materialGradient:
+		m.material = materialGradient
+		m.gradient, m.opaque = gradientStops(d.gradient.stops[:d.gradient.nstops])
+		g := d.gradient
+		var gtrans f32.Affine2D
+		if g.radial {
+			m.gradient.radial = 1
+			if r := g.p1.X; r != 0 {
+				gtrans = f32.NewAffine2D(1/r, 0, -g.p0.X/r, 0, 1/r, -g.p0.Y/r)
+			}
+		} else {
+			// Project onto the gradient vector, scaled such
+			// that the end point maps to 1.
+			v := g.p1.Sub(g.p0)
+			if l2 := v.X*v.X + v.Y*v.Y; l2 != 0 {
+				sx, sy := v.X/l2, v.Y/l2
+				gtrans = f32.NewAffine2D(sx, sy, -(g.p0.X*sx + g.p0.Y*sy), 0, 0, 0)
 			}
-			cache.put(d.image.handle, t)
-			tex = t
 		}
-		m.texture = tex.(*texture)
-		m.uvScale, m.uvOffset = texSpaceTransform(sr, sz)
+		// Map quad coordinates to screen coordinates.
+		quad := f32.NewAffine2D(float32(clip.Dx()), 0, float32(clip.Min.X), 0, float32(clip.Dy()), float32(clip.Min.Y))
+		m.uvTrans = gtrans.Mul(trans.Invert()).Mul(quad)
 	}
 	return m
 }
 
+// gradientStops converts gradient stops to uniforms for the gradient
+// shaders and reports whether the gradient is opaque.
+func gradientStops(stops []paint.GradientStop) (gradientUniforms, bool) {
+	var u gradientUniforms
+	if len(stops) == 0 {
+		return u, false
+	}
+	opaque := true
+	prev := stops[0].Offset
+	for i := range u.colors {
+		if i >= len(stops) {
+			// Repeat the last stop.
+			u.colors[i] = u.colors[i-1]
+			continue
+		}
+		s := stops[i]
+		u.colors[i] = f32color.RGBAFromSRGB(s.Color)
+		opaque = opaque && s.Color.A == 0xff
+		if i == 0 {
+			// Colors before the first stop are the color of the
+			// first stop.
+			continue
+		}
+		off := s.Offset
+		if off < prev {
+			off = prev
+		}
+		// Limit the slope of the transition to avoid
+		// dividing by zero for coinciding stops.
+		const maxScale = 1e4
+		scale := float32(maxScale)
+		if d := off - prev; d > 1/maxScale {
+			scale = 1 / d
+		}
+		u.scale[i-1] = scale
+		u.bias[i-1] = -prev * scale
+		prev = off
+	}
+	return u, opaque
+}
+
 func (r *renderer) drawZOps(ops []imageOp) {
 	r.ctx.SetDepthTest(true)
 	r.ctx.BindVertexBuffer(r.blitter.quadVerts, 4*4, 0)
@@ -840,12 +1109,52 @@ func (r *renderer) drawZOps(ops []imageOp) {
 		}
 		drc := img.clip
 		scale, off := clipSpaceTransform(drc, r.blitter.viewport)
-		r.blitter.blit(img.z, m.material, m.color, scale, off, m.uvScale, m.uvOffset)
+		r.blitter.blit(img.z, &m, scale, off)
 	}
 	r.ctx.SetDepthTest(false)
 }
 
-func (r *renderer) drawOps(ops []imageOp) {
+// drawLayers draws the contents of layers to offscreen
+// textures.
+func (r *renderer) drawLayers(layers []*layerOp, ops []imageOp) {
+	if len(layers) == 0 {
+		return
+	}
+	r.layerSizes = r.layerSizes[:0]
+	for _, l := range layers {
+		r.layerSizes = append(r.layerSizes, l.clip.Size())
+	}
+	r.layers.resize(r.ctx, backend.TextureFormatSRGB, r.layerSizes)
+	// Inner layers come before the layers that
+	// contain them.
+	for i, l := range layers {
+		f := r.layers.fbos[i]
+		sz := l.clip.Size()
+		l.tex.tex = f.tex
+		l.uvTrans = f32.Affine2D{}.Scale(f32.Point{}, f32.Point{
+			X: float32(sz.X) / float32(f.size.X),
+			Y: float32(sz.Y) / float32(f.size.Y),
+		})
+		r.ctx.BindFramebuffer(f.fbo)
+		r.ctx.Clear(0.0, 0.0, 0.0, 0.0)
+		r.ctx.Viewport(0, 0, sz.X, sz.Y)
+		r.drawOps(ops, l)
+	}
+}
+
+// drawOps draws the ops that belong to layer, or to the
+// window if layer is nil.
+func (r *renderer) drawOps(ops []imageOp, layer *layerOp) {
+	viewport := r.blitter.viewport
+	var origin image.Point
+	flipY := false
+	if layer != nil {
+		viewport = layer.clip.Size()
+		origin = layer.clip.Min
+		// Flip layer contents to match the orientation
+		// of textures.
+		flipY = r.ctx.Caps().BottomLeftOrigin
+	}
 	r.ctx.SetDepthTest(true)
 	r.ctx.DepthMask(false)
 	r.ctx.BlendFunc(backend.BlendFactorOne, backend.BlendFactorOneMinusSrcAlpha)
@@ -853,17 +1162,26 @@ func (r *renderer) drawOps(ops []imageOp) {
 	r.ctx.BindInputLayout(r.pather.coverer.layout)
 	var coverTex backend.Texture
 	for _, img := range ops {
+		if img.layer != layer {
+			continue
+		}
 		m := img.material
+		if l := m.layer; l != nil {
+			m.texture, m.uvTrans = &l.tex, l.uvTrans
+		}
 		switch m.material {
 		case materialTexture:
 			r.ctx.BindTexture(0, r.texHandle(m.texture))
 		}
 		drc := img.clip
-		scale, off := clipSpaceTransform(drc, r.blitter.viewport)
+		scale, off := clipSpaceTransform(drc.Sub(origin), viewport)
+		if flipY {
+			scale.Y, off.Y = -scale.Y, -off.Y
+		}
 		var fbo stencilFBO
 		switch img.clipType {
 		case clipTypeNone:
-			r.blitter.blit(img.z, m.material, m.color, scale, off, m.uvScale, m.uvOffset)
+			r.blitter.blit(img.z, &m, scale, off)
 			continue
 		case clipTypePath:
 			fbo = r.pather.stenciler.cover(img.place.Idx)
@@ -879,23 +1197,32 @@ func (r *renderer) drawOps(ops []imageOp) {
 			Max: img.place.Pos.Add(drc.Size()),
 		}
 		coverScale, coverOff := texSpaceTransform(toRectF(uv), fbo.size)
-		r.pather.cover(img.z, m.material, m.color, scale, off, m.uvScale, m.uvOffset, coverScale, coverOff)
+		r.pather.cover(img.z, &m, scale, off, coverScale, coverOff)
 	}
 	r.ctx.DepthMask(true)
 	r.ctx.SetDepthTest(false)
 }
 
-func (b *blitter) blit(z float32, mat materialType, col f32color.RGBA, scale, off, uvScale, uvOff f32.Point) {
-	p := b.prog[mat]
+func (b *blitter) blit(z float32, m *material, scale, off f32.Point) {
+	p := b.prog[m.material]
 	b.ctx.BindProgram(p.prog)
 	var uniforms *blitUniforms
-	switch mat {
+	switch m.material {
 	case materialColor:
-		b.colUniforms.frag.color = col
+		b.colUniforms.frag.color = m.color
 		uniforms = &b.colUniforms.vert.blitUniforms
 	case materialTexture:
-		b.texUniforms.vert.uvTransform = [4]float32{uvScale.X, uvScale.Y, uvOff.X, uvOff.Y}
+		b.texUniforms.frag.opacity = m.opacity
+		t1, t2, t3, t4, t5, t6 := m.uvTrans.Elems()
+		b.texUniforms.vert.uvTransformR1 = [4]float32{t1, t2, t3, 0}
+		b.texUniforms.vert.uvTransformR2 = [4]float32{t4, t5, t6, 0}
 		uniforms = &b.texUniforms.vert.blitUniforms
+	case materialGradient:
+		b.grUniforms.frag.gradientUniforms = m.gradient
+		t1, t2, t3, t4, t5, t6 := m.uvTrans.Elems()
+		b.grUniforms.vert.uvTransformR1 = [4]float32{t1, t2, t3, 0}
+		b.grUniforms.vert.uvTransformR2 = [4]float32{t4, t5, t6, 0}
+		uniforms = &b.grUniforms.vert.blitUniforms
 	}
 	uniforms.z = z
 	uniforms.transform = [4]float32{scale.X, scale.Y, off.X, off.Y}
@@ -991,43 +1318,57 @@ func clipSpaceTransform(r image.Rectangle, viewport image.Point) (f32.Point, f32
 	return scale, offset
 }
 
-// Fill in maximal Y coordinates of the NW and NE corners.
-func fillMaxY(verts []byte) {
-	contour := 0
-	bo := binary.LittleEndian
-	for len(verts) > 0 {
-		maxy := float32(math.Inf(-1))
-		i := 0
-		for ; i+path.VertStride*4 <= len(verts); i += path.VertStride * 4 {
-			vert := verts[i : i+path.VertStride]
-			// MaxY contains the integer contour index.
-			pathContour := int(bo.Uint32(vert[int(unsafe.Offsetof(((*path.Vertex)(nil)).MaxY)):]))
-			if contour != pathContour {
-				contour = pathContour
-				break
-			}
-			fromy := math.Float32frombits(bo.Uint32(vert[int(unsafe.Offsetof(((*path.Vertex)(nil)).FromY)):]))
-			ctrly := math.Float32frombits(bo.Uint32(vert[int(unsafe.Offsetof(((*path.Vertex)(nil)).CtrlY)):]))
-			toy := math.Float32frombits(bo.Uint32(vert[int(unsafe.Offsetof(((*path.Vertex)(nil)).ToY)):]))
-			if fromy > maxy {
-				maxy = fromy
-			}
-			if ctrly > maxy {
-				maxy = ctrly
-			}
-			if toy > maxy {
-				maxy = toy
-			}
+// textureTransform returns the transformation from quad texture
+// coordinates of clip to texture coordinates of the sub-image sr
+// of a texture of size sz, drawn to rect transformed by t.
+func textureTransform(sr f32.Rectangle, sz image.Point, rect f32.Rectangle, t f32.Affine2D, clip image.Rectangle) f32.Affine2D {
+	// Map quad coordinates to screen coordinates.
+	quad := f32.NewAffine2D(float32(clip.Dx()), 0, float32(clip.Min.X), 0, float32(clip.Dy()), float32(clip.Min.Y))
+	// Map rect to the unit square.
+	unit := f32.NewAffine2D(1/rect.Dx(), 0, -rect.Min.X/rect.Dx(), 0, 1/rect.Dy(), -rect.Min.Y/rect.Dy())
+	// Map the unit square to the sub-image.
+	uvScale, uvOffset := texSpaceTransform(sr, sz)
+	sub := f32.NewAffine2D(uvScale.X, 0, uvOffset.X, 0, uvScale.Y, uvOffset.Y)
+	return sub.Mul(unit).Mul(t.Invert()).Mul(quad)
+}
+
+// splitTransform splits a transformation into its linear
+// part and its offset.
+func splitTransform(t f32.Affine2D) (f32.Affine2D, f32.Point) {
+	sx, hx, ox, hy, sy, oy := t.Elems()
+	return f32.NewAffine2D(sx, hx, 0, hy, sy, 0), f32.Point{X: ox, Y: oy}
+}
+
+// isScale reports whether the transformation t maps axis-aligned
+// rectangles to axis-aligned rectangles.
+func isScale(t f32.Affine2D) bool {
+	_, hx, _, hy, _, _ := t.Elems()
+	return hx == 0 && hy == 0
+}
+
+// transformBounds returns the smallest rectangle containing r
+// transformed by t.
+func transformBounds(t f32.Affine2D, r f32.Rectangle) f32.Rectangle {
+	corners := [...]f32.Point{
+		t.Transform(r.Min),
+		t.Transform(f32.Point{X: r.Max.X, Y: r.Min.Y}),
+		t.Transform(r.Max),
+		t.Transform(f32.Point{X: r.Min.X, Y: r.Max.Y}),
+	}
+	b := f32.Rectangle{Min: corners[0], Max: corners[0]}
+	for _, c := range corners[1:] {
+		if c.X < b.Min.X {
+			b.Min.X = c.X
+		}
+		if c.Y < b.Min.Y {
+			b.Min.Y = c.Y
+		}
+		if c.X > b.Max.X {
+			b.Max.X = c.X
+		}
+		if c.Y > b.Max.Y {
+			b.Max.Y = c.Y
 		}
-		fillContourMaxY(maxy, verts[:i])
-		verts = verts[i:]
-	}
-}
-
-func fillContourMaxY(maxy float32, verts []byte) {
-	bo := binary.LittleEndian
-	for i := 0; i < len(verts); i += path.VertStride {
-		off := int(unsafe.Offsetof(((*path.Vertex)(nil)).MaxY))
-		bo.PutUint32(verts[i+off:], math.Float32bits(maxy))
 	}
+	return b
 }
@@ -6,12 +6,14 @@ package gpu
 // Pathfinder (https://github.com/servo/pathfinder).
 
 import (
+	"encoding/binary"
 	"image"
+	"math"
 	"unsafe"
 
 	"gioui.org/f32"
 	"gioui.org/gpu/backend"
-	"gioui.org/internal/f32color"
+	"gioui.org/internal/ops"
 	"gioui.org/internal/path"
 	gunsafe "gioui.org/internal/unsafe"
 )
@@ -27,9 +29,10 @@ type pather struct {
 
 type coverer struct {
 	ctx         backend.Device
-	prog        [2]*program
+	prog        [3]*program
 	texUniforms *coverTexUniforms
 	colUniforms *coverColUniforms
+	grUniforms  *coverGradientUniforms
 	layout      backend.InputLayout
 }
 
@@ -38,6 +41,9 @@ type coverTexUniforms struct {
 		coverUniforms
 		_ [12]byte // Padding to multiple of 16.
 	}
+	frag struct {
+		opacityUniforms
+	}
 }
 
 type coverColUniforms struct {
@@ -50,10 +56,21 @@ type coverColUniforms struct {
 	}
 }
 
+type coverGradientUniforms struct {
+	vert struct {
+		coverUniforms
+		_ [12]byte // Padding to multiple of 16.
+	}
+	frag struct {
+		gradientUniforms
+	}
+}
+
 type coverUniforms struct {
 	transform        [4]float32
 	uvCoverTransform [4]float32
-	uvTransform      [4]float32
+	uvTransformR1    [4]float32
+	uvTransformR2    [4]float32
 	z                float32
 }
 
@@ -123,9 +140,10 @@ func newCoverer(ctx backend.Device) *coverer {
 	}
 	c.colUniforms = new(coverColUniforms)
 	c.texUniforms = new(coverTexUniforms)
+	c.grUniforms = new(coverGradientUniforms)
 	prog, layout, err := createColorPrograms(ctx, shader_cover_vert, shader_cover_frag,
-		[2]interface{}{&c.colUniforms.vert, &c.texUniforms.vert},
-		[2]interface{}{&c.colUniforms.frag, nil},
+		[3]interface{}{&c.colUniforms.vert, &c.texUniforms.vert, &c.grUniforms.vert},
+		[3]interface{}{&c.colUniforms.frag, &c.texUniforms.frag, &c.grUniforms.frag},
 	)
 	if err != nil {
 		panic(err)
@@ -191,7 +209,7 @@ func newStenciler(ctx backend.Device) *stenciler {
 	return st
 }
 
-func (s *fboSet) resize(ctx backend.Device, sizes []image.Point) {
+func (s *fboSet) resize(ctx backend.Device, format backend.TextureFormat, sizes []image.Point) {
 	// Add fbos.
 	for i := len(s.fbos); i < len(sizes); i++ {
 		s.fbos = append(s.fbos, stencilFBO{})
@@ -202,14 +220,16 @@ func (s *fboSet) resize(ctx backend.Device, sizes []image.Point) {
 		// Resizing or recreating FBOs can introduce rendering stalls.
 		// Avoid if the space waste is not too high.
 		resize := sz.X > f.size.X || sz.Y > f.size.Y
-		waste := float32(sz.X*sz.Y) / float32(f.size.X*f.size.Y)
-		resize = resize || waste > 1.2
+		if area := sz.X * sz.Y; area > 0 {
+			waste := float32(f.size.X*f.size.Y) / float32(area)
+			resize = resize || waste > 1.2
+		}
 		if resize {
 			if f.fbo != nil {
 				f.fbo.Release()
 				f.tex.Release()
 			}
-			tex, err := ctx.NewTexture(backend.TextureFormatFloat, sz.X, sz.Y, backend.FilterNearest, backend.FilterNearest,
+			tex, err := ctx.NewTexture(format, sz.X, sz.Y, backend.FilterNearest, backend.FilterNearest,
 				backend.BufferBindingTexture|backend.BufferBindingFramebuffer)
 			if err != nil {
 				panic(err)
@@ -263,17 +283,148 @@ func (c *coverer) release() {
 	c.layout.Release()
 }
 
-func buildPath(ctx backend.Device, p []byte) *pathData {
-	buf, err := ctx.NewImmutableBuffer(backend.BufferBindingVertices, p)
+// buildPath transforms the path segments in quads by trans and
+// uploads them to the GPU.
+func buildPath(ctx backend.Device, trans f32.Affine2D, quads []byte) *pathData {
+	verts := encodePath(trans, quads)
+	buf, err := ctx.NewImmutableBuffer(backend.BufferBindingVertices, verts)
 	if err != nil {
 		panic(err)
 	}
 	return &pathData{
-		ncurves: len(p) / path.VertStride,
+		ncurves: len(verts) / path.VertStride,
 		data:    buf,
 	}
 }
 
+// encodePath transforms the path segments in quads, as encoded
+// by ops.EncodeQuad, and encodes them into vertices for the
+// stencil program.
+func encodePath(trans f32.Affine2D, quads []byte) []byte {
+	var verts []byte
+	contour := uint32(0)
+	start := 0
+	maxy := float32(math.Inf(-1))
+	for ; len(quads) >= ops.QuadSize; quads = quads[ops.QuadSize:] {
+		c, q := ops.DecodeQuad(quads)
+		if c != contour {
+			fillMaxY(verts[start:], maxy)
+			contour = c
+			start = len(verts)
+			maxy = float32(math.Inf(-1))
+		}
+		q = q.Transform(trans)
+		// Zero width curves don't contribute to stenciling.
+		if q.From.X == q.To.X && q.From.X == q.Ctrl.X {
+			continue
+		}
+		for _, y := range [...]float32{q.From.Y, q.Ctrl.Y, q.To.Y} {
+			if y > maxy {
+				maxy = y
+			}
+		}
+		verts = encodeQuad(verts, q)
+	}
+	fillMaxY(verts[start:], maxy)
+	return verts
+}
+
+// encodeQuad appends the vertices of q to verts.
+func encodeQuad(verts []byte, q ops.Quad) []byte {
+	// If the curve contain areas where a vertical line
+	// intersects it twice, split the curve in two x monotone
+	// lower and upper curves. The stencil fragment program
+	// expects only one intersection per curve.
+
+	// Find the t where the derivative in x is 0.
+	v0 := q.Ctrl.Sub(q.From)
+	v1 := q.To.Sub(q.Ctrl)
+	d := v0.X - v1.X
+	// t = v0 / d. Split if t is in ]0;1[.
+	if v0.X > 0 && d > v0.X || v0.X < 0 && d < v0.X {
+		t := v0.X / d
+		ctrl0 := q.From.Mul(1 - t).Add(q.Ctrl.Mul(t))
+		ctrl1 := q.Ctrl.Mul(1 - t).Add(q.To.Mul(t))
+		mid := ctrl0.Mul(1 - t).Add(ctrl1.Mul(t))
+		verts = encodeSimpleQuad(verts, ops.Quad{From: q.From, Ctrl: ctrl0, To: mid})
+		return encodeSimpleQuad(verts, ops.Quad{From: mid, Ctrl: ctrl1, To: q.To})
+	}
+	return encodeSimpleQuad(verts, q)
+}
+
+func encodeSimpleQuad(verts []byte, q ops.Quad) []byte {
+	// NW.
+	verts = encodeVertex(verts, -1, 1, q)
+	// NE.
+	verts = encodeVertex(verts, 1, 1, q)
+	// SW.
+	verts = encodeVertex(verts, -1, -1, q)
+	// SE.
+	return encodeVertex(verts, 1, -1, q)
+}
+
+func encodeVertex(verts []byte, cornerx, cornery int16, q ops.Quad) []byte {
+	var corner float32
+	// Encode corner.
+	if cornerx == 1 {
+		corner += .5
+	}
+	if cornery == 1 {
+		corner += .25
+	}
+	v := path.Vertex{
+		Corner: corner,
+		FromX:  q.From.X,
+		FromY:  q.From.Y,
+		CtrlX:  q.Ctrl.X,
+		CtrlY:  q.Ctrl.Y,
+		ToX:    q.To.X,
+		ToY:    q.To.Y,
+	}
+	n := len(verts)
+	verts = append(verts, make([]byte, path.VertStride)...)
+	data := verts[n:]
+	bo := binary.LittleEndian
+	bo.PutUint32(data[0:], math.Float32bits(v.Corner))
+	bo.PutUint32(data[8:], math.Float32bits(v.FromX))
+	bo.PutUint32(data[12:], math.Float32bits(v.FromY))
+	bo.PutUint32(data[16:], math.Float32bits(v.CtrlX))
+	bo.PutUint32(data[20:], math.Float32bits(v.CtrlY))
+	bo.PutUint32(data[24:], math.Float32bits(v.ToX))
+	bo.PutUint32(data[28:], math.Float32bits(v.ToY))
+	return verts
+}
+
+// fillMaxY fills in the maximal Y coordinate of the vertices
+// of a contour.
+func fillMaxY(verts []byte, maxy float32) {
+	bo := binary.LittleEndian
+	off := int(unsafe.Offsetof(((*path.Vertex)(nil)).MaxY))
+	for i := 0; i < len(verts); i += path.VertStride {
+		bo.PutUint32(verts[i+off:], math.Float32bits(maxy))
+	}
+}
+
+// appendRectQuads appends the outline of r as path segments
+// to quads.
+func appendRectQuads(quads []byte, r f32.Rectangle) []byte {
+	corners := [...]f32.Point{
+		r.Min, {X: r.Max.X, Y: r.Min.Y}, r.Max, {X: r.Min.X, Y: r.Max.Y},
+	}
+	for i, from := range corners {
+		to := corners[(i+1)%len(corners)]
+		n := len(quads)
+		quads = append(quads, make([]byte, ops.QuadSize)...)
+		// Model lines as degenerate quadratic Béziers.
+		ops.EncodeQuad(quads[n:], 0, ops.Quad{
+			From: from,
+			Ctrl: from.Add(to).Mul(.5),
+			To:   to,
+		})
+	}
+	return quads
+}
+
 func (p *pathData) release() {
 	p.data.Release()
 }
@@ -291,7 +442,7 @@ func (s *stenciler) beginIntersect(sizes []image.Point) {
 	// 8 bit coverage is enough, but OpenGL ES only supports single channel
 	// floating point formats. Replace with GL_RGB+GL_UNSIGNED_BYTE if
 	// no floating point support is available.
-	s.intersections.resize(s.ctx, sizes)
+	s.intersections.resize(s.ctx, backend.TextureFormatFloat, sizes)
 	s.ctx.BindProgram(s.iprog.prog.prog)
 }
 
@@ -306,7 +457,7 @@ func (s *stenciler) cover(idx int) stencilFBO {
 
 func (s *stenciler) begin(sizes []image.Point) {
 	s.ctx.BlendFunc(backend.BlendFactorOne, backend.BlendFactorOne)
-	s.fbos.resize(s.ctx, sizes)
+	s.fbos.resize(s.ctx, backend.TextureFormatFloat, sizes)
 	s.ctx.BindProgram(s.prog.prog.prog)
 	s.ctx.BindInputLayout(s.prog.layout)
 	s.ctx.BindIndexBuffer(s.indexBuf)
@@ -336,21 +487,30 @@ func (s *stenciler) stencilPath(bounds image.Rectangle, offset f32.Point, uv ima
 	}
 }
 
-func (p *pather) cover(z float32, mat materialType, col f32color.RGBA, scale, off, uvScale, uvOff, coverScale, coverOff f32.Point) {
-	p.coverer.cover(z, mat, col, scale, off, uvScale, uvOff, coverScale, coverOff)
+func (p *pather) cover(z float32, m *material, scale, off f32.Point, coverScale, coverOff f32.Point) {
+	p.coverer.cover(z, m, scale, off, coverScale, coverOff)
 }
 
-func (c *coverer) cover(z float32, mat materialType, col f32color.RGBA, scale, off, uvScale, uvOff, coverScale, coverOff f32.Point) {
-	p := c.prog[mat]
+func (c *coverer) cover(z float32, m *material, scale, off f32.Point, coverScale, coverOff f32.Point) {
+	p := c.prog[m.material]
 	c.ctx.BindProgram(p.prog)
 	var uniforms *coverUniforms
-	switch mat {
+	switch m.material {
 	case materialColor:
-		c.colUniforms.frag.color = col
+		c.colUniforms.frag.color = m.color
 		uniforms = &c.colUniforms.vert.coverUniforms
 	case materialTexture:
-		c.texUniforms.vert.uvTransform = [4]float32{uvScale.X, uvScale.Y, uvOff.X, uvOff.Y}
+		c.texUniforms.frag.opacity = m.opacity
+		t1, t2, t3, t4, t5, t6 := m.uvTrans.Elems()
+		c.texUniforms.vert.uvTransformR1 = [4]float32{t1, t2, t3, 0}
+		c.texUniforms.vert.uvTransformR2 = [4]float32{t4, t5, t6, 0}
 		uniforms = &c.texUniforms.vert.coverUniforms
+	case materialGradient:
+		c.grUniforms.frag.gradientUniforms = m.gradient
+		t1, t2, t3, t4, t5, t6 := m.uvTrans.Elems()
+		c.grUniforms.vert.uvTransformR1 = [4]float32{t1, t2, t3, 0}
+		c.grUniforms.vert.uvTransformR2 = [4]float32{t4, t5, t6, 0}
+		uniforms = &c.grUniforms.vert.coverUniforms
 	}
 	uniforms.z = z
 	uniforms.transform = [4]float32{scale.X, scale.Y, off.X, off.Y}
@@ -8,7 +8,8 @@ precision highp float;
 
 layout(binding = 0) uniform Block {
 	vec4 transform;
-	vec4 uvTransform;
+	vec4 uvTransformR1;
+	vec4 uvTransformR2;
 	float z;
 };
 
@@ -21,5 +22,6 @@ layout(location = 0) out vec2 vUV;
 void main() {
 	vec2 p = pos*transform.xy + transform.zw;
 	gl_Position = toClipSpace(vec4(p, z, 1));
-	vUV = uv*uvTransform.xy + uvTransform.zw;
+	m3x2 uvTransform = m3x2(uvTransformR1.xyz, uvTransformR2.xyz);
+	vUV = transform3x2(uvTransform, vec3(uv,1)).xy;
 }
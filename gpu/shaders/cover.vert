@@ -9,7 +9,8 @@ precision highp float;
 layout(binding = 0) uniform Block {
 	vec4 transform;
 	vec4 uvCoverTransform;
-	vec4 uvTransform;
+	vec4 uvTransformR1;
+	vec4 uvTransformR2;
 	float z;
 };
 
@@ -22,7 +23,8 @@ layout(location = 1) out vec2 vUV;
 
 void main() {
     gl_Position = toClipSpace(vec4(pos*transform.xy + transform.zw, z, 1));
-	vUV = uv*uvTransform.xy + uvTransform.zw;
+	m3x2 uvTransform = m3x2(uvTransformR1.xyz, uvTransformR2.xyz);
+	vUV = transform3x2(uvTransform, vec3(uv,1)).xy;
 	vec3 uv3 = transform3x2(fboTextureTransform, vec3(uv, 1.0));
 	vCoverUV = (uv3*vec3(uvCoverTransform.xy, 1.0)+vec3(uvCoverTransform.zw, 0.0)).xy;
 }
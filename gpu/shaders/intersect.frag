@@ -13,6 +13,6 @@ layout(binding = 0) uniform sampler2D cover;
 layout(location = 0) out vec4 fragColor;
 
 void main() {
-  float cover = abs(texture(cover, vUV).r);
+  float cover = min(abs(texture(cover, vUV).r), 1.0);
   fragColor.r = cover;
 }
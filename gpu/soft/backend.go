@@ -0,0 +1,448 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+/*
+Package soft implements the gpu/backend.Device interface with a
+rasterizer running on the CPU. It is much slower than a hardware
+backend but doesn't depend on graphics drivers, which makes it useful
+for rendering tests on machines without a GPU.
+
+Shader programs can't be compiled from source. Instead, the shaders of
+package gpu and the test shaders of package app/headless are
+implemented in Go and identified by their ShaderSources names.
+
+The backend follows the OpenGL conventions: the origin of framebuffers
+and textures is in their lower left corner and clip space depth is
+mapped from [-1; 1] to [0; 1].
+*/
+package soft
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/gpu/backend"
+	"gioui.org/internal/f32color"
+)
+
+// Backend is a software implementation of backend.Device.
+type Backend struct {
+	state  state
+	defFBO *framebuffer
+}
+
+// state is the current pipeline state.
+type state struct {
+	fbo      *framebuffer
+	prog     *program
+	layout   *inputLayout
+	vertBuf  vertexBinding
+	indexBuf *buffer
+	textures [maxTextures]*texture
+	viewport image.Rectangle
+
+	depthTest bool
+	depthMask bool
+	blend     bool
+	srcFactor backend.BlendFactor
+	dstFactor backend.BlendFactor
+}
+
+type vertexBinding struct {
+	buf    *buffer
+	stride int
+	offset int
+}
+
+type texture struct {
+	width, height int
+	format        backend.TextureFormat
+	filter        backend.TextureFilter
+	// pix contains the texels in linear RGBA, starting
+	// with the bottom row.
+	pix []float32
+}
+
+type framebuffer struct {
+	tex *texture
+	// depth is nil for framebuffers without a depth buffer.
+	depth []float32
+}
+
+type buffer struct {
+	typ       backend.BufferBinding
+	data      []byte
+	immutable bool
+}
+
+type program struct {
+	vert         vertexShader
+	frag         fragmentShader
+	vertUniforms *buffer
+	fragUniforms *buffer
+}
+
+type inputLayout struct {
+	inputs []backend.InputLocation
+	layout []backend.InputDesc
+}
+
+type timer struct {
+	start, end time.Time
+}
+
+const (
+	maxInputs   = 8
+	maxVaryings = 8
+	maxTextures = 2
+)
+
+// NewBackend returns a software backend. Its initial framebuffer
+// has no storage and discards all rendering.
+func NewBackend() *Backend {
+	b := &Backend{defFBO: new(framebuffer)}
+	b.state.fbo = b.defFBO
+	// Enable depth mask to match OpenGL.
+	b.state.depthMask = true
+	return b
+}
+
+func (b *Backend) BeginFrame() {
+}
+
+func (b *Backend) EndFrame() {
+}
+
+func (b *Backend) Caps() backend.Caps {
+	return backend.Caps{
+		BottomLeftOrigin: true,
+		Features:         backend.FeatureTimers,
+		MaxTextureSize:   8192,
+	}
+}
+
+func (b *Backend) NewTimer() backend.Timer {
+	return new(timer)
+}
+
+// IsTimeContinuous returns true, because rendering completes
+// before the rendering methods return.
+func (b *Backend) IsTimeContinuous() bool {
+	return true
+}
+
+func (b *Backend) NewTexture(format backend.TextureFormat, width, height int, minFilter, magFilter backend.TextureFilter, bindings backend.BufferBinding) (backend.Texture, error) {
+	switch format {
+	case backend.TextureFormatSRGB, backend.TextureFormatFloat:
+	default:
+		return nil, fmt.Errorf("soft: unsupported texture format %d", format)
+	}
+	if width < 0 || height < 0 || width > b.Caps().MaxTextureSize || height > b.Caps().MaxTextureSize {
+		return nil, fmt.Errorf("soft: invalid texture size %dx%d", width, height)
+	}
+	return &texture{
+		width:  width,
+		height: height,
+		format: format,
+		filter: magFilter,
+		pix:    make([]float32, width*height*4),
+	}, nil
+}
+
+func (b *Backend) CurrentFramebuffer() backend.Framebuffer {
+	return b.state.fbo
+}
+
+func (b *Backend) NewFramebuffer(tex backend.Texture, depthBits int) (backend.Framebuffer, error) {
+	t := tex.(*texture)
+	fbo := &framebuffer{tex: t}
+	if depthBits > 0 {
+		fbo.depth = make([]float32, t.width*t.height)
+	}
+	return fbo, nil
+}
+
+func (b *Backend) NewImmutableBuffer(typ backend.BufferBinding, data []byte) (backend.Buffer, error) {
+	buf := &buffer{typ: typ, data: make([]byte, len(data))}
+	copy(buf.data, data)
+	buf.immutable = true
+	return buf, nil
+}
+
+func (b *Backend) NewBuffer(typ backend.BufferBinding, size int) (backend.Buffer, error) {
+	if typ&backend.BufferBindingUniforms != 0 && typ != backend.BufferBindingUniforms {
+		return nil, errors.New("uniforms buffers cannot be bound as anything else")
+	}
+	return &buffer{typ: typ, data: make([]byte, size)}, nil
+}
+
+func (b *Backend) NewProgram(vertShader, fragShader backend.ShaderSources) (backend.Program, error) {
+	newVert, ok := vertexShaders[vertShader.Name]
+	if !ok {
+		return nil, fmt.Errorf("soft: unsupported vertex shader %q", vertShader.Name)
+	}
+	newFrag, ok := fragmentShaders[fragShader.Name]
+	if !ok {
+		return nil, fmt.Errorf("soft: unsupported fragment shader %q", fragShader.Name)
+	}
+	vs, err := newVert(vertShader)
+	if err != nil {
+		return nil, err
+	}
+	fs, err := newFrag(fragShader)
+	if err != nil {
+		return nil, err
+	}
+	return &program{vert: vs, frag: fs}, nil
+}
+
+func (b *Backend) NewInputLayout(vs backend.ShaderSources, layout []backend.InputDesc) (backend.InputLayout, error) {
+	if len(vs.Inputs) != len(layout) {
+		return nil, fmt.Errorf("NewInputLayout: got %d inputs, expected %d", len(layout), len(vs.Inputs))
+	}
+	for i, inp := range vs.Inputs {
+		if exp, got := inp.Size, layout[i].Size; exp != got {
+			return nil, fmt.Errorf("NewInputLayout: data size mismatch for %q: got %d expected %d", inp.Name, got, exp)
+		}
+		if inp.Location >= maxInputs {
+			return nil, fmt.Errorf("NewInputLayout: location %d of %q out of range", inp.Location, inp.Name)
+		}
+	}
+	return &inputLayout{
+		inputs: vs.Inputs,
+		layout: layout,
+	}, nil
+}
+
+func (b *Backend) DepthFunc(f backend.DepthFunc) {
+	if f != backend.DepthFuncGreater {
+		panic("unsupported depth func")
+	}
+}
+
+func (b *Backend) ClearDepth(d float32) {
+	if !b.state.depthMask {
+		return
+	}
+	depth := b.state.fbo.depth
+	for i := range depth {
+		depth[i] = d
+	}
+}
+
+func (b *Backend) Clear(colR, colG, colB, colA float32) {
+	t := b.state.fbo.tex
+	if t == nil {
+		return
+	}
+	col := t.clamp([4]float32{colR, colG, colB, colA})
+	for i := 0; i < len(t.pix); i += 4 {
+		copy(t.pix[i:i+4], col[:])
+	}
+}
+
+func (b *Backend) Viewport(x, y, width, height int) {
+	b.state.viewport = image.Rect(x, y, x+width, y+height)
+}
+
+func (b *Backend) DrawArrays(mode backend.DrawMode, off, count int) {
+	b.draw(mode, count, func(i int) int {
+		return off + i
+	})
+}
+
+func (b *Backend) DrawElements(mode backend.DrawMode, off, count int) {
+	// off is in 16-bit indices.
+	indices := b.state.indexBuf.data[off*2:]
+	b.draw(mode, count, func(i int) int {
+		return int(uint16(indices[i*2]) | uint16(indices[i*2+1])<<8)
+	})
+}
+
+func (b *Backend) SetBlend(enable bool) {
+	b.state.blend = enable
+}
+
+func (b *Backend) SetDepthTest(enable bool) {
+	b.state.depthTest = enable
+}
+
+func (b *Backend) DepthMask(mask bool) {
+	b.state.depthMask = mask
+}
+
+func (b *Backend) BlendFunc(sfactor, dfactor backend.BlendFactor) {
+	b.state.srcFactor = sfactor
+	b.state.dstFactor = dfactor
+}
+
+func (b *Backend) BindInputLayout(l backend.InputLayout) {
+	b.state.layout = l.(*inputLayout)
+}
+
+func (b *Backend) BindProgram(p backend.Program) {
+	b.state.prog = p.(*program)
+}
+
+func (b *Backend) BindFramebuffer(f backend.Framebuffer) {
+	b.state.fbo = f.(*framebuffer)
+}
+
+func (b *Backend) BindTexture(unit int, t backend.Texture) {
+	b.state.textures[unit] = t.(*texture)
+}
+
+func (b *Backend) BindVertexBuffer(buf backend.Buffer, stride, offset int) {
+	b.state.vertBuf = vertexBinding{buf: buf.(*buffer), stride: stride, offset: offset}
+}
+
+func (b *Backend) BindIndexBuffer(buf backend.Buffer) {
+	b.state.indexBuf = buf.(*buffer)
+}
+
+func (t *texture) Upload(img *image.RGBA) {
+	if img.Bounds().Size() != image.Pt(t.width, t.height) {
+		panic("texture size mismatch")
+	}
+	for y := 0; y < t.height; y++ {
+		row := img.Pix[y*img.Stride:]
+		pix := t.pix[y*t.width*4:]
+		for x := 0; x < t.width; x++ {
+			r, g, b, a := row[x*4], row[x*4+1], row[x*4+2], row[x*4+3]
+			var c [4]float32
+			if t.format == backend.TextureFormatSRGB {
+				c = [4]float32{srgbTable[r], srgbTable[g], srgbTable[b], float32(a) / 0xff}
+			} else {
+				c = [4]float32{float32(r) / 0xff, float32(g) / 0xff, float32(b) / 0xff, float32(a) / 0xff}
+			}
+			copy(pix[x*4:x*4+4], c[:])
+		}
+	}
+}
+
+func (t *texture) Release() {
+	t.pix = nil
+}
+
+// clamp a color to the range of the texture format.
+func (t *texture) clamp(c [4]float32) [4]float32 {
+	if t.format != backend.TextureFormatSRGB {
+		return c
+	}
+	for i, v := range c {
+		switch {
+		case v < 0:
+			c[i] = 0
+		case v > 1:
+			c[i] = 1
+		}
+	}
+	return c
+}
+
+func (f *framebuffer) Invalidate() {
+}
+
+func (f *framebuffer) Release() {
+	f.tex = nil
+	f.depth = nil
+}
+
+func (f *framebuffer) ReadPixels(src image.Rectangle, pixels []byte) error {
+	t := f.tex
+	if t == nil {
+		return errors.New("soft: framebuffer has no storage")
+	}
+	if !src.In(image.Rect(0, 0, t.width, t.height)) {
+		return errors.New("soft: ReadPixels outside framebuffer")
+	}
+	if len(pixels) < src.Dx()*src.Dy()*4 {
+		return errors.New("unexpected RGBA size")
+	}
+	// Rows are returned top to bottom.
+	stride := src.Dx() * 4
+	for y := 0; y < src.Dy(); y++ {
+		row := pixels[y*stride:]
+		pix := t.pix[((src.Max.Y-1-y)*t.width+src.Min.X)*4:]
+		for x := 0; x < src.Dx(); x++ {
+			c := pix[x*4 : x*4+4]
+			var rgba [4]byte
+			if t.format == backend.TextureFormatSRGB {
+				col := f32color.RGBA{R: c[0], G: c[1], B: c[2], A: c[3]}.SRGB()
+				rgba = [4]byte{col.R, col.G, col.B, col.A}
+			} else {
+				for i := range rgba {
+					rgba[i] = toUnorm8(c[i])
+				}
+			}
+			copy(row[x*4:x*4+4], rgba[:])
+		}
+	}
+	return nil
+}
+
+func (b *buffer) Upload(data []byte) {
+	if b.immutable {
+		panic("immutable buffer")
+	}
+	copy(b.data, data)
+}
+
+func (b *buffer) Release() {
+	b.data = nil
+}
+
+func (p *program) SetVertexUniforms(buf backend.Buffer) {
+	p.vertUniforms = buf.(*buffer)
+}
+
+func (p *program) SetFragmentUniforms(buf backend.Buffer) {
+	p.fragUniforms = buf.(*buffer)
+}
+
+func (p *program) Release() {
+	p.vertUniforms = nil
+	p.fragUniforms = nil
+}
+
+func (l *inputLayout) Release() {}
+
+func (t *timer) Begin() {
+	t.start = time.Now()
+	t.end = time.Time{}
+}
+
+func (t *timer) End() {
+	t.end = time.Now()
+}
+
+func (t *timer) Duration() (time.Duration, bool) {
+	if t.end.IsZero() {
+		return 0, false
+	}
+	return t.end.Sub(t.start), true
+}
+
+func (t *timer) Release() {}
+
+func toUnorm8(v float32) byte {
+	switch {
+	case v <= 0:
+		return 0
+	case v >= 1:
+		return 0xff
+	}
+	return byte(v*0xff + .5)
+}
+
+// srgbTable maps 8-bit sRGB values to linear values.
+var srgbTable [256]float32
+
+func init() {
+	for i := range srgbTable {
+		c := f32color.RGBAFromSRGB(color.Gray{Y: uint8(i)})
+		srgbTable[i] = c.R
+	}
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package soft
+
+import (
+	"encoding/binary"
+	"image"
+	"math"
+
+	"gioui.org/gpu/backend"
+)
+
+// vertex is the output of a vertex shader.
+type vertex struct {
+	// pos is the clip space position.
+	pos      [4]float32
+	varyings varyings
+}
+
+type varyings [maxVaryings]float32
+
+// attributes are the vertex shader inputs, indexed by location.
+type attributes [maxInputs][4]float32
+
+// windowVertex is a vertex transformed to window coordinates.
+type windowVertex struct {
+	x, y, z  float64
+	varyings *varyings
+}
+
+// draw runs the bound program on count vertices, whose indices
+// are given by index.
+func (b *Backend) draw(mode backend.DrawMode, count int, index func(i int) int) {
+	s := &b.state
+	t := s.fbo.tex
+	if t == nil || s.prog == nil {
+		return
+	}
+	// Clip to the viewport and framebuffer.
+	clip := s.viewport.Intersect(image.Rect(0, 0, t.width, t.height))
+	if clip.Empty() {
+		return
+	}
+	var vertUniforms, fragUniforms uniforms
+	if u := s.prog.vertUniforms; u != nil {
+		vertUniforms = u.data
+	}
+	if u := s.prog.fragUniforms; u != nil {
+		fragUniforms = u.data
+	}
+	verts := make([]vertex, count)
+	wverts := make([]windowVertex, count)
+	for i := range verts {
+		idx := index(i)
+		var in attributes
+		b.fetch(&in, idx)
+		v := &verts[i]
+		s.prog.vert.main(vertUniforms, &in, idx, v)
+		w := v.pos[3]
+		// Map from normalized device coordinates to window
+		// coordinates.
+		wverts[i] = windowVertex{
+			x:        float64(s.viewport.Min.X) + (float64(v.pos[0]/w)+1)*.5*float64(s.viewport.Dx()),
+			y:        float64(s.viewport.Min.Y) + (float64(v.pos[1]/w)+1)*.5*float64(s.viewport.Dy()),
+			z:        (float64(v.pos[2]/w) + 1) * .5,
+			varyings: &v.varyings,
+		}
+	}
+	r := rasterizer{
+		b:     b,
+		clip:  clip,
+		shade: s.prog.frag.bind(fragUniforms),
+	}
+	switch mode {
+	case backend.DrawModeTriangles:
+		for i := 0; i+2 < count; i += 3 {
+			r.triangle(&wverts[i], &wverts[i+1], &wverts[i+2])
+		}
+	case backend.DrawModeTriangleStrip:
+		for i := 0; i+2 < count; i++ {
+			r.triangle(&wverts[i], &wverts[i+1], &wverts[i+2])
+		}
+	default:
+		panic("unsupported draw mode")
+	}
+}
+
+// fetch the vertex attributes for the vertex at index idx.
+func (b *Backend) fetch(in *attributes, idx int) {
+	l := b.state.layout
+	if l == nil {
+		return
+	}
+	vb := b.state.vertBuf
+	bo := binary.LittleEndian
+	for i, inp := range l.inputs {
+		desc := l.layout[i]
+		data := vb.buf.data[vb.offset+idx*vb.stride+desc.Offset:]
+		attr := &in[inp.Location]
+		*attr = [4]float32{0, 0, 0, 1}
+		for j := 0; j < desc.Size; j++ {
+			switch desc.Type {
+			case backend.DataTypeFloat:
+				attr[j] = math.Float32frombits(bo.Uint32(data[j*4:]))
+			case backend.DataTypeInt:
+				attr[j] = float32(int32(bo.Uint32(data[j*4:])))
+			case backend.DataTypeShort:
+				attr[j] = float32(int16(bo.Uint16(data[j*2:])))
+			default:
+				panic("unsupported data type")
+			}
+		}
+	}
+}
+
+type rasterizer struct {
+	b     *Backend
+	clip  image.Rectangle
+	shade shadeFunc
+	// Temporary storage for interpolated varyings.
+	varyings varyings
+}
+
+// triangle rasterizes a triangle. Pixels are covered if their
+// centers are inside the triangle. Pixel centers on an edge are
+// covered only for top and left edges, so pixels shared by adjacent
+// triangles are covered exactly once.
+func (r *rasterizer) triangle(v0, v1, v2 *windowVertex) {
+	area := edge(v0, v1, v2.x, v2.y)
+	if area == 0 {
+		return
+	}
+	if area < 0 {
+		// Make the winding counter-clockwise.
+		v1, v2 = v2, v1
+		area = -area
+	}
+	minx := math.Min(math.Min(v0.x, v1.x), v2.x)
+	maxx := math.Max(math.Max(v0.x, v1.x), v2.x)
+	miny := math.Min(math.Min(v0.y, v1.y), v2.y)
+	maxy := math.Max(math.Max(v0.y, v1.y), v2.y)
+	bounds := image.Rect(
+		int(math.Floor(minx)), int(math.Floor(miny)),
+		int(math.Ceil(maxx)), int(math.Ceil(maxy)),
+	).Intersect(r.clip)
+	tl0, tl1, tl2 := topLeft(v1, v2), topLeft(v2, v0), topLeft(v0, v1)
+	nvary := r.b.state.prog.vert.nvaryings
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		py := float64(y) + .5
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			px := float64(x) + .5
+			w0 := edge(v1, v2, px, py)
+			w1 := edge(v2, v0, px, py)
+			w2 := edge(v0, v1, px, py)
+			if !inside(w0, tl0) || !inside(w1, tl1) || !inside(w2, tl2) {
+				continue
+			}
+			l0, l1, l2 := w0/area, w1/area, w2/area
+			z := l0*v0.z + l1*v1.z + l2*v2.z
+			for i := 0; i < nvary; i++ {
+				r.varyings[i] = float32(l0*float64(v0.varyings[i]) + l1*float64(v1.varyings[i]) + l2*float64(v2.varyings[i]))
+			}
+			r.fragment(x, y, float32(z))
+		}
+	}
+}
+
+// fragment shades, tests and blends a single pixel.
+func (r *rasterizer) fragment(x, y int, z float32) {
+	s := &r.b.state
+	fbo := s.fbo
+	idx := y*fbo.tex.width + x
+	if z < 0 || z > 1 {
+		return
+	}
+	if s.depthTest && fbo.depth != nil {
+		if z <= fbo.depth[idx] {
+			return
+		}
+		if s.depthMask {
+			fbo.depth[idx] = z
+		}
+	}
+	c := r.shade(&s.textures, &r.varyings)
+	dst := fbo.tex.pix[idx*4 : idx*4+4]
+	if s.blend {
+		src := c
+		sf := blendFactor(s.srcFactor, src, dst)
+		df := blendFactor(s.dstFactor, src, dst)
+		for i := range c {
+			c[i] = src[i]*sf[i] + dst[i]*df[i]
+		}
+	}
+	c = fbo.tex.clamp(c)
+	copy(dst, c[:])
+}
+
+func blendFactor(f backend.BlendFactor, src [4]float32, dst []float32) [4]float32 {
+	switch f {
+	case backend.BlendFactorOne:
+		return [4]float32{1, 1, 1, 1}
+	case backend.BlendFactorOneMinusSrcAlpha:
+		a := 1 - src[3]
+		return [4]float32{a, a, a, a}
+	case backend.BlendFactorZero:
+		return [4]float32{}
+	case backend.BlendFactorDstColor:
+		return [4]float32{dst[0], dst[1], dst[2], dst[3]}
+	default:
+		panic("unsupported blend factor")
+	}
+}
+
+// edge returns the signed area of the parallelogram spanned by the
+// edge (v0, v1) and the point (x, y). The area is positive if the
+// point is to the left of the edge.
+func edge(v0, v1 *windowVertex, x, y float64) float64 {
+	return (v1.x-v0.x)*(y-v0.y) - (v1.y-v0.y)*(x-v0.x)
+}
+
+// topLeft reports whether the edge (v0, v1) of a counter-clockwise
+// triangle is a top or a left edge. The y axis points up.
+func topLeft(v0, v1 *windowVertex) bool {
+	dx, dy := v1.x-v0.x, v1.y-v0.y
+	// Top edges are horizontal and point left, left edges
+	// point down.
+	return (dy == 0 && dx < 0) || dy < 0
+}
+
+func inside(w float64, topLeft bool) bool {
+	return w > 0 || (w == 0 && topLeft)
+}
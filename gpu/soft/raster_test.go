@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package soft
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/gpu/backend"
+)
+
+// newTestRasterizer returns a rasterizer shading every fragment with
+// col into a float framebuffer of size w×h.
+func newTestRasterizer(w, h int, col [4]float32) (*rasterizer, *texture) {
+	b := NewBackend()
+	tex := &texture{
+		width:  w,
+		height: h,
+		format: backend.TextureFormatFloat,
+		pix:    make([]float32, w*h*4),
+	}
+	b.state.fbo = &framebuffer{tex: tex}
+	b.state.prog = &program{
+		frag: fragmentShader{
+			bind: func(u uniforms) shadeFunc {
+				return func(tex *[maxTextures]*texture, in *varyings) [4]float32 {
+					return col
+				}
+			},
+		},
+	}
+	r := &rasterizer{
+		b:     b,
+		clip:  image.Rect(0, 0, w, h),
+		shade: b.state.prog.frag.bind(nil),
+	}
+	return r, tex
+}
+
+// quad rasterizes the rectangle from (x0, y0) to (x1, y1) as two
+// triangles sharing a diagonal.
+func (r *rasterizer) quad(x0, y0, x1, y1 float64) {
+	v := []windowVertex{
+		{x: x0, y: y0, z: .5},
+		{x: x1, y: y0, z: .5},
+		{x: x1, y: y1, z: .5},
+		{x: x0, y: y1, z: .5},
+	}
+	r.triangle(&v[0], &v[1], &v[2])
+	r.triangle(&v[0], &v[2], &v[3])
+}
+
+func TestFillRule(t *testing.T) {
+	r, tex := newTestRasterizer(4, 4, [4]float32{1, 0, 0, 1})
+	// Add the coverage of the triangles.
+	r.b.SetBlend(true)
+	r.b.BlendFunc(backend.BlendFactorOne, backend.BlendFactorOne)
+	// The diagonal and the edges of the rectangle pass through
+	// pixel centers.
+	r.quad(.5, .5, 2.5, 2.5)
+	for y := 0; y < tex.height; y++ {
+		for x := 0; x < tex.width; x++ {
+			// The left and top edges are covered, the right and
+			// bottom edges are not. The y axis points up.
+			var exp float32
+			if x < 2 && y >= 1 && y < 3 {
+				exp = 1
+			}
+			if got := tex.texel(x, y)[0]; got != exp {
+				t.Errorf("(%d,%d): got coverage %v, expected %v", x, y, got, exp)
+			}
+		}
+	}
+}
+
+func TestBlend(t *testing.T) {
+	tests := []struct {
+		src, dst [4]float32
+		sf, df   backend.BlendFactor
+		exp      [4]float32
+	}{
+		// Source over with premultiplied alpha.
+		{
+			src: [4]float32{.5, 0, 0, .5}, dst: [4]float32{0, 0, 1, 1},
+			sf: backend.BlendFactorOne, df: backend.BlendFactorOneMinusSrcAlpha,
+			exp: [4]float32{.5, 0, .5, 1},
+		},
+		// Multiplication by the destination.
+		{
+			src: [4]float32{.5, .5, 1, 1}, dst: [4]float32{.5, 1, .25, 1},
+			sf: backend.BlendFactorDstColor, df: backend.BlendFactorZero,
+			exp: [4]float32{.25, .5, .25, 1},
+		},
+	}
+	for _, test := range tests {
+		r, tex := newTestRasterizer(1, 1, test.src)
+		r.b.Clear(test.dst[0], test.dst[1], test.dst[2], test.dst[3])
+		r.b.SetBlend(true)
+		r.b.BlendFunc(test.sf, test.df)
+		r.quad(0, 0, 1, 1)
+		if got := tex.texel(0, 0); got != test.exp {
+			t.Errorf("blend %v over %v: got %v, expected %v", test.src, test.dst, got, test.exp)
+		}
+	}
+}
+
+func TestRasterClip(t *testing.T) {
+	r, tex := newTestRasterizer(4, 4, [4]float32{1, 1, 1, 1})
+	r.clip = image.Rect(1, 1, 3, 2)
+	// Cover the framebuffer and more.
+	r.quad(-2, -2, 6, 6)
+	for y := 0; y < tex.height; y++ {
+		for x := 0; x < tex.width; x++ {
+			var exp float32
+			if image.Pt(x, y).In(r.clip) {
+				exp = 1
+			}
+			if got := tex.texel(x, y)[0]; got != exp {
+				t.Errorf("(%d,%d): got %v, expected %v", x, y, got, exp)
+			}
+		}
+	}
+}
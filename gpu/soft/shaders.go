@@ -0,0 +1,520 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package soft
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"gioui.org/gpu/backend"
+)
+
+// vertexShader is the Go implementation of a vertex program.
+type vertexShader struct {
+	// nvaryings is the number of varyings written by main.
+	nvaryings int
+	main      func(u uniforms, in *attributes, index int, out *vertex)
+}
+
+// fragmentShader is the Go implementation of a fragment program.
+type fragmentShader struct {
+	// bind returns the shading function of a draw with uniforms u.
+	// Uniforms are decoded once per draw, not per fragment.
+	bind func(u uniforms) shadeFunc
+}
+
+// shadeFunc computes the color of a fragment.
+type shadeFunc func(tex *[maxTextures]*texture, in *varyings) [4]float32
+
+// fetchFunc computes the paint color at (x, y).
+type fetchFunc func(tex *[maxTextures]*texture, x, y float32) [4]float32
+
+// uniforms is the contents of a uniform buffer.
+type uniforms []byte
+
+// vertexShaders maps shader names to their implementations.
+var vertexShaders = map[string]func(src backend.ShaderSources) (vertexShader, error){
+	"blit.vert":      newBlitVert,
+	"cover.vert":     newCoverVert,
+	"intersect.vert": newIntersectVert,
+	"stencil.vert":   newStencilVert,
+	// Test shaders from package app/headless.
+	"input.vert":  newInputVert,
+	"simple.vert": newSimpleVert,
+}
+
+// fragmentShaders maps shader names to their implementations.
+var fragmentShaders = map[string]func(src backend.ShaderSources) (fragmentShader, error){
+	"blit.frag":      newBlitFrag,
+	"cover.frag":     newCoverFrag,
+	"intersect.frag": newIntersectFrag,
+	"stencil.frag":   newStencilFrag,
+	// Test shader from package app/headless.
+	"simple.frag": newSimpleFrag,
+}
+
+func newBlitVert(src backend.ShaderSources) (vertexShader, error) {
+	offs, err := uniformOffsets(src, "transform", "uvTransformR1", "uvTransformR2", "z")
+	if err != nil {
+		return vertexShader{}, err
+	}
+	return vertexShader{
+		nvaryings: 2,
+		main: func(u uniforms, in *attributes, index int, out *vertex) {
+			transform := u.vec4(offs[0])
+			r1, r2 := u.vec4(offs[1]), u.vec4(offs[2])
+			pos, uv := in[0], in[1]
+			out.pos = [4]float32{
+				pos[0]*transform[0] + transform[2],
+				pos[1]*transform[1] + transform[3],
+				u.float(offs[3]),
+				1,
+			}
+			out.varyings[0] = r1[0]*uv[0] + r1[1]*uv[1] + r1[2]
+			out.varyings[1] = r2[0]*uv[0] + r2[1]*uv[1] + r2[2]
+		},
+	}, nil
+}
+
+func newCoverVert(src backend.ShaderSources) (vertexShader, error) {
+	offs, err := uniformOffsets(src, "transform", "uvCoverTransform", "uvTransformR1", "uvTransformR2", "z")
+	if err != nil {
+		return vertexShader{}, err
+	}
+	return vertexShader{
+		nvaryings: 4,
+		main: func(u uniforms, in *attributes, index int, out *vertex) {
+			transform, coverTransform := u.vec4(offs[0]), u.vec4(offs[1])
+			r1, r2 := u.vec4(offs[2]), u.vec4(offs[3])
+			pos, uv := in[0], in[1]
+			out.pos = [4]float32{
+				pos[0]*transform[0] + transform[2],
+				pos[1]*transform[1] + transform[3],
+				u.float(offs[4]),
+				1,
+			}
+			// vCoverUV.
+			out.varyings[0] = uv[0]*coverTransform[0] + coverTransform[2]
+			out.varyings[1] = uv[1]*coverTransform[1] + coverTransform[3]
+			// vUV.
+			out.varyings[2] = r1[0]*uv[0] + r1[1]*uv[1] + r1[2]
+			out.varyings[3] = r2[0]*uv[0] + r2[1]*uv[1] + r2[2]
+		},
+	}, nil
+}
+
+func newIntersectVert(src backend.ShaderSources) (vertexShader, error) {
+	offs, err := uniformOffsets(src, "uvTransform", "subUVTransform")
+	if err != nil {
+		return vertexShader{}, err
+	}
+	return vertexShader{
+		nvaryings: 2,
+		main: func(u uniforms, in *attributes, index int, out *vertex) {
+			uvTransform, subTransform := u.vec4(offs[0]), u.vec4(offs[1])
+			pos, uv := in[0], in[1]
+			// Apply the OpenGL fboTransform.
+			out.pos = [4]float32{pos[0], -pos[1], 1, 1}
+			for i := 0; i < 2; i++ {
+				v := uv[i]*subTransform[i] + subTransform[2+i]
+				out.varyings[i] = v*uvTransform[i] + uvTransform[2+i]
+			}
+		},
+	}, nil
+}
+
+func newStencilVert(src backend.ShaderSources) (vertexShader, error) {
+	offs, err := uniformOffsets(src, "transform", "pathOffset")
+	if err != nil {
+		return vertexShader{}, err
+	}
+	return vertexShader{
+		nvaryings: 6,
+		main: func(u uniforms, in *attributes, index int, out *vertex) {
+			transform, off := u.vec4(offs[0]), u.vec2(offs[1])
+			corner, maxy := in[0][0], in[1][0]+off[1]
+			from := [2]float32{in[2][0] + off[0], in[2][1] + off[1]}
+			ctrl := [2]float32{in[3][0] + off[0], in[3][1] + off[1]}
+			to := [2]float32{in[4][0] + off[0], in[4][1] + off[1]}
+			// Add a one pixel overlap so curve quads cover their
+			// entire curves.
+			var pos [2]float32
+			c := corner
+			if c >= 0.375 {
+				// North.
+				c -= 0.5
+				pos[1] = maxy + 1
+			} else {
+				// South.
+				pos[1] = min(min(from[1], ctrl[1]), to[1]) - 1
+			}
+			if c >= 0.125 {
+				// East.
+				pos[0] = max(max(from[0], ctrl[0]), to[0]) + 1
+			} else {
+				// West.
+				pos[0] = min(min(from[0], ctrl[0]), to[0]) - 1
+			}
+			for i := 0; i < 2; i++ {
+				out.varyings[i] = from[i] - pos[i]
+				out.varyings[2+i] = ctrl[i] - pos[i]
+				out.varyings[4+i] = to[i] - pos[i]
+			}
+			out.pos = [4]float32{
+				pos[0]*transform[0] + transform[2],
+				pos[1]*transform[1] + transform[3],
+				1,
+				1,
+			}
+		},
+	}, nil
+}
+
+func newInputVert(src backend.ShaderSources) (vertexShader, error) {
+	return vertexShader{
+		main: func(u uniforms, in *attributes, index int, out *vertex) {
+			out.pos = in[0]
+		},
+	}, nil
+}
+
+func newSimpleVert(src backend.ShaderSources) (vertexShader, error) {
+	return vertexShader{
+		main: func(u uniforms, in *attributes, index int, out *vertex) {
+			var x, y float32
+			switch index {
+			case 0:
+				x, y = 0, .5
+			case 1:
+				x, y = .5, -.5
+			default:
+				x, y = -.5, -.5
+			}
+			out.pos = [4]float32{x, y, .5, 1}
+		},
+	}, nil
+}
+
+func newBlitFrag(src backend.ShaderSources) (fragmentShader, error) {
+	fetch, err := newFetchColor(src)
+	if err != nil {
+		return fragmentShader{}, err
+	}
+	return fragmentShader{
+		bind: func(u uniforms) shadeFunc {
+			fetch := fetch(u)
+			return func(tex *[maxTextures]*texture, in *varyings) [4]float32 {
+				return fetch(tex, in[0], in[1])
+			}
+		},
+	}, nil
+}
+
+func newCoverFrag(src backend.ShaderSources) (fragmentShader, error) {
+	fetch, err := newFetchColor(src)
+	if err != nil {
+		return fragmentShader{}, err
+	}
+	unit, err := textureUnit(src, "cover")
+	if err != nil {
+		return fragmentShader{}, err
+	}
+	return fragmentShader{
+		bind: func(u uniforms) shadeFunc {
+			fetch := fetch(u)
+			return func(tex *[maxTextures]*texture, in *varyings) [4]float32 {
+				c := fetch(tex, in[2], in[3])
+				cover := min(abs(tex[unit].sample(in[0], in[1])[0]), 1)
+				for i := range c {
+					c[i] *= cover
+				}
+				return c
+			}
+		},
+	}, nil
+}
+
+func newIntersectFrag(src backend.ShaderSources) (fragmentShader, error) {
+	unit, err := textureUnit(src, "cover")
+	if err != nil {
+		return fragmentShader{}, err
+	}
+	return fragmentShader{
+		bind: func(u uniforms) shadeFunc {
+			return func(tex *[maxTextures]*texture, in *varyings) [4]float32 {
+				cover := min(abs(tex[unit].sample(in[0], in[1])[0]), 1)
+				return [4]float32{cover, 0, 0, 0}
+			}
+		},
+	}, nil
+}
+
+func newStencilFrag(src backend.ShaderSources) (fragmentShader, error) {
+	return fragmentShader{
+		bind: func(u uniforms) shadeFunc {
+			return func(tex *[maxTextures]*texture, in *varyings) [4]float32 {
+				return [4]float32{stencilArea(in), 0, 0, 0}
+			}
+		},
+	}, nil
+}
+
+func newSimpleFrag(src backend.ShaderSources) (fragmentShader, error) {
+	return fragmentShader{
+		bind: func(u uniforms) shadeFunc {
+			return func(tex *[maxTextures]*texture, in *varyings) [4]float32 {
+				return [4]float32{.25, .55, .75, 1}
+			}
+		},
+	}, nil
+}
+
+// stencilArea computes the area of the fragment above the curve
+// segment. See stencil.frag for the derivation.
+func stencilArea(in *varyings) float32 {
+	from := [2]float32{in[0], in[1]}
+	ctrl := [2]float32{in[2], in[3]}
+	to := [2]float32{in[4], in[5]}
+	// Sort from and to in increasing order so the root below
+	// is always the positive square root, if any.
+	left, right := from, to
+	if to[0] < from[0] {
+		left, right = to, from
+	}
+	// The signed horizontal extent of the fragment.
+	extent := [2]float32{clamp(from[0], -.5, .5), clamp(to[0], -.5, .5)}
+	// Find the t where the curve crosses the middle of the
+	// extent.
+	midx := mix(extent[0], extent[1], .5)
+	x0 := midx - left[0]
+	p1 := [2]float32{ctrl[0] - left[0], ctrl[1] - left[1]}
+	v := [2]float32{right[0] - ctrl[0], right[1] - ctrl[1]}
+	t := x0 / (p1[0] + sqrt(p1[0]*p1[0]+(v[0]-p1[0])*x0))
+	// Find y(t) on the curve.
+	y := mix(mix(left[1], ctrl[1], t), mix(ctrl[1], right[1], t), t)
+	// And the slope.
+	dhalf := [2]float32{mix(p1[0], v[0], t), mix(p1[1], v[1], t)}
+	dy := dhalf[1] / dhalf[0]
+	// Compute the fragment area above the line approximation.
+	width := extent[1] - extent[0]
+	dy = abs(dy * width)
+	sides := [4]float32{dy*+.5 + y, dy*-.5 + y, (+.5 - y) / dy, (-.5 - y) / dy}
+	for i, s := range sides {
+		sides[i] = clamp(s+.5, 0, 1)
+	}
+	area := .5 * (sides[2] - sides[2]*sides[1] + 1 - sides[0] + sides[0]*sides[3])
+	area *= width
+	if width == 0 {
+		area = 0
+	}
+	return area
+}
+
+// newFetchColor returns the implementation of the color,
+// texture or gradient variant of a fragment shader, determined
+// by its uniform block.
+func newFetchColor(src backend.ShaderSources) (func(u uniforms) fetchFunc, error) {
+	var block string
+	if b := src.Uniforms.Blocks; len(b) > 0 {
+		block = b[0].Name
+	}
+	switch block {
+	case "Color":
+		offs, err := uniformOffsets(src, "_color")
+		if err != nil {
+			return nil, err
+		}
+		return func(u uniforms) fetchFunc {
+			c := u.vec4(offs[0])
+			return func(tex *[maxTextures]*texture, x, y float32) [4]float32 {
+				return c
+			}
+		}, nil
+	case "Opacity":
+		offs, err := uniformOffsets(src, "_opacity")
+		if err != nil {
+			return nil, err
+		}
+		unit, err := textureUnit(src, "tex")
+		if err != nil {
+			return nil, err
+		}
+		return func(u uniforms) fetchFunc {
+			opacity := u.float(offs[0])
+			return func(tex *[maxTextures]*texture, x, y float32) [4]float32 {
+				c := tex[unit].sample(x, y)
+				for i := range c {
+					c[i] *= opacity
+				}
+				return c
+			}
+		}, nil
+	case "Gradient":
+		names := []string{"_stopScale0", "_stopScale1", "_stopBias0", "_stopBias1", "_radial"}
+		for i := 0; i < 8; i++ {
+			names = append(names, fmt.Sprintf("_stopColor%d", i))
+		}
+		offs, err := uniformOffsets(src, names...)
+		if err != nil {
+			return nil, err
+		}
+		return func(u uniforms) fetchFunc {
+			radial := u.float(offs[4]) != 0
+			// The 7 transitions between the 8 stops.
+			var scale, bias [7]float32
+			scale0, scale1 := u.vec4(offs[0]), u.vec4(offs[1])
+			bias0, bias1 := u.vec4(offs[2]), u.vec4(offs[3])
+			copy(scale[:], scale0[:])
+			copy(scale[4:], scale1[:3])
+			copy(bias[:], bias0[:])
+			copy(bias[4:], bias1[:3])
+			var stops [8][4]float32
+			for i := range stops {
+				stops[i] = u.vec4(offs[5+i])
+			}
+			return func(tex *[maxTextures]*texture, x, y float32) [4]float32 {
+				t := x
+				if radial {
+					t = sqrt(x*x + y*y)
+				}
+				c := stops[0]
+				for i := range scale {
+					s := clamp(t*scale[i]+bias[i], 0, 1)
+					for j := range c {
+						c[j] = mix(c[j], stops[i+1][j], s)
+					}
+				}
+				return c
+			}
+		}, nil
+	default:
+		return nil, fmt.Errorf("%s: unknown uniform block %q", src.Name, block)
+	}
+}
+
+// uniformOffsets looks up the offsets of uniforms by name.
+func uniformOffsets(src backend.ShaderSources, names ...string) ([]int, error) {
+	offs := make([]int, len(names))
+loop:
+	for i, name := range names {
+		for _, l := range src.Uniforms.Locations {
+			// Strip the block prefix.
+			n := l.Name
+			if j := strings.LastIndexByte(n, '.'); j != -1 {
+				n = n[j+1:]
+			}
+			if n == name {
+				offs[i] = l.Offset
+				continue loop
+			}
+		}
+		return nil, fmt.Errorf("%s: no uniform %q", src.Name, name)
+	}
+	return offs, nil
+}
+
+// textureUnit looks up the binding of a texture by name.
+func textureUnit(src backend.ShaderSources, name string) (int, error) {
+	for _, t := range src.Textures {
+		if t.Name == name {
+			if t.Binding >= maxTextures {
+				return 0, fmt.Errorf("%s: texture binding %d out of range", src.Name, t.Binding)
+			}
+			return t.Binding, nil
+		}
+	}
+	return 0, fmt.Errorf("%s: no texture %q", src.Name, name)
+}
+
+func (u uniforms) float(off int) float32 {
+	if off+4 > len(u) {
+		return 0
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(u[off:]))
+}
+
+func (u uniforms) vec2(off int) [2]float32 {
+	return [2]float32{u.float(off), u.float(off + 4)}
+}
+
+func (u uniforms) vec4(off int) [4]float32 {
+	return [4]float32{u.float(off), u.float(off + 4), u.float(off + 8), u.float(off + 12)}
+}
+
+// sample the texture at the normalized coordinates (x, y), with
+// coordinates clamped to the edges.
+func (t *texture) sample(x, y float32) [4]float32 {
+	if t == nil || t.width == 0 || t.height == 0 {
+		return [4]float32{0, 0, 0, 1}
+	}
+	fx, fy := x*float32(t.width), y*float32(t.height)
+	if t.filter == backend.FilterNearest {
+		return t.texel(int(floor(fx)), int(floor(fy)))
+	}
+	fx, fy = fx-.5, fy-.5
+	x0, y0 := floor(fx), floor(fy)
+	ax, ay := fx-x0, fy-y0
+	ix, iy := int(x0), int(y0)
+	c00, c10 := t.texel(ix, iy), t.texel(ix+1, iy)
+	c01, c11 := t.texel(ix, iy+1), t.texel(ix+1, iy+1)
+	var c [4]float32
+	for i := range c {
+		c[i] = mix(mix(c00[i], c10[i], ax), mix(c01[i], c11[i], ax), ay)
+	}
+	return c
+}
+
+func (t *texture) texel(x, y int) [4]float32 {
+	x = clampInt(x, 0, t.width-1)
+	y = clampInt(y, 0, t.height-1)
+	i := (y*t.width + x) * 4
+	var c [4]float32
+	copy(c[:], t.pix[i:i+4])
+	return c
+}
+
+func mix(x, y, a float32) float32 {
+	return x + (y-x)*a
+}
+
+func clamp(v, lo, hi float32) float32 {
+	return min(max(v, lo), hi)
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+func min(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func abs(v float32) float32 {
+	return float32(math.Abs(float64(v)))
+}
+
+func sqrt(v float32) float32 {
+	return float32(math.Sqrt(float64(v)))
+}
+
+func floor(v float32) float32 {
+	return float32(math.Floor(float64(v)))
+}
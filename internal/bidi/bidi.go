@@ -0,0 +1,747 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+// Package bidi implements the Unicode Bidirectional Algorithm of
+// Unicode Standard Annex #9, https://unicode.org/reports/tr9/.
+//
+// Paragraph resolves the embedding levels of a paragraph, Line adjusts
+// the levels of a line of the paragraph and Reorder computes the visual
+// order of a line from its levels. The character classes are from
+// golang.org/x/text/unicode/bidi.
+package bidi
+
+import (
+	"golang.org/x/text/unicode/bidi"
+)
+
+type class = bidi.Class
+
+const (
+	classL   = bidi.L
+	classR   = bidi.R
+	classEN  = bidi.EN
+	classES  = bidi.ES
+	classET  = bidi.ET
+	classAN  = bidi.AN
+	classCS  = bidi.CS
+	classB   = bidi.B
+	classS   = bidi.S
+	classWS  = bidi.WS
+	classON  = bidi.ON
+	classBN  = bidi.BN
+	classNSM = bidi.NSM
+	classAL  = bidi.AL
+	classLRO = bidi.LRO
+	classRLO = bidi.RLO
+	classLRE = bidi.LRE
+	classRLE = bidi.RLE
+	classPDF = bidi.PDF
+	classLRI = bidi.LRI
+	classRLI = bidi.RLI
+	classFSI = bidi.FSI
+	classPDI = bidi.PDI
+)
+
+// maxDepth is the maximum explicit embedding level.
+const maxDepth = 125
+
+// maxBrackets is the size of the bracket stack of rule BD16.
+const maxBrackets = 63
+
+// paragraph is the state of the resolution of a paragraph.
+type paragraph struct {
+	// classes are the original classes of the characters.
+	classes []class
+	// types are the resolved classes of the characters.
+	types  []class
+	levels []uint8
+	// matching is the index of the matching PDI of each isolate
+	// initiator and the index of the isolate initiator of each
+	// matching PDI, or -1.
+	matching []int
+	runes    []rune
+	level    uint8
+}
+
+// Paragraph resolves the embedding levels of a paragraph with the
+// rules P2 to I2. The paragraph level is the level of the first strong
+// character, or 0 if there is none. Odd levels are right-to-left.
+func Paragraph(runes []rune) (levels []uint8, level uint8) {
+	p := &paragraph{
+		runes:   runes,
+		classes: make([]class, len(runes)),
+		levels:  make([]uint8, len(runes)),
+	}
+	simple := true
+	for i, r := range runes {
+		c := classOf(r)
+		p.classes[i] = c
+		switch c {
+		case classR, classAL, classAN, classLRO, classRLO, classLRE, classRLE,
+			classPDF, classLRI, classRLI, classFSI, classPDI:
+			simple = false
+		}
+	}
+	if simple {
+		// Text without right-to-left characters, Arabic numbers
+		// and explicit formatting is entirely at level 0.
+		return p.levels, 0
+	}
+	p.types = make([]class, len(runes))
+	copy(p.types, p.classes)
+	p.matchIsolates()
+	p.level = p.firstStrong(0, len(runes))
+	p.explicit()
+	for _, seq := range p.sequences() {
+		seq.resolveWeak()
+		seq.resolveBrackets()
+		seq.resolveNeutral()
+		seq.resolveImplicit()
+	}
+	return p.levels, p.level
+}
+
+// Line applies rule L1 to the levels of a line of a paragraph with
+// level para: segment separators and trailing whitespace are reset to
+// the paragraph level.
+func Line(runes []rune, levels []uint8, para uint8) {
+	// Reset whitespace preceding separators and the end of the line.
+	trailing := true
+	for i := len(runes) - 1; i >= 0; i-- {
+		switch c := classOf(runes[i]); {
+		case c == classS || c == classB:
+			levels[i] = para
+			trailing = true
+		case trailing && (isWhitespace(c) || isRemoved(c)):
+			levels[i] = para
+		default:
+			trailing = false
+		}
+	}
+}
+
+// Reorder returns the visual order of a line according to rule L2.
+// The i'th element of the order is the logical index of the i'th
+// character from the left.
+func Reorder(levels []uint8) []int {
+	order := make([]int, len(levels))
+	for i := range order {
+		order[i] = i
+	}
+	var highest uint8
+	lowestOdd := uint8(maxDepth + 2)
+	for _, l := range levels {
+		if l > highest {
+			highest = l
+		}
+		if l&1 == 1 && l < lowestOdd {
+			lowestOdd = l
+		}
+	}
+	lv := make([]uint8, len(levels))
+	copy(lv, levels)
+	for l := highest; l >= lowestOdd && l > 0; l-- {
+		for i := 0; i < len(lv); {
+			if lv[i] < l {
+				i++
+				continue
+			}
+			j := i + 1
+			for j < len(lv) && lv[j] >= l {
+				j++
+			}
+			reverseInts(order[i:j])
+			reverseLevels(lv[i:j])
+			i = j
+		}
+	}
+	return order
+}
+
+// Run is a run of characters with the same embedding level.
+type Run struct {
+	// Start and End are the logical indices of the run characters.
+	Start, End int
+	Level      uint8
+}
+
+// Runs returns the runs of a line with the embedding levels, in
+// visual order from left to right.
+func Runs(levels []uint8) []Run {
+	order := Reorder(levels)
+	var runs []Run
+	for i := 0; i < len(order); {
+		lvl := levels[order[i]]
+		j := i + 1
+		for j < len(order) && levels[order[j]] == lvl {
+			j++
+		}
+		// The characters of a run are contiguous in logical order.
+		start, end := order[i], order[j-1]
+		if start > end {
+			start, end = end, start
+		}
+		runs = append(runs, Run{Start: start, End: end + 1, Level: lvl})
+		i = j
+	}
+	return runs
+}
+
+// Mirror returns the mirror image of r, or r if it has none. Rule L4
+// requires mirrored characters at right-to-left levels to be displayed
+// as their mirror images.
+func Mirror(r rune) rune {
+	if m, ok := brackets[r]; ok {
+		return m
+	}
+	if m, ok := closers[r]; ok {
+		return m
+	}
+	if m, ok := mirrors[r]; ok {
+		return m
+	}
+	return r
+}
+
+func classOf(r rune) class {
+	p, _ := bidi.LookupRune(r)
+	return p.Class()
+}
+
+// matchIsolates finds the matching PDI of isolate initiators (BD9).
+func (p *paragraph) matchIsolates() {
+	p.matching = make([]int, len(p.classes))
+	var stack []int
+	for i, c := range p.classes {
+		p.matching[i] = -1
+		switch c {
+		case classLRI, classRLI, classFSI:
+			stack = append(stack, i)
+		case classPDI:
+			if n := len(stack); n > 0 {
+				p.matching[stack[n-1]] = i
+				p.matching[i] = stack[n-1]
+				stack = stack[:n-1]
+			}
+		}
+	}
+}
+
+// firstStrong returns the level of the first strong character between
+// start and end, skipping isolates (rules P2 and P3).
+func (p *paragraph) firstStrong(start, end int) uint8 {
+	for i := start; i < end; i++ {
+		switch p.classes[i] {
+		case classL:
+			return 0
+		case classR, classAL:
+			return 1
+		case classLRI, classRLI, classFSI:
+			if m := p.matching[i]; m != -1 {
+				i = m
+			} else {
+				return 0
+			}
+		}
+	}
+	return 0
+}
+
+type status struct {
+	level    uint8
+	override class
+	isolate  bool
+}
+
+// explicit resolves the explicit levels and directions (rules X1 to
+// X8).
+func (p *paragraph) explicit() {
+	stack := []status{{level: p.level, override: classON}}
+	var overflowIsolates, overflowEmbeddings, validIsolates int
+	for i, c := range p.classes {
+		top := stack[len(stack)-1]
+		switch c {
+		case classRLE, classLRE, classRLO, classLRO:
+			p.levels[i] = top.level
+			l := nextLevel(top.level, c == classRLE || c == classRLO)
+			if l <= maxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				s := status{level: l, override: classON}
+				switch c {
+				case classRLO:
+					s.override = classR
+				case classLRO:
+					s.override = classL
+				}
+				stack = append(stack, s)
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+		case classRLI, classLRI, classFSI:
+			p.levels[i] = top.level
+			if top.override != classON {
+				p.types[i] = top.override
+			}
+			rtl := c == classRLI
+			if c == classFSI {
+				end := p.matching[i]
+				if end == -1 {
+					end = len(p.classes)
+				}
+				rtl = p.firstStrong(i+1, end) == 1
+			}
+			l := nextLevel(top.level, rtl)
+			if l <= maxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates++
+				stack = append(stack, status{level: l, override: classON, isolate: true})
+			} else {
+				overflowIsolates++
+			}
+		case classPDI:
+			switch {
+			case overflowIsolates > 0:
+				overflowIsolates--
+			case validIsolates == 0:
+			default:
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			top = stack[len(stack)-1]
+			p.levels[i] = top.level
+			if top.override != classON {
+				p.types[i] = top.override
+			}
+		case classPDF:
+			p.levels[i] = top.level
+			switch {
+			case overflowIsolates > 0:
+			case overflowEmbeddings > 0:
+				overflowEmbeddings--
+			case !top.isolate && len(stack) >= 2:
+				stack = stack[:len(stack)-1]
+			}
+		case classB:
+			p.levels[i] = p.level
+		case classBN:
+			p.levels[i] = top.level
+		default:
+			p.levels[i] = top.level
+			if top.override != classON {
+				p.types[i] = top.override
+			}
+		}
+	}
+}
+
+// sequence is an isolating run sequence (BD13).
+type sequence struct {
+	p *paragraph
+	// indices are the indices of the characters in the paragraph.
+	indices []int
+	// types are the classes of the characters, resolved in place.
+	types    []class
+	level    uint8
+	sos, eos class
+}
+
+// sequences computes the isolating run sequences of the paragraph
+// (rules X9 and X10).
+func (p *paragraph) sequences() []*sequence {
+	// Split the characters that remain after X9 into level runs.
+	var runs [][]int
+	runOf := make([]int, len(p.classes))
+	var run []int
+	for i, c := range p.classes {
+		runOf[i] = -1
+		if isRemoved(c) {
+			continue
+		}
+		if len(run) > 0 && p.levels[run[len(run)-1]] != p.levels[i] {
+			runs = append(runs, run)
+			run = nil
+		}
+		runOf[i] = len(runs)
+		run = append(run, i)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	var seqs []*sequence
+	for _, run := range runs {
+		first := run[0]
+		if p.classes[first] == classPDI && p.matching[first] != -1 {
+			// The run continues a sequence.
+			continue
+		}
+		var indices []int
+		for {
+			indices = append(indices, run...)
+			last := run[len(run)-1]
+			m := p.isolateEnd(last)
+			if m == -1 || runOf[m] == -1 {
+				break
+			}
+			run = runs[runOf[m]]
+		}
+		seqs = append(seqs, p.newSequence(indices))
+	}
+	return seqs
+}
+
+// isolateEnd returns the index of the matching PDI if the character at
+// i is an isolate initiator, or -1.
+func (p *paragraph) isolateEnd(i int) int {
+	switch p.classes[i] {
+	case classLRI, classRLI, classFSI:
+		return p.matching[i]
+	}
+	return -1
+}
+
+func (p *paragraph) newSequence(indices []int) *sequence {
+	s := &sequence{
+		p:       p,
+		indices: indices,
+		types:   make([]class, len(indices)),
+		level:   p.levels[indices[0]],
+	}
+	for i, idx := range indices {
+		s.types[i] = p.types[idx]
+	}
+	prev := p.level
+	for i := indices[0] - 1; i >= 0; i-- {
+		if !isRemoved(p.classes[i]) {
+			prev = p.levels[i]
+			break
+		}
+	}
+	last := indices[len(indices)-1]
+	next := p.level
+	switch p.classes[last] {
+	case classLRI, classRLI, classFSI:
+		// The sequence ends with an isolate initiator
+		// without a matching PDI.
+	default:
+		for i := last + 1; i < len(p.classes); i++ {
+			if !isRemoved(p.classes[i]) {
+				next = p.levels[i]
+				break
+			}
+		}
+	}
+	s.sos = directionOf(maxLevel(prev, s.level))
+	s.eos = directionOf(maxLevel(next, s.level))
+	return s
+}
+
+// resolveWeak applies the rules W1 to W7.
+func (s *sequence) resolveWeak() {
+	t := s.types
+	// W1.
+	for i, c := range t {
+		if c != classNSM {
+			continue
+		}
+		if i == 0 {
+			t[i] = s.sos
+			continue
+		}
+		switch s.p.classes[s.indices[i-1]] {
+		case classLRI, classRLI, classFSI, classPDI:
+			t[i] = classON
+		default:
+			t[i] = t[i-1]
+		}
+	}
+	// W2 and W3.
+	strong := s.sos
+	for i, c := range t {
+		switch c {
+		case classL, classR:
+			strong = c
+		case classAL:
+			strong = c
+			t[i] = classR
+		case classEN:
+			if strong == classAL {
+				t[i] = classAN
+			}
+		}
+	}
+	// W4.
+	for i := 1; i < len(t)-1; i++ {
+		prev, next := t[i-1], t[i+1]
+		switch t[i] {
+		case classES:
+			if prev == classEN && next == classEN {
+				t[i] = classEN
+			}
+		case classCS:
+			if prev == next && (prev == classEN || prev == classAN) {
+				t[i] = prev
+			}
+		}
+	}
+	// W5.
+	for i := 0; i < len(t); {
+		if t[i] != classET {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(t) && t[j] == classET {
+			j++
+		}
+		if (i > 0 && t[i-1] == classEN) || (j < len(t) && t[j] == classEN) {
+			for k := i; k < j; k++ {
+				t[k] = classEN
+			}
+		}
+		i = j
+	}
+	// W6.
+	for i, c := range t {
+		switch c {
+		case classES, classET, classCS:
+			t[i] = classON
+		}
+	}
+	// W7.
+	strong = s.sos
+	for i, c := range t {
+		switch c {
+		case classL, classR:
+			strong = c
+		case classEN:
+			if strong == classL {
+				t[i] = classL
+			}
+		}
+	}
+}
+
+type bracketPair struct {
+	open, close int
+}
+
+// resolveBrackets applies rule N0.
+func (s *sequence) resolveBrackets() {
+	t := s.types
+	// Identify the bracket pairs (BD16).
+	type opener struct {
+		pos     int
+		closing rune
+	}
+	var stack []opener
+	var pairs []bracketPair
+loop:
+	for i, idx := range s.indices {
+		if t[i] != classON {
+			continue
+		}
+		r := canonicalBracket(s.p.runes[idx])
+		if c, ok := brackets[r]; ok {
+			if len(stack) == maxBrackets {
+				break loop
+			}
+			stack = append(stack, opener{pos: i, closing: c})
+			continue
+		}
+		if _, ok := closers[r]; !ok {
+			continue
+		}
+		for j := len(stack) - 1; j >= 0; j-- {
+			if stack[j].closing == r {
+				pairs = append(pairs, bracketPair{open: stack[j].pos, close: i})
+				stack = stack[:j]
+				break
+			}
+		}
+	}
+	sortPairs(pairs)
+	e := directionOf(s.level)
+	for _, bp := range pairs {
+		var opposite bool
+		var dir class
+		for i := bp.open + 1; i < bp.close; i++ {
+			d := strongDirection(t[i])
+			if d == e {
+				dir = e
+				break
+			}
+			if d != classON {
+				opposite = true
+			}
+		}
+		if dir != e {
+			if !opposite {
+				continue
+			}
+			// Use the direction of the context before the
+			// opening bracket.
+			ctx := s.sos
+			for i := bp.open - 1; i >= 0; i-- {
+				if d := strongDirection(t[i]); d != classON {
+					ctx = d
+					break
+				}
+			}
+			dir = e
+			if ctx != e {
+				dir = ctx
+			}
+		}
+		s.setBracket(bp.open, dir)
+		s.setBracket(bp.close, dir)
+	}
+}
+
+// setBracket sets the direction of the bracket at i and the
+// non-spacing marks following it.
+func (s *sequence) setBracket(i int, dir class) {
+	s.types[i] = dir
+	for i++; i < len(s.types) && s.p.classes[s.indices[i]] == classNSM; i++ {
+		s.types[i] = dir
+	}
+}
+
+// resolveNeutral applies the rules N1 and N2.
+func (s *sequence) resolveNeutral() {
+	t := s.types
+	e := directionOf(s.level)
+	for i := 0; i < len(t); {
+		if !isNeutral(t[i]) {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(t) && isNeutral(t[j]) {
+			j++
+		}
+		before, after := s.sos, s.eos
+		if i > 0 {
+			before = strongDirection(t[i-1])
+		}
+		if j < len(t) {
+			after = strongDirection(t[j])
+		}
+		dir := e
+		if before == after {
+			dir = before
+		}
+		for k := i; k < j; k++ {
+			t[k] = dir
+		}
+		i = j
+	}
+}
+
+// resolveImplicit applies the rules I1 and I2.
+func (s *sequence) resolveImplicit() {
+	for i, idx := range s.indices {
+		l := s.p.levels[idx]
+		switch t := s.types[i]; {
+		case l&1 == 0 && t == classR:
+			l++
+		case l&1 == 0 && (t == classAN || t == classEN):
+			l += 2
+		case l&1 == 1 && (t == classL || t == classAN || t == classEN):
+			l++
+		}
+		s.p.levels[idx] = l
+	}
+}
+
+// canonicalBracket maps brackets to their canonical equivalents.
+func canonicalBracket(r rune) rune {
+	switch r {
+	case 0x2329:
+		return 0x3008
+	case 0x232A:
+		return 0x3009
+	}
+	return r
+}
+
+func sortPairs(pairs []bracketPair) {
+	// Insertion sort by opening position; the number of pairs is
+	// small.
+	for i := 1; i < len(pairs); i++ {
+		for j := i; j > 0 && pairs[j].open < pairs[j-1].open; j-- {
+			pairs[j], pairs[j-1] = pairs[j-1], pairs[j]
+		}
+	}
+}
+
+// strongDirection returns the strong direction of a resolved class,
+// where numbers count as right-to-left, or ON if the class is not
+// strong.
+func strongDirection(c class) class {
+	switch c {
+	case classL:
+		return classL
+	case classR, classAL, classEN, classAN:
+		return classR
+	}
+	return classON
+}
+
+func isNeutral(c class) bool {
+	switch c {
+	case classB, classS, classWS, classON, classLRI, classRLI, classFSI, classPDI:
+		return true
+	}
+	return false
+}
+
+func isWhitespace(c class) bool {
+	switch c {
+	case classWS, classLRI, classRLI, classFSI, classPDI:
+		return true
+	}
+	return false
+}
+
+// isRemoved reports whether rule X9 removes characters of class c.
+func isRemoved(c class) bool {
+	switch c {
+	case classRLE, classLRE, classRLO, classLRO, classPDF, classBN:
+		return true
+	}
+	return false
+}
+
+func nextLevel(l uint8, rtl bool) uint8 {
+	if rtl {
+		return (l + 1) | 1
+	}
+	return (l + 2) &^ 1
+}
+
+func directionOf(l uint8) class {
+	if l&1 == 1 {
+		return classR
+	}
+	return classL
+}
+
+func maxLevel(a, b uint8) uint8 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func reverseInts(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+func reverseLevels(s []uint8) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package bidi
+
+import (
+	"testing"
+)
+
+// visual returns the display order of a paragraph line, with mirrored
+// characters at right-to-left levels.
+func visual(s string) (string, uint8) {
+	runes := []rune(s)
+	levels, para := Paragraph(runes)
+	Line(runes, levels, para)
+	var out []rune
+	for _, i := range Reorder(levels) {
+		r := runes[i]
+		if levels[i]&1 == 1 {
+			r = Mirror(r)
+		}
+		out = append(out, r)
+	}
+	return string(out), para
+}
+
+func TestParagraph(t *testing.T) {
+	tests := []struct {
+		logical, visual string
+		para            uint8
+	}{
+		{"abc def", "abc def", 0},
+		{"אבג דהו", "והד גבא", 1},
+		{"abc אבג def", "abc גבא def", 0},
+		// Numbers keep their order in right-to-left text.
+		{"אבג 123", "123 גבא", 1},
+		{"abc 123 אבג", "abc 123 גבא", 0},
+		{"אבג 12.5%", "12.5% גבא", 1},
+		// Brackets take the direction of the embedding and are
+		// mirrored.
+		{"א(b)", "(b)א", 1},
+		{"a (אב) c", "a (בא) c", 0},
+		// Trailing whitespace is at the paragraph level.
+		{"abc אבג ", "abc גבא ", 0},
+		// Isolates.
+		{"⁧abc⁩ אבג", "גבא ⁩abc⁧", 1},
+		// Overrides.
+		{"‮abc‬", "‮cba‬", 0},
+	}
+	for _, test := range tests {
+		got, para := visual(test.logical)
+		if got != test.visual || para != test.para {
+			t.Errorf("%q: got %q at level %d, expected %q at level %d", test.logical, got, para, test.visual, test.para)
+		}
+	}
+}
+
+func TestReorder(t *testing.T) {
+	order := Reorder([]uint8{0, 0, 1, 1, 2, 2, 1, 0})
+	exp := []int{0, 1, 6, 4, 5, 3, 2, 7}
+	for i := range exp {
+		if order[i] != exp[i] {
+			t.Fatalf("got order %v, expected %v", order, exp)
+		}
+	}
+}
+
+func TestRuns(t *testing.T) {
+	runs := Runs([]uint8{0, 0, 1, 1, 2, 2, 1, 0})
+	exp := []Run{
+		{Start: 0, End: 2, Level: 0},
+		{Start: 6, End: 7, Level: 1},
+		{Start: 4, End: 6, Level: 2},
+		{Start: 2, End: 4, Level: 1},
+		{Start: 7, End: 8, Level: 0},
+	}
+	if len(runs) != len(exp) {
+		t.Fatalf("got runs %v, expected %v", runs, exp)
+	}
+	for i := range exp {
+		if runs[i] != exp[i] {
+			t.Fatalf("got runs %v, expected %v", runs, exp)
+		}
+	}
+}
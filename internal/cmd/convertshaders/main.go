@@ -33,6 +33,41 @@ type shaderArgs struct {
 	Header         string
 }
 
+// gradientHeader declares the stops of gradients with up to 8 stops
+// and the function for evaluating them. Gradient coordinates are
+// mapped to the range [0;1] by the distance from the origin for
+// radial gradients and by the x coordinate for linear gradients.
+// The stop transitions are computed by scaling and biasing the
+// gradient coordinate.
+const gradientHeader = `layout(binding=0) uniform Gradient {
+	vec4 _stopColor0;
+	vec4 _stopColor1;
+	vec4 _stopColor2;
+	vec4 _stopColor3;
+	vec4 _stopColor4;
+	vec4 _stopColor5;
+	vec4 _stopColor6;
+	vec4 _stopColor7;
+	vec4 _stopScale0;
+	vec4 _stopScale1;
+	vec4 _stopBias0;
+	vec4 _stopBias1;
+	float _radial;
+};
+
+vec4 gradient(vec2 uv) {
+	float t = _radial != 0.0 ? length(uv) : uv.x;
+	vec4 c = _stopColor0;
+	c = mix(c, _stopColor1, clamp(t*_stopScale0.x + _stopBias0.x, 0.0, 1.0));
+	c = mix(c, _stopColor2, clamp(t*_stopScale0.y + _stopBias0.y, 0.0, 1.0));
+	c = mix(c, _stopColor3, clamp(t*_stopScale0.z + _stopBias0.z, 0.0, 1.0));
+	c = mix(c, _stopColor4, clamp(t*_stopScale0.w + _stopBias0.w, 0.0, 1.0));
+	c = mix(c, _stopColor5, clamp(t*_stopScale1.x + _stopBias1.x, 0.0, 1.0));
+	c = mix(c, _stopColor6, clamp(t*_stopScale1.y + _stopBias1.y, 0.0, 1.0));
+	c = mix(c, _stopColor7, clamp(t*_stopScale1.z + _stopBias1.z, 0.0, 1.0));
+	return c;
+}`
+
 func main() {
 	flag.Parse()
 	if err := generate(); err != nil {
@@ -70,7 +105,7 @@ func generate() error {
 		if ext := filepath.Ext(shader); ext != ".vert" && ext != ".frag" {
 			continue
 		}
-		const nvariants = 2
+		const nvariants = 3
 		var variants [nvariants]struct {
 			backend.ShaderSources
 			hlslSrc string
@@ -81,8 +116,13 @@ func generate() error {
 				Header:         `layout(binding=0) uniform Color { vec4 _color; };`,
 			},
 			{
-				FetchColorExpr: `texture(tex, vUV)`,
-				Header:         `layout(binding=0) uniform sampler2D tex;`,
+				FetchColorExpr: `texture(tex, vUV)*_opacity`,
+				Header: `layout(binding=0) uniform sampler2D tex;
+layout(binding=0) uniform Opacity { float _opacity; };`,
+			},
+			{
+				FetchColorExpr: `gradient(vUV)`,
+				Header:         gradientHeader,
 			},
 		}
 		for i := range args {
@@ -146,6 +186,7 @@ func generate() error {
 		}
 		for _, src := range variants {
 			fmt.Fprintf(&out, "backend.ShaderSources{\n")
+			fmt.Fprintf(&out, "Name: %#v,\n", filepath.Base(shader))
 			if len(src.Inputs) > 0 {
 				fmt.Fprintf(&out, "Inputs: %#v,\n", src.Inputs)
 			}
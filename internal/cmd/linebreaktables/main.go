@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// This program generates the line breaking class table of package
+// gioui.org/internal/linebreak from LineBreak.txt of the Unicode
+// Character Database.
+
+const unicodeVersion = "14.0.0"
+
+var (
+	input  = flag.String("input", "https://www.unicode.org/Public/"+unicodeVersion+"/ucd/LineBreak.txt", "specify the URL or path of LineBreak.txt")
+	output = flag.String("output", "tables.go", "specify the output file")
+)
+
+// classes are the line breaking classes known to package linebreak.
+var classes = map[string]bool{
+	"AL": true, "B2": true, "BA": true, "BB": true, "BK": true, "CB": true,
+	"CL": true, "CM": true, "CP": true, "CR": true, "EB": true, "EM": true,
+	"EX": true, "GL": true, "H2": true, "H3": true, "HL": true, "HY": true,
+	"ID": true, "IN": true, "IS": true, "JL": true, "JT": true, "JV": true,
+	"LF": true, "NL": true, "NS": true, "NU": true, "OP": true, "PO": true,
+	"PR": true, "QU": true, "RI": true, "SA": true, "SP": true, "SY": true,
+	"WJ": true, "ZW": true, "ZWJ": true,
+}
+
+// resolved maps the classes resolved by rule LB1 to their
+// replacements.
+var resolved = map[string]string{
+	"AI": "AL",
+	"SG": "AL",
+	"XX": "AL",
+	"CJ": "NS",
+}
+
+type classRange struct {
+	lo, hi rune
+	class  string
+}
+
+func main() {
+	flag.Parse()
+	if err := generate(); err != nil {
+		fmt.Fprintf(os.Stderr, "generate: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func generate() error {
+	r, err := open(*input)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	ranges, err := parse(r)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// SPDX-License-Identifier: Unlicense OR MIT\n\n")
+	fmt.Fprintf(&b, "// Code generated by internal/cmd/linebreaktables. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package linebreak\n\n")
+	fmt.Fprintf(&b, "// classRanges are the line breaking classes of LineBreak.txt of the\n")
+	fmt.Fprintf(&b, "// Unicode Character Database, version %s. Runes not in a range\n", unicodeVersion)
+	fmt.Fprintf(&b, "// are class AL. The AI, SG and XX classes are resolved to AL and CJ to\n")
+	fmt.Fprintf(&b, "// NS, as recommended by rule LB1.\n")
+	fmt.Fprintf(&b, "var classRanges = []classRange{\n")
+	for _, r := range ranges {
+		fmt.Fprintf(&b, "\t{0x%04X, 0x%04X, class%s},\n", r.lo, r.hi, r.class)
+	}
+	fmt.Fprintf(&b, "}\n")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(*output, src, 0644)
+}
+
+// open opens the file or URL name.
+func open(name string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(name, "http://") && !strings.HasPrefix(name, "https://") {
+		return os.Open(name)
+	}
+	resp, err := http.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", name, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// parse reads the ranges of LineBreak.txt from r, with the classes
+// resolved and class AL left out. Adjacent ranges of the same class
+// are merged.
+func parse(r io.Reader) ([]classRange, error) {
+	var ranges []classRange
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		l := s.Text()
+		if i := strings.IndexByte(l, '#'); i != -1 {
+			l = l[:i]
+		}
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		fields := strings.Split(l, ";")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: malformed entry %q", line, l)
+		}
+		runes, class := strings.TrimSpace(fields[0]), strings.TrimSpace(fields[1])
+		if c, ok := resolved[class]; ok {
+			class = c
+		}
+		if !classes[class] {
+			return nil, fmt.Errorf("line %d: unknown class %q", line, class)
+		}
+		lo, hi := runes, runes
+		if i := strings.Index(runes, ".."); i != -1 {
+			lo, hi = runes[:i], runes[i+2:]
+		}
+		r := classRange{class: class}
+		var err error
+		if r.lo, err = parseRune(lo); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		if r.hi, err = parseRune(hi); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		if n := len(ranges); n > 0 && r.lo <= ranges[n-1].hi {
+			return nil, fmt.Errorf("line %d: range %q out of order", line, runes)
+		}
+		if class == "AL" {
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1].hi+1 == r.lo && ranges[n-1].class == class {
+			ranges[n-1].hi = r.hi
+			continue
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, s.Err()
+}
+
+func parseRune(s string) (rune, error) {
+	v, err := strconv.ParseUint(s, 16, 32)
+	return rune(v), err
+}
@@ -0,0 +1,5 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package linebreak
+
+//go:generate go run ../cmd/linebreaktables -output tables.go
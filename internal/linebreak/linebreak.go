@@ -0,0 +1,333 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+// Package linebreak implements the line breaking algorithm of Unicode
+// Standard Annex #14, https://unicode.org/reports/tr14/.
+//
+// Breaks computes the break opportunities of a text from the pair
+// rules of the algorithm. The tailorings of rules LB30 and LB30b that
+// depend on the East Asian Width and Extended_Pictographic properties
+// are not implemented.
+package linebreak
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Break is the kind of line break opportunity after a rune.
+type Break uint8
+
+const (
+	// NoBreak prohibits a line break.
+	NoBreak Break = iota
+	// Allowed allows a line break.
+	Allowed
+	// Mandatory requires a line break, such as after a newline.
+	Mandatory
+)
+
+// class is a line breaking class.
+type class uint8
+
+const (
+	classAL class = iota
+	classB2
+	classBA
+	classBB
+	classBK
+	classCB
+	classCL
+	classCM
+	classCP
+	classCR
+	classEB
+	classEM
+	classEX
+	classGL
+	classH2
+	classH3
+	classHL
+	classHY
+	classID
+	classIN
+	classIS
+	classJL
+	classJT
+	classJV
+	classLF
+	classNL
+	classNS
+	classNU
+	classOP
+	classPO
+	classPR
+	classQU
+	classRI
+	classSA
+	classSP
+	classSY
+	classWJ
+	classZW
+	classZWJ
+)
+
+// classRange is a range of runes with the same class.
+type classRange struct {
+	lo, hi rune
+	class  class
+}
+
+// classOf returns the line breaking class of r, resolved according to
+// rule LB1.
+func classOf(r rune) class {
+	i := sort.Search(len(classRanges), func(i int) bool {
+		return classRanges[i].hi >= r
+	})
+	if i == len(classRanges) || classRanges[i].lo > r {
+		return classAL
+	}
+	c := classRanges[i].class
+	if c == classSA {
+		// Complex context dependent runes break like combining
+		// marks or letters.
+		if unicode.In(r, unicode.Mn, unicode.Mc) {
+			return classCM
+		}
+		return classAL
+	}
+	return c
+}
+
+// Breaks returns the line break opportunities of runes. Element i is
+// the break opportunity after rune i; a break is mandatory after the
+// final rune.
+func Breaks(runes []rune) []Break {
+	brks := make([]Break, len(runes))
+	if len(runes) == 0 {
+		return brks
+	}
+	var s state
+	s.init(classOf(runes[0]))
+	for i := 1; i < len(runes); i++ {
+		c := classOf(runes[i])
+		brks[i-1] = s.pair(c)
+		s.advance(c)
+	}
+	brks[len(brks)-1] = Mandatory
+	return brks
+}
+
+// state tracks the context of the pair rules.
+type state struct {
+	// prev is the class of the preceding rune.
+	prev class
+	// base is the class of the preceding rune, after combining
+	// marks are attached to their base by rule LB9.
+	base class
+	// beforeBase is the class of the rune before base.
+	beforeBase class
+	// beforeSpace is the class of the last rune before a run of
+	// spaces ending at base, or base.
+	beforeSpace class
+	// ri is the number of consecutive regional indicators ending at
+	// base.
+	ri int
+}
+
+func (s *state) init(c class) {
+	s.prev = c
+	// Rule LB10: combining marks without a base are letters.
+	if c == classCM || c == classZWJ {
+		c = classAL
+	}
+	s.base, s.beforeBase, s.beforeSpace = c, c, c
+	if c == classRI {
+		s.ri = 1
+	}
+}
+
+// advance moves the state past a rune of class c.
+func (s *state) advance(c class) {
+	s.prev = c
+	attach := false
+	if c == classCM || c == classZWJ {
+		// Rule LB9 attaches combining marks to their base, and rule
+		// LB10 treats the rest as letters.
+		switch s.base {
+		case classBK, classCR, classLF, classNL, classSP, classZW:
+			c = classAL
+		default:
+			attach = true
+		}
+	}
+	if attach {
+		return
+	}
+	if c == classRI && s.base == classRI {
+		s.ri++
+	} else if c == classRI {
+		s.ri = 1
+	} else {
+		s.ri = 0
+	}
+	s.beforeBase, s.base = s.base, c
+	if c != classSP {
+		s.beforeSpace = c
+	}
+}
+
+// pair returns the break opportunity before a rune of class c.
+func (s *state) pair(c class) Break {
+	a := s.base
+	switch {
+	// LB4, LB5: break after hard line breaks.
+	case a == classCR && c == classLF:
+		return NoBreak
+	case a == classBK || a == classCR || a == classLF || a == classNL:
+		return Mandatory
+	// LB6: don't break before hard line breaks.
+	case c == classBK || c == classCR || c == classLF || c == classNL:
+		return NoBreak
+	// LB7: don't break before spaces or zero width space.
+	case c == classSP || c == classZW:
+		return NoBreak
+	// LB8: break after zero width space and following spaces.
+	case s.beforeSpace == classZW:
+		return Allowed
+	// LB8a: don't break after zero width joiners.
+	case s.prev == classZWJ:
+		return NoBreak
+	}
+	if c == classCM || c == classZWJ {
+		// LB9: don't break before combining marks.
+		if a != classSP {
+			return NoBreak
+		}
+		// LB10: treat the remaining marks as letters.
+		c = classAL
+	}
+	switch {
+	// LB11: don't break around word joiners.
+	case a == classWJ || c == classWJ:
+		return NoBreak
+	// LB12, LB12a: don't break around non-breaking glue, except
+	// after spaces and hyphens.
+	case a == classGL:
+		return NoBreak
+	case c == classGL && a != classSP && a != classBA && a != classHY:
+		return NoBreak
+	// LB13: don't break before closing punctuation.
+	case c == classCL || c == classCP || c == classEX || c == classIS || c == classSY:
+		return NoBreak
+	// LB14-LB17: don't break after opening punctuation and before
+	// some punctuation, even after spaces.
+	case s.beforeSpace == classOP:
+		return NoBreak
+	case s.beforeSpace == classQU && c == classOP:
+		return NoBreak
+	case (s.beforeSpace == classCL || s.beforeSpace == classCP) && c == classNS:
+		return NoBreak
+	case s.beforeSpace == classB2 && c == classB2:
+		return NoBreak
+	// LB18: break after spaces.
+	case a == classSP:
+		return Allowed
+	// LB19: don't break around quotation marks.
+	case a == classQU || c == classQU:
+		return NoBreak
+	// LB20: break around contingent breaks.
+	case a == classCB || c == classCB:
+		return Allowed
+	// LB21: don't break before hyphens and small kana, or after
+	// characters like acute accents.
+	case c == classBA || c == classHY || c == classNS || a == classBB:
+		return NoBreak
+	// LB21a: don't break after Hebrew and a hyphen.
+	case s.beforeBase == classHL && (a == classHY || a == classBA):
+		return NoBreak
+	// LB21b: don't break between solidus and Hebrew letters.
+	case a == classSY && c == classHL:
+		return NoBreak
+	// LB22: don't break before ellipses.
+	case c == classIN:
+		return NoBreak
+	// LB23: don't break between digits and letters.
+	case isAlpha(a) && c == classNU, a == classNU && isAlpha(c):
+		return NoBreak
+	// LB23a: don't break between numeric prefixes and ideographs,
+	// or ideographs and numeric postfixes.
+	case a == classPR && (c == classID || c == classEB || c == classEM):
+		return NoBreak
+	case (a == classID || a == classEB || a == classEM) && c == classPO:
+		return NoBreak
+	// LB24: don't break between numeric affixes and letters.
+	case (a == classPR || a == classPO) && isAlpha(c):
+		return NoBreak
+	case isAlpha(a) && (c == classPR || c == classPO):
+		return NoBreak
+	// LB25: don't break inside numbers.
+	case numeric(a, c):
+		return NoBreak
+	// LB26, LB27: don't break Korean syllables.
+	case a == classJL && (c == classJL || c == classJV || c == classH2 || c == classH3):
+		return NoBreak
+	case (a == classJV || a == classH2) && (c == classJV || c == classJT):
+		return NoBreak
+	case (a == classJT || a == classH3) && c == classJT:
+		return NoBreak
+	case isHangul(a) && c == classPO, a == classPR && isHangul(c):
+		return NoBreak
+	// LB28: don't break between letters.
+	case isAlpha(a) && isAlpha(c):
+		return NoBreak
+	// LB29: don't break between numeric punctuation and letters.
+	case a == classIS && isAlpha(c):
+		return NoBreak
+	// LB30: don't break between letters or numbers and
+	// parentheses.
+	case (isAlpha(a) || a == classNU) && c == classOP:
+		return NoBreak
+	case a == classCP && (isAlpha(c) || c == classNU):
+		return NoBreak
+	// LB30a: break between pairs of regional indicators.
+	case a == classRI && c == classRI:
+		if s.ri%2 == 1 {
+			return NoBreak
+		}
+		return Allowed
+	// LB30b: don't break between emoji bases and modifiers.
+	case a == classEB && c == classEM:
+		return NoBreak
+	}
+	// LB31: break everywhere else.
+	return Allowed
+}
+
+// numeric reports whether rule LB25 prohibits a break between classes
+// a and b.
+func numeric(a, b class) bool {
+	switch b {
+	case classPO, classPR:
+		return a == classCL || a == classCP || a == classNU
+	case classOP:
+		return a == classPO || a == classPR
+	case classNU:
+		switch a {
+		case classPO, classPR, classHY, classIS, classNU, classSY:
+			return true
+		}
+	}
+	return false
+}
+
+func isAlpha(c class) bool {
+	return c == classAL || c == classHL
+}
+
+func isHangul(c class) bool {
+	switch c {
+	case classJL, classJV, classJT, classH2, classH3:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package linebreak
+
+import (
+	"strings"
+	"testing"
+)
+
+// segments splits s at its break opportunities, and marks mandatory
+// breaks with a trailing '!'.
+func segments(s string) []string {
+	runes := []rune(s)
+	var segs []string
+	start := 0
+	for i, b := range Breaks(runes) {
+		if b == NoBreak {
+			continue
+		}
+		seg := string(runes[start : i+1])
+		if b == Mandatory && i < len(runes)-1 {
+			seg += "!"
+		}
+		segs = append(segs, seg)
+		start = i + 1
+	}
+	return segs
+}
+
+func TestBreaks(t *testing.T) {
+	tests := []struct {
+		text string
+		segs []string
+	}{
+		{"", nil},
+		{"hello world", []string{"hello ", "world"}},
+		// Spaces stay at the end of lines.
+		{"a   b", []string{"a   ", "b"}},
+		{"a\nb", []string{"a\n!", "b"}},
+		{"a\r\nb", []string{"a\r\n!", "b"}},
+		// Ideographs break between characters, but not before
+		// closing punctuation and small kana.
+		{"日本語。", []string{"日", "本", "語。"}},
+		{"キャット", []string{"キャッ", "ト"}},
+		{"(日本)", []string{"(日", "本)"}},
+		// URLs break after slashes.
+		{"http://example.com/path", []string{"http://", "example.com/", "path"}},
+		{"well-known", []string{"well-", "known"}},
+		{"$12.50, 100%", []string{"$12.50, ", "100%"}},
+		{"\"quoted\" text!", []string{"\"quoted\" ", "text!"}},
+		// No-break spaces glue words together.
+		{"a\u00a0b c", []string{"a\u00a0b ", "c"}},
+		{"a\u200bb", []string{"a\u200b", "b"}},
+		// Combining marks stay with their base.
+		{"e\u0301 x", []string{"e\u0301 ", "x"}},
+		// Regional indicators pair into flags.
+		{"\U0001F1E9\U0001F1EA\U0001F1EB\U0001F1F7", []string{"\U0001F1E9\U0001F1EA", "\U0001F1EB\U0001F1F7"}},
+	}
+	for _, test := range tests {
+		segs := segments(test.text)
+		if strings.Join(segs, "|") != strings.Join(test.segs, "|") || len(segs) != len(test.segs) {
+			t.Errorf("%q: got segments %q, expected %q", test.text, segs, test.segs)
+		}
+	}
+}
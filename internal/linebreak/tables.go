@@ -0,0 +1,1891 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+// Code generated by internal/cmd/linebreaktables. DO NOT EDIT.
+
+package linebreak
+
+// classRanges are the line breaking classes of LineBreak.txt of the
+// Unicode Character Database, version 14.0.0. Runes not in a range
+// are class AL. The AI, SG and XX classes are resolved to AL and CJ to
+// NS, as recommended by rule LB1.
+var classRanges = []classRange{
+	{0x0000, 0x0008, classCM},
+	{0x0009, 0x0009, classBA},
+	{0x000A, 0x000A, classLF},
+	{0x000B, 0x000C, classBK},
+	{0x000D, 0x000D, classCR},
+	{0x000E, 0x001F, classCM},
+	{0x0020, 0x0020, classSP},
+	{0x0021, 0x0021, classEX},
+	{0x0022, 0x0022, classQU},
+	{0x0024, 0x0024, classPR},
+	{0x0025, 0x0025, classPO},
+	{0x0027, 0x0027, classQU},
+	{0x0028, 0x0028, classOP},
+	{0x0029, 0x0029, classCP},
+	{0x002B, 0x002B, classPR},
+	{0x002C, 0x002C, classIS},
+	{0x002D, 0x002D, classHY},
+	{0x002E, 0x002E, classIS},
+	{0x002F, 0x002F, classSY},
+	{0x0030, 0x0039, classNU},
+	{0x003A, 0x003B, classIS},
+	{0x003F, 0x003F, classEX},
+	{0x005B, 0x005B, classOP},
+	{0x005C, 0x005C, classPR},
+	{0x005D, 0x005D, classCP},
+	{0x007B, 0x007B, classOP},
+	{0x007C, 0x007C, classBA},
+	{0x007D, 0x007D, classCL},
+	{0x007F, 0x0084, classCM},
+	{0x0085, 0x0085, classNL},
+	{0x0086, 0x009F, classCM},
+	{0x00A0, 0x00A0, classGL},
+	{0x00A1, 0x00A1, classOP},
+	{0x00A2, 0x00A2, classPO},
+	{0x00A3, 0x00A5, classPR},
+	{0x00AB, 0x00AB, classQU},
+	{0x00AD, 0x00AD, classBA},
+	{0x00B0, 0x00B0, classPO},
+	{0x00B1, 0x00B1, classPR},
+	{0x00B4, 0x00B4, classBB},
+	{0x00BB, 0x00BB, classQU},
+	{0x00BF, 0x00BF, classOP},
+	{0x02C8, 0x02C8, classBB},
+	{0x02CC, 0x02CC, classBB},
+	{0x02DF, 0x02DF, classBB},
+	{0x0300, 0x034E, classCM},
+	{0x034F, 0x034F, classGL},
+	{0x0350, 0x035B, classCM},
+	{0x035C, 0x0362, classGL},
+	{0x0363, 0x036F, classCM},
+	{0x037E, 0x037E, classIS},
+	{0x0483, 0x0489, classCM},
+	{0x0589, 0x0589, classIS},
+	{0x058A, 0x058A, classBA},
+	{0x058F, 0x058F, classPR},
+	{0x0591, 0x05BD, classCM},
+	{0x05BE, 0x05BE, classBA},
+	{0x05BF, 0x05BF, classCM},
+	{0x05C1, 0x05C2, classCM},
+	{0x05C4, 0x05C5, classCM},
+	{0x05C6, 0x05C6, classEX},
+	{0x05C7, 0x05C7, classCM},
+	{0x05D0, 0x05EA, classHL},
+	{0x05EF, 0x05F2, classHL},
+	{0x0609, 0x060B, classPO},
+	{0x060C, 0x060D, classIS},
+	{0x0610, 0x061A, classCM},
+	{0x061B, 0x061B, classEX},
+	{0x061C, 0x061C, classCM},
+	{0x061D, 0x061F, classEX},
+	{0x064B, 0x065F, classCM},
+	{0x0660, 0x0669, classNU},
+	{0x066A, 0x066A, classPO},
+	{0x066B, 0x066C, classNU},
+	{0x0670, 0x0670, classCM},
+	{0x06D4, 0x06D4, classEX},
+	{0x06D6, 0x06DC, classCM},
+	{0x06DF, 0x06E4, classCM},
+	{0x06E7, 0x06E8, classCM},
+	{0x06EA, 0x06ED, classCM},
+	{0x06F0, 0x06F9, classNU},
+	{0x0711, 0x0711, classCM},
+	{0x0730, 0x074A, classCM},
+	{0x07A6, 0x07B0, classCM},
+	{0x07C0, 0x07C9, classNU},
+	{0x07EB, 0x07F3, classCM},
+	{0x07F8, 0x07F8, classIS},
+	{0x07F9, 0x07F9, classEX},
+	{0x07FD, 0x07FD, classCM},
+	{0x07FE, 0x07FF, classPR},
+	{0x0816, 0x0819, classCM},
+	{0x081B, 0x0823, classCM},
+	{0x0825, 0x0827, classCM},
+	{0x0829, 0x082D, classCM},
+	{0x0859, 0x085B, classCM},
+	{0x0898, 0x089F, classCM},
+	{0x08CA, 0x08E1, classCM},
+	{0x08E3, 0x0903, classCM},
+	{0x093A, 0x093C, classCM},
+	{0x093E, 0x094F, classCM},
+	{0x0951, 0x0957, classCM},
+	{0x0962, 0x0963, classCM},
+	{0x0964, 0x0965, classBA},
+	{0x0966, 0x096F, classNU},
+	{0x0981, 0x0983, classCM},
+	{0x09BC, 0x09BC, classCM},
+	{0x09BE, 0x09C4, classCM},
+	{0x09C7, 0x09C8, classCM},
+	{0x09CB, 0x09CD, classCM},
+	{0x09D7, 0x09D7, classCM},
+	{0x09E2, 0x09E3, classCM},
+	{0x09E6, 0x09EF, classNU},
+	{0x09F2, 0x09F3, classPO},
+	{0x09F9, 0x09F9, classPO},
+	{0x09FB, 0x09FB, classPR},
+	{0x09FE, 0x09FE, classCM},
+	{0x0A01, 0x0A03, classCM},
+	{0x0A3C, 0x0A3C, classCM},
+	{0x0A3E, 0x0A42, classCM},
+	{0x0A47, 0x0A48, classCM},
+	{0x0A4B, 0x0A4D, classCM},
+	{0x0A51, 0x0A51, classCM},
+	{0x0A66, 0x0A6F, classNU},
+	{0x0A70, 0x0A71, classCM},
+	{0x0A75, 0x0A75, classCM},
+	{0x0A81, 0x0A83, classCM},
+	{0x0ABC, 0x0ABC, classCM},
+	{0x0ABE, 0x0AC5, classCM},
+	{0x0AC7, 0x0AC9, classCM},
+	{0x0ACB, 0x0ACD, classCM},
+	{0x0AE2, 0x0AE3, classCM},
+	{0x0AE6, 0x0AEF, classNU},
+	{0x0AF1, 0x0AF1, classPR},
+	{0x0AFA, 0x0AFF, classCM},
+	{0x0B01, 0x0B03, classCM},
+	{0x0B3C, 0x0B3C, classCM},
+	{0x0B3E, 0x0B44, classCM},
+	{0x0B47, 0x0B48, classCM},
+	{0x0B4B, 0x0B4D, classCM},
+	{0x0B55, 0x0B57, classCM},
+	{0x0B62, 0x0B63, classCM},
+	{0x0B66, 0x0B6F, classNU},
+	{0x0B82, 0x0B82, classCM},
+	{0x0BBE, 0x0BC2, classCM},
+	{0x0BC6, 0x0BC8, classCM},
+	{0x0BCA, 0x0BCD, classCM},
+	{0x0BD7, 0x0BD7, classCM},
+	{0x0BE6, 0x0BEF, classNU},
+	{0x0BF9, 0x0BF9, classPR},
+	{0x0C00, 0x0C04, classCM},
+	{0x0C3C, 0x0C3C, classCM},
+	{0x0C3E, 0x0C44, classCM},
+	{0x0C46, 0x0C48, classCM},
+	{0x0C4A, 0x0C4D, classCM},
+	{0x0C55, 0x0C56, classCM},
+	{0x0C62, 0x0C63, classCM},
+	{0x0C66, 0x0C6F, classNU},
+	{0x0C77, 0x0C77, classBB},
+	{0x0C81, 0x0C83, classCM},
+	{0x0C84, 0x0C84, classBB},
+	{0x0CBC, 0x0CBC, classCM},
+	{0x0CBE, 0x0CC4, classCM},
+	{0x0CC6, 0x0CC8, classCM},
+	{0x0CCA, 0x0CCD, classCM},
+	{0x0CD5, 0x0CD6, classCM},
+	{0x0CE2, 0x0CE3, classCM},
+	{0x0CE6, 0x0CEF, classNU},
+	{0x0D00, 0x0D03, classCM},
+	{0x0D3B, 0x0D3C, classCM},
+	{0x0D3E, 0x0D44, classCM},
+	{0x0D46, 0x0D48, classCM},
+	{0x0D4A, 0x0D4D, classCM},
+	{0x0D57, 0x0D57, classCM},
+	{0x0D62, 0x0D63, classCM},
+	{0x0D66, 0x0D6F, classNU},
+	{0x0D79, 0x0D79, classPO},
+	{0x0D81, 0x0D83, classCM},
+	{0x0DCA, 0x0DCA, classCM},
+	{0x0DCF, 0x0DD4, classCM},
+	{0x0DD6, 0x0DD6, classCM},
+	{0x0DD8, 0x0DDF, classCM},
+	{0x0DE6, 0x0DEF, classNU},
+	{0x0DF2, 0x0DF3, classCM},
+	{0x0E01, 0x0E3A, classSA},
+	{0x0E3F, 0x0E3F, classPR},
+	{0x0E40, 0x0E4E, classSA},
+	{0x0E50, 0x0E59, classNU},
+	{0x0E5A, 0x0E5B, classBA},
+	{0x0E81, 0x0E82, classSA},
+	{0x0E84, 0x0E84, classSA},
+	{0x0E86, 0x0E8A, classSA},
+	{0x0E8C, 0x0EA3, classSA},
+	{0x0EA5, 0x0EA5, classSA},
+	{0x0EA7, 0x0EBD, classSA},
+	{0x0EC0, 0x0EC4, classSA},
+	{0x0EC6, 0x0EC6, classSA},
+	{0x0EC8, 0x0ECD, classSA},
+	{0x0ED0, 0x0ED9, classNU},
+	{0x0EDC, 0x0EDF, classSA},
+	{0x0F01, 0x0F04, classBB},
+	{0x0F06, 0x0F07, classBB},
+	{0x0F08, 0x0F08, classGL},
+	{0x0F09, 0x0F0A, classBB},
+	{0x0F0B, 0x0F0B, classBA},
+	{0x0F0C, 0x0F0C, classGL},
+	{0x0F0D, 0x0F11, classEX},
+	{0x0F12, 0x0F12, classGL},
+	{0x0F14, 0x0F14, classEX},
+	{0x0F18, 0x0F19, classCM},
+	{0x0F20, 0x0F29, classNU},
+	{0x0F34, 0x0F34, classBA},
+	{0x0F35, 0x0F35, classCM},
+	{0x0F37, 0x0F37, classCM},
+	{0x0F39, 0x0F39, classCM},
+	{0x0F3A, 0x0F3A, classOP},
+	{0x0F3B, 0x0F3B, classCL},
+	{0x0F3C, 0x0F3C, classOP},
+	{0x0F3D, 0x0F3D, classCL},
+	{0x0F3E, 0x0F3F, classCM},
+	{0x0F71, 0x0F7E, classCM},
+	{0x0F7F, 0x0F7F, classBA},
+	{0x0F80, 0x0F84, classCM},
+	{0x0F85, 0x0F85, classBA},
+	{0x0F86, 0x0F87, classCM},
+	{0x0F8D, 0x0F97, classCM},
+	{0x0F99, 0x0FBC, classCM},
+	{0x0FBE, 0x0FBF, classBA},
+	{0x0FC6, 0x0FC6, classCM},
+	{0x0FD0, 0x0FD1, classBB},
+	{0x0FD2, 0x0FD2, classBA},
+	{0x0FD3, 0x0FD3, classBB},
+	{0x0FD9, 0x0FDA, classGL},
+	{0x1000, 0x103F, classSA},
+	{0x1040, 0x1049, classNU},
+	{0x104A, 0x104B, classBA},
+	{0x1050, 0x108F, classSA},
+	{0x1090, 0x1099, classNU},
+	{0x109A, 0x109F, classSA},
+	{0x1100, 0x115F, classJL},
+	{0x1160, 0x11A7, classJV},
+	{0x11A8, 0x11FF, classJT},
+	{0x135D, 0x135F, classCM},
+	{0x1361, 0x1361, classBA},
+	{0x1400, 0x1400, classBA},
+	{0x1680, 0x1680, classBA},
+	{0x169B, 0x169B, classOP},
+	{0x169C, 0x169C, classCL},
+	{0x16EB, 0x16ED, classBA},
+	{0x1712, 0x1715, classCM},
+	{0x1732, 0x1734, classCM},
+	{0x1735, 0x1736, classBA},
+	{0x1752, 0x1753, classCM},
+	{0x1772, 0x1773, classCM},
+	{0x1780, 0x17D3, classSA},
+	{0x17D4, 0x17D5, classBA},
+	{0x17D6, 0x17D6, classNS},
+	{0x17D7, 0x17D7, classSA},
+	{0x17D8, 0x17D8, classBA},
+	{0x17DA, 0x17DA, classBA},
+	{0x17DB, 0x17DB, classPR},
+	{0x17DC, 0x17DD, classSA},
+	{0x17E0, 0x17E9, classNU},
+	{0x1802, 0x1803, classEX},
+	{0x1804, 0x1805, classBA},
+	{0x1806, 0x1806, classBB},
+	{0x1808, 0x1809, classEX},
+	{0x180B, 0x180D, classCM},
+	{0x180E, 0x180E, classGL},
+	{0x180F, 0x180F, classCM},
+	{0x1810, 0x1819, classNU},
+	{0x1885, 0x1886, classCM},
+	{0x18A9, 0x18A9, classCM},
+	{0x1920, 0x192B, classCM},
+	{0x1930, 0x193B, classCM},
+	{0x1944, 0x1945, classEX},
+	{0x1946, 0x194F, classNU},
+	{0x1950, 0x196D, classSA},
+	{0x1970, 0x1974, classSA},
+	{0x1980, 0x19AB, classSA},
+	{0x19B0, 0x19C9, classSA},
+	{0x19D0, 0x19D9, classNU},
+	{0x19DA, 0x19DA, classSA},
+	{0x19DE, 0x19DF, classSA},
+	{0x1A17, 0x1A1B, classCM},
+	{0x1A20, 0x1A5E, classSA},
+	{0x1A60, 0x1A7C, classSA},
+	{0x1A7F, 0x1A7F, classCM},
+	{0x1A80, 0x1A89, classNU},
+	{0x1A90, 0x1A99, classNU},
+	{0x1AA0, 0x1AAD, classSA},
+	{0x1AB0, 0x1ACE, classCM},
+	{0x1B00, 0x1B04, classCM},
+	{0x1B34, 0x1B44, classCM},
+	{0x1B50, 0x1B59, classNU},
+	{0x1B5A, 0x1B5B, classBA},
+	{0x1B5D, 0x1B60, classBA},
+	{0x1B6B, 0x1B73, classCM},
+	{0x1B7D, 0x1B7E, classBA},
+	{0x1B80, 0x1B82, classCM},
+	{0x1BA1, 0x1BAD, classCM},
+	{0x1BB0, 0x1BB9, classNU},
+	{0x1BE6, 0x1BF3, classCM},
+	{0x1C24, 0x1C37, classCM},
+	{0x1C3B, 0x1C3F, classBA},
+	{0x1C40, 0x1C49, classNU},
+	{0x1C50, 0x1C59, classNU},
+	{0x1C7E, 0x1C7F, classBA},
+	{0x1CD0, 0x1CD2, classCM},
+	{0x1CD4, 0x1CE8, classCM},
+	{0x1CED, 0x1CED, classCM},
+	{0x1CF4, 0x1CF4, classCM},
+	{0x1CF7, 0x1CF9, classCM},
+	{0x1DC0, 0x1DFF, classCM},
+	{0x1FFD, 0x1FFD, classBB},
+	{0x2000, 0x2006, classBA},
+	{0x2007, 0x2007, classGL},
+	{0x2008, 0x200A, classBA},
+	{0x200B, 0x200B, classZW},
+	{0x200C, 0x200C, classCM},
+	{0x200D, 0x200D, classZWJ},
+	{0x200E, 0x200F, classCM},
+	{0x2010, 0x2010, classBA},
+	{0x2011, 0x2011, classGL},
+	{0x2012, 0x2013, classBA},
+	{0x2014, 0x2014, classB2},
+	{0x2018, 0x2019, classQU},
+	{0x201A, 0x201A, classOP},
+	{0x201B, 0x201D, classQU},
+	{0x201E, 0x201E, classOP},
+	{0x201F, 0x201F, classQU},
+	{0x2024, 0x2026, classIN},
+	{0x2027, 0x2027, classBA},
+	{0x2028, 0x2029, classBK},
+	{0x202A, 0x202E, classCM},
+	{0x202F, 0x202F, classGL},
+	{0x2030, 0x2037, classPO},
+	{0x2039, 0x203A, classQU},
+	{0x203C, 0x203D, classNS},
+	{0x2044, 0x2044, classIS},
+	{0x2045, 0x2045, classOP},
+	{0x2046, 0x2046, classCL},
+	{0x2047, 0x2049, classNS},
+	{0x2056, 0x2056, classBA},
+	{0x2058, 0x205B, classBA},
+	{0x205D, 0x205F, classBA},
+	{0x2060, 0x2060, classWJ},
+	{0x2066, 0x206F, classCM},
+	{0x207D, 0x207D, classOP},
+	{0x207E, 0x207E, classCL},
+	{0x208D, 0x208D, classOP},
+	{0x208E, 0x208E, classCL},
+	{0x20A0, 0x20A6, classPR},
+	{0x20A7, 0x20A7, classPO},
+	{0x20A8, 0x20B5, classPR},
+	{0x20B6, 0x20B6, classPO},
+	{0x20B7, 0x20BA, classPR},
+	{0x20BB, 0x20BB, classPO},
+	{0x20BC, 0x20BD, classPR},
+	{0x20BE, 0x20BE, classPO},
+	{0x20BF, 0x20BF, classPR},
+	{0x20C0, 0x20C0, classPO},
+	{0x20C1, 0x20CF, classPR},
+	{0x20D0, 0x20F0, classCM},
+	{0x2103, 0x2103, classPO},
+	{0x2109, 0x2109, classPO},
+	{0x2116, 0x2116, classPR},
+	{0x2212, 0x2213, classPR},
+	{0x22EF, 0x22EF, classIN},
+	{0x2308, 0x2308, classOP},
+	{0x2309, 0x2309, classCL},
+	{0x230A, 0x230A, classOP},
+	{0x230B, 0x230B, classCL},
+	{0x231A, 0x231B, classID},
+	{0x2329, 0x2329, classOP},
+	{0x232A, 0x232A, classCL},
+	{0x23F0, 0x23F3, classID},
+	{0x2600, 0x2603, classID},
+	{0x2614, 0x2615, classID},
+	{0x2618, 0x2618, classID},
+	{0x261A, 0x261C, classID},
+	{0x261D, 0x261D, classEB},
+	{0x261E, 0x261F, classID},
+	{0x2639, 0x263B, classID},
+	{0x2668, 0x2668, classID},
+	{0x267F, 0x267F, classID},
+	{0x26BD, 0x26C8, classID},
+	{0x26CD, 0x26CD, classID},
+	{0x26CF, 0x26D1, classID},
+	{0x26D3, 0x26D4, classID},
+	{0x26D8, 0x26D9, classID},
+	{0x26DC, 0x26DC, classID},
+	{0x26DF, 0x26E1, classID},
+	{0x26EA, 0x26EA, classID},
+	{0x26F1, 0x26F5, classID},
+	{0x26F7, 0x26F8, classID},
+	{0x26F9, 0x26F9, classEB},
+	{0x26FA, 0x26FA, classID},
+	{0x26FD, 0x2704, classID},
+	{0x2708, 0x2709, classID},
+	{0x270A, 0x270D, classEB},
+	{0x275B, 0x2760, classQU},
+	{0x2762, 0x2763, classEX},
+	{0x2764, 0x2764, classID},
+	{0x2768, 0x2768, classOP},
+	{0x2769, 0x2769, classCL},
+	{0x276A, 0x276A, classOP},
+	{0x276B, 0x276B, classCL},
+	{0x276C, 0x276C, classOP},
+	{0x276D, 0x276D, classCL},
+	{0x276E, 0x276E, classOP},
+	{0x276F, 0x276F, classCL},
+	{0x2770, 0x2770, classOP},
+	{0x2771, 0x2771, classCL},
+	{0x2772, 0x2772, classOP},
+	{0x2773, 0x2773, classCL},
+	{0x2774, 0x2774, classOP},
+	{0x2775, 0x2775, classCL},
+	{0x27C5, 0x27C5, classOP},
+	{0x27C6, 0x27C6, classCL},
+	{0x27E6, 0x27E6, classOP},
+	{0x27E7, 0x27E7, classCL},
+	{0x27E8, 0x27E8, classOP},
+	{0x27E9, 0x27E9, classCL},
+	{0x27EA, 0x27EA, classOP},
+	{0x27EB, 0x27EB, classCL},
+	{0x27EC, 0x27EC, classOP},
+	{0x27ED, 0x27ED, classCL},
+	{0x27EE, 0x27EE, classOP},
+	{0x27EF, 0x27EF, classCL},
+	{0x2983, 0x2983, classOP},
+	{0x2984, 0x2984, classCL},
+	{0x2985, 0x2985, classOP},
+	{0x2986, 0x2986, classCL},
+	{0x2987, 0x2987, classOP},
+	{0x2988, 0x2988, classCL},
+	{0x2989, 0x2989, classOP},
+	{0x298A, 0x298A, classCL},
+	{0x298B, 0x298B, classOP},
+	{0x298C, 0x298C, classCL},
+	{0x298D, 0x298D, classOP},
+	{0x298E, 0x298E, classCL},
+	{0x298F, 0x298F, classOP},
+	{0x2990, 0x2990, classCL},
+	{0x2991, 0x2991, classOP},
+	{0x2992, 0x2992, classCL},
+	{0x2993, 0x2993, classOP},
+	{0x2994, 0x2994, classCL},
+	{0x2995, 0x2995, classOP},
+	{0x2996, 0x2996, classCL},
+	{0x2997, 0x2997, classOP},
+	{0x2998, 0x2998, classCL},
+	{0x29D8, 0x29D8, classOP},
+	{0x29D9, 0x29D9, classCL},
+	{0x29DA, 0x29DA, classOP},
+	{0x29DB, 0x29DB, classCL},
+	{0x29FC, 0x29FC, classOP},
+	{0x29FD, 0x29FD, classCL},
+	{0x2CEF, 0x2CF1, classCM},
+	{0x2CF9, 0x2CF9, classEX},
+	{0x2CFA, 0x2CFC, classBA},
+	{0x2CFE, 0x2CFE, classEX},
+	{0x2CFF, 0x2CFF, classBA},
+	{0x2D70, 0x2D70, classBA},
+	{0x2D7F, 0x2D7F, classCM},
+	{0x2DE0, 0x2DFF, classCM},
+	{0x2E00, 0x2E0D, classQU},
+	{0x2E0E, 0x2E15, classBA},
+	{0x2E17, 0x2E17, classBA},
+	{0x2E18, 0x2E18, classOP},
+	{0x2E19, 0x2E19, classBA},
+	{0x2E1C, 0x2E1D, classQU},
+	{0x2E20, 0x2E21, classQU},
+	{0x2E22, 0x2E22, classOP},
+	{0x2E23, 0x2E23, classCL},
+	{0x2E24, 0x2E24, classOP},
+	{0x2E25, 0x2E25, classCL},
+	{0x2E26, 0x2E26, classOP},
+	{0x2E27, 0x2E27, classCL},
+	{0x2E28, 0x2E28, classOP},
+	{0x2E29, 0x2E29, classCL},
+	{0x2E2A, 0x2E2D, classBA},
+	{0x2E2E, 0x2E2E, classEX},
+	{0x2E30, 0x2E31, classBA},
+	{0x2E33, 0x2E34, classBA},
+	{0x2E3A, 0x2E3B, classB2},
+	{0x2E3C, 0x2E3E, classBA},
+	{0x2E40, 0x2E41, classBA},
+	{0x2E42, 0x2E42, classOP},
+	{0x2E43, 0x2E4A, classBA},
+	{0x2E4C, 0x2E4C, classBA},
+	{0x2E4E, 0x2E4F, classBA},
+	{0x2E53, 0x2E54, classEX},
+	{0x2E55, 0x2E55, classOP},
+	{0x2E56, 0x2E56, classCL},
+	{0x2E57, 0x2E57, classOP},
+	{0x2E58, 0x2E58, classCL},
+	{0x2E59, 0x2E59, classOP},
+	{0x2E5A, 0x2E5A, classCL},
+	{0x2E5B, 0x2E5B, classOP},
+	{0x2E5C, 0x2E5C, classCL},
+	{0x2E5D, 0x2E5D, classBA},
+	{0x2E80, 0x2E99, classID},
+	{0x2E9B, 0x2EF3, classID},
+	{0x2F00, 0x2FD5, classID},
+	{0x2FF0, 0x2FFB, classID},
+	{0x3000, 0x3000, classBA},
+	{0x3001, 0x3002, classCL},
+	{0x3003, 0x3004, classID},
+	{0x3005, 0x3005, classNS},
+	{0x3006, 0x3007, classID},
+	{0x3008, 0x3008, classOP},
+	{0x3009, 0x3009, classCL},
+	{0x300A, 0x300A, classOP},
+	{0x300B, 0x300B, classCL},
+	{0x300C, 0x300C, classOP},
+	{0x300D, 0x300D, classCL},
+	{0x300E, 0x300E, classOP},
+	{0x300F, 0x300F, classCL},
+	{0x3010, 0x3010, classOP},
+	{0x3011, 0x3011, classCL},
+	{0x3012, 0x3013, classID},
+	{0x3014, 0x3014, classOP},
+	{0x3015, 0x3015, classCL},
+	{0x3016, 0x3016, classOP},
+	{0x3017, 0x3017, classCL},
+	{0x3018, 0x3018, classOP},
+	{0x3019, 0x3019, classCL},
+	{0x301A, 0x301A, classOP},
+	{0x301B, 0x301B, classCL},
+	{0x301C, 0x301C, classNS},
+	{0x301D, 0x301D, classOP},
+	{0x301E, 0x301F, classCL},
+	{0x3020, 0x3029, classID},
+	{0x302A, 0x302F, classCM},
+	{0x3030, 0x3034, classID},
+	{0x3035, 0x3035, classCM},
+	{0x3036, 0x303A, classID},
+	{0x303B, 0x303C, classNS},
+	{0x303D, 0x303F, classID},
+	{0x3041, 0x3041, classNS},
+	{0x3042, 0x3042, classID},
+	{0x3043, 0x3043, classNS},
+	{0x3044, 0x3044, classID},
+	{0x3045, 0x3045, classNS},
+	{0x3046, 0x3046, classID},
+	{0x3047, 0x3047, classNS},
+	{0x3048, 0x3048, classID},
+	{0x3049, 0x3049, classNS},
+	{0x304A, 0x3062, classID},
+	{0x3063, 0x3063, classNS},
+	{0x3064, 0x3082, classID},
+	{0x3083, 0x3083, classNS},
+	{0x3084, 0x3084, classID},
+	{0x3085, 0x3085, classNS},
+	{0x3086, 0x3086, classID},
+	{0x3087, 0x3087, classNS},
+	{0x3088, 0x308D, classID},
+	{0x308E, 0x308E, classNS},
+	{0x308F, 0x3094, classID},
+	{0x3095, 0x3096, classNS},
+	{0x3099, 0x309A, classCM},
+	{0x309B, 0x309E, classNS},
+	{0x309F, 0x309F, classID},
+	{0x30A0, 0x30A1, classNS},
+	{0x30A2, 0x30A2, classID},
+	{0x30A3, 0x30A3, classNS},
+	{0x30A4, 0x30A4, classID},
+	{0x30A5, 0x30A5, classNS},
+	{0x30A6, 0x30A6, classID},
+	{0x30A7, 0x30A7, classNS},
+	{0x30A8, 0x30A8, classID},
+	{0x30A9, 0x30A9, classNS},
+	{0x30AA, 0x30C2, classID},
+	{0x30C3, 0x30C3, classNS},
+	{0x30C4, 0x30E2, classID},
+	{0x30E3, 0x30E3, classNS},
+	{0x30E4, 0x30E4, classID},
+	{0x30E5, 0x30E5, classNS},
+	{0x30E6, 0x30E6, classID},
+	{0x30E7, 0x30E7, classNS},
+	{0x30E8, 0x30ED, classID},
+	{0x30EE, 0x30EE, classNS},
+	{0x30EF, 0x30F4, classID},
+	{0x30F5, 0x30F6, classNS},
+	{0x30F7, 0x30FA, classID},
+	{0x30FB, 0x30FE, classNS},
+	{0x30FF, 0x30FF, classID},
+	{0x3105, 0x312F, classID},
+	{0x3131, 0x318E, classID},
+	{0x3190, 0x31E3, classID},
+	{0x31F0, 0x31FF, classNS},
+	{0x3200, 0x321E, classID},
+	{0x3220, 0x3247, classID},
+	{0x3250, 0x4DBF, classID},
+	{0x4E00, 0xA014, classID},
+	{0xA015, 0xA015, classNS},
+	{0xA016, 0xA48C, classID},
+	{0xA490, 0xA4C6, classID},
+	{0xA4FE, 0xA4FF, classBA},
+	{0xA60D, 0xA60D, classBA},
+	{0xA60E, 0xA60E, classEX},
+	{0xA60F, 0xA60F, classBA},
+	{0xA620, 0xA629, classNU},
+	{0xA66F, 0xA672, classCM},
+	{0xA674, 0xA67D, classCM},
+	{0xA69E, 0xA69F, classCM},
+	{0xA6F0, 0xA6F1, classCM},
+	{0xA6F3, 0xA6F7, classBA},
+	{0xA802, 0xA802, classCM},
+	{0xA806, 0xA806, classCM},
+	{0xA80B, 0xA80B, classCM},
+	{0xA823, 0xA827, classCM},
+	{0xA82C, 0xA82C, classCM},
+	{0xA838, 0xA838, classPO},
+	{0xA874, 0xA875, classBB},
+	{0xA876, 0xA877, classEX},
+	{0xA880, 0xA881, classCM},
+	{0xA8B4, 0xA8C5, classCM},
+	{0xA8CE, 0xA8CF, classBA},
+	{0xA8D0, 0xA8D9, classNU},
+	{0xA8E0, 0xA8F1, classCM},
+	{0xA8FC, 0xA8FC, classBB},
+	{0xA8FF, 0xA8FF, classCM},
+	{0xA900, 0xA909, classNU},
+	{0xA926, 0xA92D, classCM},
+	{0xA92E, 0xA92F, classBA},
+	{0xA947, 0xA953, classCM},
+	{0xA960, 0xA97C, classJL},
+	{0xA980, 0xA983, classCM},
+	{0xA9B3, 0xA9C0, classCM},
+	{0xA9C7, 0xA9C9, classBA},
+	{0xA9D0, 0xA9D9, classNU},
+	{0xA9E0, 0xA9EF, classSA},
+	{0xA9F0, 0xA9F9, classNU},
+	{0xA9FA, 0xA9FE, classSA},
+	{0xAA29, 0xAA36, classCM},
+	{0xAA43, 0xAA43, classCM},
+	{0xAA4C, 0xAA4D, classCM},
+	{0xAA50, 0xAA59, classNU},
+	{0xAA5D, 0xAA5F, classBA},
+	{0xAA60, 0xAAC2, classSA},
+	{0xAADB, 0xAADF, classSA},
+	{0xAAEB, 0xAAEF, classCM},
+	{0xAAF0, 0xAAF1, classBA},
+	{0xAAF5, 0xAAF6, classCM},
+	{0xABE3, 0xABEA, classCM},
+	{0xABEB, 0xABEB, classBA},
+	{0xABEC, 0xABED, classCM},
+	{0xABF0, 0xABF9, classNU},
+	{0xAC00, 0xAC00, classH2},
+	{0xAC01, 0xAC1B, classH3},
+	{0xAC1C, 0xAC1C, classH2},
+	{0xAC1D, 0xAC37, classH3},
+	{0xAC38, 0xAC38, classH2},
+	{0xAC39, 0xAC53, classH3},
+	{0xAC54, 0xAC54, classH2},
+	{0xAC55, 0xAC6F, classH3},
+	{0xAC70, 0xAC70, classH2},
+	{0xAC71, 0xAC8B, classH3},
+	{0xAC8C, 0xAC8C, classH2},
+	{0xAC8D, 0xACA7, classH3},
+	{0xACA8, 0xACA8, classH2},
+	{0xACA9, 0xACC3, classH3},
+	{0xACC4, 0xACC4, classH2},
+	{0xACC5, 0xACDF, classH3},
+	{0xACE0, 0xACE0, classH2},
+	{0xACE1, 0xACFB, classH3},
+	{0xACFC, 0xACFC, classH2},
+	{0xACFD, 0xAD17, classH3},
+	{0xAD18, 0xAD18, classH2},
+	{0xAD19, 0xAD33, classH3},
+	{0xAD34, 0xAD34, classH2},
+	{0xAD35, 0xAD4F, classH3},
+	{0xAD50, 0xAD50, classH2},
+	{0xAD51, 0xAD6B, classH3},
+	{0xAD6C, 0xAD6C, classH2},
+	{0xAD6D, 0xAD87, classH3},
+	{0xAD88, 0xAD88, classH2},
+	{0xAD89, 0xADA3, classH3},
+	{0xADA4, 0xADA4, classH2},
+	{0xADA5, 0xADBF, classH3},
+	{0xADC0, 0xADC0, classH2},
+	{0xADC1, 0xADDB, classH3},
+	{0xADDC, 0xADDC, classH2},
+	{0xADDD, 0xADF7, classH3},
+	{0xADF8, 0xADF8, classH2},
+	{0xADF9, 0xAE13, classH3},
+	{0xAE14, 0xAE14, classH2},
+	{0xAE15, 0xAE2F, classH3},
+	{0xAE30, 0xAE30, classH2},
+	{0xAE31, 0xAE4B, classH3},
+	{0xAE4C, 0xAE4C, classH2},
+	{0xAE4D, 0xAE67, classH3},
+	{0xAE68, 0xAE68, classH2},
+	{0xAE69, 0xAE83, classH3},
+	{0xAE84, 0xAE84, classH2},
+	{0xAE85, 0xAE9F, classH3},
+	{0xAEA0, 0xAEA0, classH2},
+	{0xAEA1, 0xAEBB, classH3},
+	{0xAEBC, 0xAEBC, classH2},
+	{0xAEBD, 0xAED7, classH3},
+	{0xAED8, 0xAED8, classH2},
+	{0xAED9, 0xAEF3, classH3},
+	{0xAEF4, 0xAEF4, classH2},
+	{0xAEF5, 0xAF0F, classH3},
+	{0xAF10, 0xAF10, classH2},
+	{0xAF11, 0xAF2B, classH3},
+	{0xAF2C, 0xAF2C, classH2},
+	{0xAF2D, 0xAF47, classH3},
+	{0xAF48, 0xAF48, classH2},
+	{0xAF49, 0xAF63, classH3},
+	{0xAF64, 0xAF64, classH2},
+	{0xAF65, 0xAF7F, classH3},
+	{0xAF80, 0xAF80, classH2},
+	{0xAF81, 0xAF9B, classH3},
+	{0xAF9C, 0xAF9C, classH2},
+	{0xAF9D, 0xAFB7, classH3},
+	{0xAFB8, 0xAFB8, classH2},
+	{0xAFB9, 0xAFD3, classH3},
+	{0xAFD4, 0xAFD4, classH2},
+	{0xAFD5, 0xAFEF, classH3},
+	{0xAFF0, 0xAFF0, classH2},
+	{0xAFF1, 0xB00B, classH3},
+	{0xB00C, 0xB00C, classH2},
+	{0xB00D, 0xB027, classH3},
+	{0xB028, 0xB028, classH2},
+	{0xB029, 0xB043, classH3},
+	{0xB044, 0xB044, classH2},
+	{0xB045, 0xB05F, classH3},
+	{0xB060, 0xB060, classH2},
+	{0xB061, 0xB07B, classH3},
+	{0xB07C, 0xB07C, classH2},
+	{0xB07D, 0xB097, classH3},
+	{0xB098, 0xB098, classH2},
+	{0xB099, 0xB0B3, classH3},
+	{0xB0B4, 0xB0B4, classH2},
+	{0xB0B5, 0xB0CF, classH3},
+	{0xB0D0, 0xB0D0, classH2},
+	{0xB0D1, 0xB0EB, classH3},
+	{0xB0EC, 0xB0EC, classH2},
+	{0xB0ED, 0xB107, classH3},
+	{0xB108, 0xB108, classH2},
+	{0xB109, 0xB123, classH3},
+	{0xB124, 0xB124, classH2},
+	{0xB125, 0xB13F, classH3},
+	{0xB140, 0xB140, classH2},
+	{0xB141, 0xB15B, classH3},
+	{0xB15C, 0xB15C, classH2},
+	{0xB15D, 0xB177, classH3},
+	{0xB178, 0xB178, classH2},
+	{0xB179, 0xB193, classH3},
+	{0xB194, 0xB194, classH2},
+	{0xB195, 0xB1AF, classH3},
+	{0xB1B0, 0xB1B0, classH2},
+	{0xB1B1, 0xB1CB, classH3},
+	{0xB1CC, 0xB1CC, classH2},
+	{0xB1CD, 0xB1E7, classH3},
+	{0xB1E8, 0xB1E8, classH2},
+	{0xB1E9, 0xB203, classH3},
+	{0xB204, 0xB204, classH2},
+	{0xB205, 0xB21F, classH3},
+	{0xB220, 0xB220, classH2},
+	{0xB221, 0xB23B, classH3},
+	{0xB23C, 0xB23C, classH2},
+	{0xB23D, 0xB257, classH3},
+	{0xB258, 0xB258, classH2},
+	{0xB259, 0xB273, classH3},
+	{0xB274, 0xB274, classH2},
+	{0xB275, 0xB28F, classH3},
+	{0xB290, 0xB290, classH2},
+	{0xB291, 0xB2AB, classH3},
+	{0xB2AC, 0xB2AC, classH2},
+	{0xB2AD, 0xB2C7, classH3},
+	{0xB2C8, 0xB2C8, classH2},
+	{0xB2C9, 0xB2E3, classH3},
+	{0xB2E4, 0xB2E4, classH2},
+	{0xB2E5, 0xB2FF, classH3},
+	{0xB300, 0xB300, classH2},
+	{0xB301, 0xB31B, classH3},
+	{0xB31C, 0xB31C, classH2},
+	{0xB31D, 0xB337, classH3},
+	{0xB338, 0xB338, classH2},
+	{0xB339, 0xB353, classH3},
+	{0xB354, 0xB354, classH2},
+	{0xB355, 0xB36F, classH3},
+	{0xB370, 0xB370, classH2},
+	{0xB371, 0xB38B, classH3},
+	{0xB38C, 0xB38C, classH2},
+	{0xB38D, 0xB3A7, classH3},
+	{0xB3A8, 0xB3A8, classH2},
+	{0xB3A9, 0xB3C3, classH3},
+	{0xB3C4, 0xB3C4, classH2},
+	{0xB3C5, 0xB3DF, classH3},
+	{0xB3E0, 0xB3E0, classH2},
+	{0xB3E1, 0xB3FB, classH3},
+	{0xB3FC, 0xB3FC, classH2},
+	{0xB3FD, 0xB417, classH3},
+	{0xB418, 0xB418, classH2},
+	{0xB419, 0xB433, classH3},
+	{0xB434, 0xB434, classH2},
+	{0xB435, 0xB44F, classH3},
+	{0xB450, 0xB450, classH2},
+	{0xB451, 0xB46B, classH3},
+	{0xB46C, 0xB46C, classH2},
+	{0xB46D, 0xB487, classH3},
+	{0xB488, 0xB488, classH2},
+	{0xB489, 0xB4A3, classH3},
+	{0xB4A4, 0xB4A4, classH2},
+	{0xB4A5, 0xB4BF, classH3},
+	{0xB4C0, 0xB4C0, classH2},
+	{0xB4C1, 0xB4DB, classH3},
+	{0xB4DC, 0xB4DC, classH2},
+	{0xB4DD, 0xB4F7, classH3},
+	{0xB4F8, 0xB4F8, classH2},
+	{0xB4F9, 0xB513, classH3},
+	{0xB514, 0xB514, classH2},
+	{0xB515, 0xB52F, classH3},
+	{0xB530, 0xB530, classH2},
+	{0xB531, 0xB54B, classH3},
+	{0xB54C, 0xB54C, classH2},
+	{0xB54D, 0xB567, classH3},
+	{0xB568, 0xB568, classH2},
+	{0xB569, 0xB583, classH3},
+	{0xB584, 0xB584, classH2},
+	{0xB585, 0xB59F, classH3},
+	{0xB5A0, 0xB5A0, classH2},
+	{0xB5A1, 0xB5BB, classH3},
+	{0xB5BC, 0xB5BC, classH2},
+	{0xB5BD, 0xB5D7, classH3},
+	{0xB5D8, 0xB5D8, classH2},
+	{0xB5D9, 0xB5F3, classH3},
+	{0xB5F4, 0xB5F4, classH2},
+	{0xB5F5, 0xB60F, classH3},
+	{0xB610, 0xB610, classH2},
+	{0xB611, 0xB62B, classH3},
+	{0xB62C, 0xB62C, classH2},
+	{0xB62D, 0xB647, classH3},
+	{0xB648, 0xB648, classH2},
+	{0xB649, 0xB663, classH3},
+	{0xB664, 0xB664, classH2},
+	{0xB665, 0xB67F, classH3},
+	{0xB680, 0xB680, classH2},
+	{0xB681, 0xB69B, classH3},
+	{0xB69C, 0xB69C, classH2},
+	{0xB69D, 0xB6B7, classH3},
+	{0xB6B8, 0xB6B8, classH2},
+	{0xB6B9, 0xB6D3, classH3},
+	{0xB6D4, 0xB6D4, classH2},
+	{0xB6D5, 0xB6EF, classH3},
+	{0xB6F0, 0xB6F0, classH2},
+	{0xB6F1, 0xB70B, classH3},
+	{0xB70C, 0xB70C, classH2},
+	{0xB70D, 0xB727, classH3},
+	{0xB728, 0xB728, classH2},
+	{0xB729, 0xB743, classH3},
+	{0xB744, 0xB744, classH2},
+	{0xB745, 0xB75F, classH3},
+	{0xB760, 0xB760, classH2},
+	{0xB761, 0xB77B, classH3},
+	{0xB77C, 0xB77C, classH2},
+	{0xB77D, 0xB797, classH3},
+	{0xB798, 0xB798, classH2},
+	{0xB799, 0xB7B3, classH3},
+	{0xB7B4, 0xB7B4, classH2},
+	{0xB7B5, 0xB7CF, classH3},
+	{0xB7D0, 0xB7D0, classH2},
+	{0xB7D1, 0xB7EB, classH3},
+	{0xB7EC, 0xB7EC, classH2},
+	{0xB7ED, 0xB807, classH3},
+	{0xB808, 0xB808, classH2},
+	{0xB809, 0xB823, classH3},
+	{0xB824, 0xB824, classH2},
+	{0xB825, 0xB83F, classH3},
+	{0xB840, 0xB840, classH2},
+	{0xB841, 0xB85B, classH3},
+	{0xB85C, 0xB85C, classH2},
+	{0xB85D, 0xB877, classH3},
+	{0xB878, 0xB878, classH2},
+	{0xB879, 0xB893, classH3},
+	{0xB894, 0xB894, classH2},
+	{0xB895, 0xB8AF, classH3},
+	{0xB8B0, 0xB8B0, classH2},
+	{0xB8B1, 0xB8CB, classH3},
+	{0xB8CC, 0xB8CC, classH2},
+	{0xB8CD, 0xB8E7, classH3},
+	{0xB8E8, 0xB8E8, classH2},
+	{0xB8E9, 0xB903, classH3},
+	{0xB904, 0xB904, classH2},
+	{0xB905, 0xB91F, classH3},
+	{0xB920, 0xB920, classH2},
+	{0xB921, 0xB93B, classH3},
+	{0xB93C, 0xB93C, classH2},
+	{0xB93D, 0xB957, classH3},
+	{0xB958, 0xB958, classH2},
+	{0xB959, 0xB973, classH3},
+	{0xB974, 0xB974, classH2},
+	{0xB975, 0xB98F, classH3},
+	{0xB990, 0xB990, classH2},
+	{0xB991, 0xB9AB, classH3},
+	{0xB9AC, 0xB9AC, classH2},
+	{0xB9AD, 0xB9C7, classH3},
+	{0xB9C8, 0xB9C8, classH2},
+	{0xB9C9, 0xB9E3, classH3},
+	{0xB9E4, 0xB9E4, classH2},
+	{0xB9E5, 0xB9FF, classH3},
+	{0xBA00, 0xBA00, classH2},
+	{0xBA01, 0xBA1B, classH3},
+	{0xBA1C, 0xBA1C, classH2},
+	{0xBA1D, 0xBA37, classH3},
+	{0xBA38, 0xBA38, classH2},
+	{0xBA39, 0xBA53, classH3},
+	{0xBA54, 0xBA54, classH2},
+	{0xBA55, 0xBA6F, classH3},
+	{0xBA70, 0xBA70, classH2},
+	{0xBA71, 0xBA8B, classH3},
+	{0xBA8C, 0xBA8C, classH2},
+	{0xBA8D, 0xBAA7, classH3},
+	{0xBAA8, 0xBAA8, classH2},
+	{0xBAA9, 0xBAC3, classH3},
+	{0xBAC4, 0xBAC4, classH2},
+	{0xBAC5, 0xBADF, classH3},
+	{0xBAE0, 0xBAE0, classH2},
+	{0xBAE1, 0xBAFB, classH3},
+	{0xBAFC, 0xBAFC, classH2},
+	{0xBAFD, 0xBB17, classH3},
+	{0xBB18, 0xBB18, classH2},
+	{0xBB19, 0xBB33, classH3},
+	{0xBB34, 0xBB34, classH2},
+	{0xBB35, 0xBB4F, classH3},
+	{0xBB50, 0xBB50, classH2},
+	{0xBB51, 0xBB6B, classH3},
+	{0xBB6C, 0xBB6C, classH2},
+	{0xBB6D, 0xBB87, classH3},
+	{0xBB88, 0xBB88, classH2},
+	{0xBB89, 0xBBA3, classH3},
+	{0xBBA4, 0xBBA4, classH2},
+	{0xBBA5, 0xBBBF, classH3},
+	{0xBBC0, 0xBBC0, classH2},
+	{0xBBC1, 0xBBDB, classH3},
+	{0xBBDC, 0xBBDC, classH2},
+	{0xBBDD, 0xBBF7, classH3},
+	{0xBBF8, 0xBBF8, classH2},
+	{0xBBF9, 0xBC13, classH3},
+	{0xBC14, 0xBC14, classH2},
+	{0xBC15, 0xBC2F, classH3},
+	{0xBC30, 0xBC30, classH2},
+	{0xBC31, 0xBC4B, classH3},
+	{0xBC4C, 0xBC4C, classH2},
+	{0xBC4D, 0xBC67, classH3},
+	{0xBC68, 0xBC68, classH2},
+	{0xBC69, 0xBC83, classH3},
+	{0xBC84, 0xBC84, classH2},
+	{0xBC85, 0xBC9F, classH3},
+	{0xBCA0, 0xBCA0, classH2},
+	{0xBCA1, 0xBCBB, classH3},
+	{0xBCBC, 0xBCBC, classH2},
+	{0xBCBD, 0xBCD7, classH3},
+	{0xBCD8, 0xBCD8, classH2},
+	{0xBCD9, 0xBCF3, classH3},
+	{0xBCF4, 0xBCF4, classH2},
+	{0xBCF5, 0xBD0F, classH3},
+	{0xBD10, 0xBD10, classH2},
+	{0xBD11, 0xBD2B, classH3},
+	{0xBD2C, 0xBD2C, classH2},
+	{0xBD2D, 0xBD47, classH3},
+	{0xBD48, 0xBD48, classH2},
+	{0xBD49, 0xBD63, classH3},
+	{0xBD64, 0xBD64, classH2},
+	{0xBD65, 0xBD7F, classH3},
+	{0xBD80, 0xBD80, classH2},
+	{0xBD81, 0xBD9B, classH3},
+	{0xBD9C, 0xBD9C, classH2},
+	{0xBD9D, 0xBDB7, classH3},
+	{0xBDB8, 0xBDB8, classH2},
+	{0xBDB9, 0xBDD3, classH3},
+	{0xBDD4, 0xBDD4, classH2},
+	{0xBDD5, 0xBDEF, classH3},
+	{0xBDF0, 0xBDF0, classH2},
+	{0xBDF1, 0xBE0B, classH3},
+	{0xBE0C, 0xBE0C, classH2},
+	{0xBE0D, 0xBE27, classH3},
+	{0xBE28, 0xBE28, classH2},
+	{0xBE29, 0xBE43, classH3},
+	{0xBE44, 0xBE44, classH2},
+	{0xBE45, 0xBE5F, classH3},
+	{0xBE60, 0xBE60, classH2},
+	{0xBE61, 0xBE7B, classH3},
+	{0xBE7C, 0xBE7C, classH2},
+	{0xBE7D, 0xBE97, classH3},
+	{0xBE98, 0xBE98, classH2},
+	{0xBE99, 0xBEB3, classH3},
+	{0xBEB4, 0xBEB4, classH2},
+	{0xBEB5, 0xBECF, classH3},
+	{0xBED0, 0xBED0, classH2},
+	{0xBED1, 0xBEEB, classH3},
+	{0xBEEC, 0xBEEC, classH2},
+	{0xBEED, 0xBF07, classH3},
+	{0xBF08, 0xBF08, classH2},
+	{0xBF09, 0xBF23, classH3},
+	{0xBF24, 0xBF24, classH2},
+	{0xBF25, 0xBF3F, classH3},
+	{0xBF40, 0xBF40, classH2},
+	{0xBF41, 0xBF5B, classH3},
+	{0xBF5C, 0xBF5C, classH2},
+	{0xBF5D, 0xBF77, classH3},
+	{0xBF78, 0xBF78, classH2},
+	{0xBF79, 0xBF93, classH3},
+	{0xBF94, 0xBF94, classH2},
+	{0xBF95, 0xBFAF, classH3},
+	{0xBFB0, 0xBFB0, classH2},
+	{0xBFB1, 0xBFCB, classH3},
+	{0xBFCC, 0xBFCC, classH2},
+	{0xBFCD, 0xBFE7, classH3},
+	{0xBFE8, 0xBFE8, classH2},
+	{0xBFE9, 0xC003, classH3},
+	{0xC004, 0xC004, classH2},
+	{0xC005, 0xC01F, classH3},
+	{0xC020, 0xC020, classH2},
+	{0xC021, 0xC03B, classH3},
+	{0xC03C, 0xC03C, classH2},
+	{0xC03D, 0xC057, classH3},
+	{0xC058, 0xC058, classH2},
+	{0xC059, 0xC073, classH3},
+	{0xC074, 0xC074, classH2},
+	{0xC075, 0xC08F, classH3},
+	{0xC090, 0xC090, classH2},
+	{0xC091, 0xC0AB, classH3},
+	{0xC0AC, 0xC0AC, classH2},
+	{0xC0AD, 0xC0C7, classH3},
+	{0xC0C8, 0xC0C8, classH2},
+	{0xC0C9, 0xC0E3, classH3},
+	{0xC0E4, 0xC0E4, classH2},
+	{0xC0E5, 0xC0FF, classH3},
+	{0xC100, 0xC100, classH2},
+	{0xC101, 0xC11B, classH3},
+	{0xC11C, 0xC11C, classH2},
+	{0xC11D, 0xC137, classH3},
+	{0xC138, 0xC138, classH2},
+	{0xC139, 0xC153, classH3},
+	{0xC154, 0xC154, classH2},
+	{0xC155, 0xC16F, classH3},
+	{0xC170, 0xC170, classH2},
+	{0xC171, 0xC18B, classH3},
+	{0xC18C, 0xC18C, classH2},
+	{0xC18D, 0xC1A7, classH3},
+	{0xC1A8, 0xC1A8, classH2},
+	{0xC1A9, 0xC1C3, classH3},
+	{0xC1C4, 0xC1C4, classH2},
+	{0xC1C5, 0xC1DF, classH3},
+	{0xC1E0, 0xC1E0, classH2},
+	{0xC1E1, 0xC1FB, classH3},
+	{0xC1FC, 0xC1FC, classH2},
+	{0xC1FD, 0xC217, classH3},
+	{0xC218, 0xC218, classH2},
+	{0xC219, 0xC233, classH3},
+	{0xC234, 0xC234, classH2},
+	{0xC235, 0xC24F, classH3},
+	{0xC250, 0xC250, classH2},
+	{0xC251, 0xC26B, classH3},
+	{0xC26C, 0xC26C, classH2},
+	{0xC26D, 0xC287, classH3},
+	{0xC288, 0xC288, classH2},
+	{0xC289, 0xC2A3, classH3},
+	{0xC2A4, 0xC2A4, classH2},
+	{0xC2A5, 0xC2BF, classH3},
+	{0xC2C0, 0xC2C0, classH2},
+	{0xC2C1, 0xC2DB, classH3},
+	{0xC2DC, 0xC2DC, classH2},
+	{0xC2DD, 0xC2F7, classH3},
+	{0xC2F8, 0xC2F8, classH2},
+	{0xC2F9, 0xC313, classH3},
+	{0xC314, 0xC314, classH2},
+	{0xC315, 0xC32F, classH3},
+	{0xC330, 0xC330, classH2},
+	{0xC331, 0xC34B, classH3},
+	{0xC34C, 0xC34C, classH2},
+	{0xC34D, 0xC367, classH3},
+	{0xC368, 0xC368, classH2},
+	{0xC369, 0xC383, classH3},
+	{0xC384, 0xC384, classH2},
+	{0xC385, 0xC39F, classH3},
+	{0xC3A0, 0xC3A0, classH2},
+	{0xC3A1, 0xC3BB, classH3},
+	{0xC3BC, 0xC3BC, classH2},
+	{0xC3BD, 0xC3D7, classH3},
+	{0xC3D8, 0xC3D8, classH2},
+	{0xC3D9, 0xC3F3, classH3},
+	{0xC3F4, 0xC3F4, classH2},
+	{0xC3F5, 0xC40F, classH3},
+	{0xC410, 0xC410, classH2},
+	{0xC411, 0xC42B, classH3},
+	{0xC42C, 0xC42C, classH2},
+	{0xC42D, 0xC447, classH3},
+	{0xC448, 0xC448, classH2},
+	{0xC449, 0xC463, classH3},
+	{0xC464, 0xC464, classH2},
+	{0xC465, 0xC47F, classH3},
+	{0xC480, 0xC480, classH2},
+	{0xC481, 0xC49B, classH3},
+	{0xC49C, 0xC49C, classH2},
+	{0xC49D, 0xC4B7, classH3},
+	{0xC4B8, 0xC4B8, classH2},
+	{0xC4B9, 0xC4D3, classH3},
+	{0xC4D4, 0xC4D4, classH2},
+	{0xC4D5, 0xC4EF, classH3},
+	{0xC4F0, 0xC4F0, classH2},
+	{0xC4F1, 0xC50B, classH3},
+	{0xC50C, 0xC50C, classH2},
+	{0xC50D, 0xC527, classH3},
+	{0xC528, 0xC528, classH2},
+	{0xC529, 0xC543, classH3},
+	{0xC544, 0xC544, classH2},
+	{0xC545, 0xC55F, classH3},
+	{0xC560, 0xC560, classH2},
+	{0xC561, 0xC57B, classH3},
+	{0xC57C, 0xC57C, classH2},
+	{0xC57D, 0xC597, classH3},
+	{0xC598, 0xC598, classH2},
+	{0xC599, 0xC5B3, classH3},
+	{0xC5B4, 0xC5B4, classH2},
+	{0xC5B5, 0xC5CF, classH3},
+	{0xC5D0, 0xC5D0, classH2},
+	{0xC5D1, 0xC5EB, classH3},
+	{0xC5EC, 0xC5EC, classH2},
+	{0xC5ED, 0xC607, classH3},
+	{0xC608, 0xC608, classH2},
+	{0xC609, 0xC623, classH3},
+	{0xC624, 0xC624, classH2},
+	{0xC625, 0xC63F, classH3},
+	{0xC640, 0xC640, classH2},
+	{0xC641, 0xC65B, classH3},
+	{0xC65C, 0xC65C, classH2},
+	{0xC65D, 0xC677, classH3},
+	{0xC678, 0xC678, classH2},
+	{0xC679, 0xC693, classH3},
+	{0xC694, 0xC694, classH2},
+	{0xC695, 0xC6AF, classH3},
+	{0xC6B0, 0xC6B0, classH2},
+	{0xC6B1, 0xC6CB, classH3},
+	{0xC6CC, 0xC6CC, classH2},
+	{0xC6CD, 0xC6E7, classH3},
+	{0xC6E8, 0xC6E8, classH2},
+	{0xC6E9, 0xC703, classH3},
+	{0xC704, 0xC704, classH2},
+	{0xC705, 0xC71F, classH3},
+	{0xC720, 0xC720, classH2},
+	{0xC721, 0xC73B, classH3},
+	{0xC73C, 0xC73C, classH2},
+	{0xC73D, 0xC757, classH3},
+	{0xC758, 0xC758, classH2},
+	{0xC759, 0xC773, classH3},
+	{0xC774, 0xC774, classH2},
+	{0xC775, 0xC78F, classH3},
+	{0xC790, 0xC790, classH2},
+	{0xC791, 0xC7AB, classH3},
+	{0xC7AC, 0xC7AC, classH2},
+	{0xC7AD, 0xC7C7, classH3},
+	{0xC7C8, 0xC7C8, classH2},
+	{0xC7C9, 0xC7E3, classH3},
+	{0xC7E4, 0xC7E4, classH2},
+	{0xC7E5, 0xC7FF, classH3},
+	{0xC800, 0xC800, classH2},
+	{0xC801, 0xC81B, classH3},
+	{0xC81C, 0xC81C, classH2},
+	{0xC81D, 0xC837, classH3},
+	{0xC838, 0xC838, classH2},
+	{0xC839, 0xC853, classH3},
+	{0xC854, 0xC854, classH2},
+	{0xC855, 0xC86F, classH3},
+	{0xC870, 0xC870, classH2},
+	{0xC871, 0xC88B, classH3},
+	{0xC88C, 0xC88C, classH2},
+	{0xC88D, 0xC8A7, classH3},
+	{0xC8A8, 0xC8A8, classH2},
+	{0xC8A9, 0xC8C3, classH3},
+	{0xC8C4, 0xC8C4, classH2},
+	{0xC8C5, 0xC8DF, classH3},
+	{0xC8E0, 0xC8E0, classH2},
+	{0xC8E1, 0xC8FB, classH3},
+	{0xC8FC, 0xC8FC, classH2},
+	{0xC8FD, 0xC917, classH3},
+	{0xC918, 0xC918, classH2},
+	{0xC919, 0xC933, classH3},
+	{0xC934, 0xC934, classH2},
+	{0xC935, 0xC94F, classH3},
+	{0xC950, 0xC950, classH2},
+	{0xC951, 0xC96B, classH3},
+	{0xC96C, 0xC96C, classH2},
+	{0xC96D, 0xC987, classH3},
+	{0xC988, 0xC988, classH2},
+	{0xC989, 0xC9A3, classH3},
+	{0xC9A4, 0xC9A4, classH2},
+	{0xC9A5, 0xC9BF, classH3},
+	{0xC9C0, 0xC9C0, classH2},
+	{0xC9C1, 0xC9DB, classH3},
+	{0xC9DC, 0xC9DC, classH2},
+	{0xC9DD, 0xC9F7, classH3},
+	{0xC9F8, 0xC9F8, classH2},
+	{0xC9F9, 0xCA13, classH3},
+	{0xCA14, 0xCA14, classH2},
+	{0xCA15, 0xCA2F, classH3},
+	{0xCA30, 0xCA30, classH2},
+	{0xCA31, 0xCA4B, classH3},
+	{0xCA4C, 0xCA4C, classH2},
+	{0xCA4D, 0xCA67, classH3},
+	{0xCA68, 0xCA68, classH2},
+	{0xCA69, 0xCA83, classH3},
+	{0xCA84, 0xCA84, classH2},
+	{0xCA85, 0xCA9F, classH3},
+	{0xCAA0, 0xCAA0, classH2},
+	{0xCAA1, 0xCABB, classH3},
+	{0xCABC, 0xCABC, classH2},
+	{0xCABD, 0xCAD7, classH3},
+	{0xCAD8, 0xCAD8, classH2},
+	{0xCAD9, 0xCAF3, classH3},
+	{0xCAF4, 0xCAF4, classH2},
+	{0xCAF5, 0xCB0F, classH3},
+	{0xCB10, 0xCB10, classH2},
+	{0xCB11, 0xCB2B, classH3},
+	{0xCB2C, 0xCB2C, classH2},
+	{0xCB2D, 0xCB47, classH3},
+	{0xCB48, 0xCB48, classH2},
+	{0xCB49, 0xCB63, classH3},
+	{0xCB64, 0xCB64, classH2},
+	{0xCB65, 0xCB7F, classH3},
+	{0xCB80, 0xCB80, classH2},
+	{0xCB81, 0xCB9B, classH3},
+	{0xCB9C, 0xCB9C, classH2},
+	{0xCB9D, 0xCBB7, classH3},
+	{0xCBB8, 0xCBB8, classH2},
+	{0xCBB9, 0xCBD3, classH3},
+	{0xCBD4, 0xCBD4, classH2},
+	{0xCBD5, 0xCBEF, classH3},
+	{0xCBF0, 0xCBF0, classH2},
+	{0xCBF1, 0xCC0B, classH3},
+	{0xCC0C, 0xCC0C, classH2},
+	{0xCC0D, 0xCC27, classH3},
+	{0xCC28, 0xCC28, classH2},
+	{0xCC29, 0xCC43, classH3},
+	{0xCC44, 0xCC44, classH2},
+	{0xCC45, 0xCC5F, classH3},
+	{0xCC60, 0xCC60, classH2},
+	{0xCC61, 0xCC7B, classH3},
+	{0xCC7C, 0xCC7C, classH2},
+	{0xCC7D, 0xCC97, classH3},
+	{0xCC98, 0xCC98, classH2},
+	{0xCC99, 0xCCB3, classH3},
+	{0xCCB4, 0xCCB4, classH2},
+	{0xCCB5, 0xCCCF, classH3},
+	{0xCCD0, 0xCCD0, classH2},
+	{0xCCD1, 0xCCEB, classH3},
+	{0xCCEC, 0xCCEC, classH2},
+	{0xCCED, 0xCD07, classH3},
+	{0xCD08, 0xCD08, classH2},
+	{0xCD09, 0xCD23, classH3},
+	{0xCD24, 0xCD24, classH2},
+	{0xCD25, 0xCD3F, classH3},
+	{0xCD40, 0xCD40, classH2},
+	{0xCD41, 0xCD5B, classH3},
+	{0xCD5C, 0xCD5C, classH2},
+	{0xCD5D, 0xCD77, classH3},
+	{0xCD78, 0xCD78, classH2},
+	{0xCD79, 0xCD93, classH3},
+	{0xCD94, 0xCD94, classH2},
+	{0xCD95, 0xCDAF, classH3},
+	{0xCDB0, 0xCDB0, classH2},
+	{0xCDB1, 0xCDCB, classH3},
+	{0xCDCC, 0xCDCC, classH2},
+	{0xCDCD, 0xCDE7, classH3},
+	{0xCDE8, 0xCDE8, classH2},
+	{0xCDE9, 0xCE03, classH3},
+	{0xCE04, 0xCE04, classH2},
+	{0xCE05, 0xCE1F, classH3},
+	{0xCE20, 0xCE20, classH2},
+	{0xCE21, 0xCE3B, classH3},
+	{0xCE3C, 0xCE3C, classH2},
+	{0xCE3D, 0xCE57, classH3},
+	{0xCE58, 0xCE58, classH2},
+	{0xCE59, 0xCE73, classH3},
+	{0xCE74, 0xCE74, classH2},
+	{0xCE75, 0xCE8F, classH3},
+	{0xCE90, 0xCE90, classH2},
+	{0xCE91, 0xCEAB, classH3},
+	{0xCEAC, 0xCEAC, classH2},
+	{0xCEAD, 0xCEC7, classH3},
+	{0xCEC8, 0xCEC8, classH2},
+	{0xCEC9, 0xCEE3, classH3},
+	{0xCEE4, 0xCEE4, classH2},
+	{0xCEE5, 0xCEFF, classH3},
+	{0xCF00, 0xCF00, classH2},
+	{0xCF01, 0xCF1B, classH3},
+	{0xCF1C, 0xCF1C, classH2},
+	{0xCF1D, 0xCF37, classH3},
+	{0xCF38, 0xCF38, classH2},
+	{0xCF39, 0xCF53, classH3},
+	{0xCF54, 0xCF54, classH2},
+	{0xCF55, 0xCF6F, classH3},
+	{0xCF70, 0xCF70, classH2},
+	{0xCF71, 0xCF8B, classH3},
+	{0xCF8C, 0xCF8C, classH2},
+	{0xCF8D, 0xCFA7, classH3},
+	{0xCFA8, 0xCFA8, classH2},
+	{0xCFA9, 0xCFC3, classH3},
+	{0xCFC4, 0xCFC4, classH2},
+	{0xCFC5, 0xCFDF, classH3},
+	{0xCFE0, 0xCFE0, classH2},
+	{0xCFE1, 0xCFFB, classH3},
+	{0xCFFC, 0xCFFC, classH2},
+	{0xCFFD, 0xD017, classH3},
+	{0xD018, 0xD018, classH2},
+	{0xD019, 0xD033, classH3},
+	{0xD034, 0xD034, classH2},
+	{0xD035, 0xD04F, classH3},
+	{0xD050, 0xD050, classH2},
+	{0xD051, 0xD06B, classH3},
+	{0xD06C, 0xD06C, classH2},
+	{0xD06D, 0xD087, classH3},
+	{0xD088, 0xD088, classH2},
+	{0xD089, 0xD0A3, classH3},
+	{0xD0A4, 0xD0A4, classH2},
+	{0xD0A5, 0xD0BF, classH3},
+	{0xD0C0, 0xD0C0, classH2},
+	{0xD0C1, 0xD0DB, classH3},
+	{0xD0DC, 0xD0DC, classH2},
+	{0xD0DD, 0xD0F7, classH3},
+	{0xD0F8, 0xD0F8, classH2},
+	{0xD0F9, 0xD113, classH3},
+	{0xD114, 0xD114, classH2},
+	{0xD115, 0xD12F, classH3},
+	{0xD130, 0xD130, classH2},
+	{0xD131, 0xD14B, classH3},
+	{0xD14C, 0xD14C, classH2},
+	{0xD14D, 0xD167, classH3},
+	{0xD168, 0xD168, classH2},
+	{0xD169, 0xD183, classH3},
+	{0xD184, 0xD184, classH2},
+	{0xD185, 0xD19F, classH3},
+	{0xD1A0, 0xD1A0, classH2},
+	{0xD1A1, 0xD1BB, classH3},
+	{0xD1BC, 0xD1BC, classH2},
+	{0xD1BD, 0xD1D7, classH3},
+	{0xD1D8, 0xD1D8, classH2},
+	{0xD1D9, 0xD1F3, classH3},
+	{0xD1F4, 0xD1F4, classH2},
+	{0xD1F5, 0xD20F, classH3},
+	{0xD210, 0xD210, classH2},
+	{0xD211, 0xD22B, classH3},
+	{0xD22C, 0xD22C, classH2},
+	{0xD22D, 0xD247, classH3},
+	{0xD248, 0xD248, classH2},
+	{0xD249, 0xD263, classH3},
+	{0xD264, 0xD264, classH2},
+	{0xD265, 0xD27F, classH3},
+	{0xD280, 0xD280, classH2},
+	{0xD281, 0xD29B, classH3},
+	{0xD29C, 0xD29C, classH2},
+	{0xD29D, 0xD2B7, classH3},
+	{0xD2B8, 0xD2B8, classH2},
+	{0xD2B9, 0xD2D3, classH3},
+	{0xD2D4, 0xD2D4, classH2},
+	{0xD2D5, 0xD2EF, classH3},
+	{0xD2F0, 0xD2F0, classH2},
+	{0xD2F1, 0xD30B, classH3},
+	{0xD30C, 0xD30C, classH2},
+	{0xD30D, 0xD327, classH3},
+	{0xD328, 0xD328, classH2},
+	{0xD329, 0xD343, classH3},
+	{0xD344, 0xD344, classH2},
+	{0xD345, 0xD35F, classH3},
+	{0xD360, 0xD360, classH2},
+	{0xD361, 0xD37B, classH3},
+	{0xD37C, 0xD37C, classH2},
+	{0xD37D, 0xD397, classH3},
+	{0xD398, 0xD398, classH2},
+	{0xD399, 0xD3B3, classH3},
+	{0xD3B4, 0xD3B4, classH2},
+	{0xD3B5, 0xD3CF, classH3},
+	{0xD3D0, 0xD3D0, classH2},
+	{0xD3D1, 0xD3EB, classH3},
+	{0xD3EC, 0xD3EC, classH2},
+	{0xD3ED, 0xD407, classH3},
+	{0xD408, 0xD408, classH2},
+	{0xD409, 0xD423, classH3},
+	{0xD424, 0xD424, classH2},
+	{0xD425, 0xD43F, classH3},
+	{0xD440, 0xD440, classH2},
+	{0xD441, 0xD45B, classH3},
+	{0xD45C, 0xD45C, classH2},
+	{0xD45D, 0xD477, classH3},
+	{0xD478, 0xD478, classH2},
+	{0xD479, 0xD493, classH3},
+	{0xD494, 0xD494, classH2},
+	{0xD495, 0xD4AF, classH3},
+	{0xD4B0, 0xD4B0, classH2},
+	{0xD4B1, 0xD4CB, classH3},
+	{0xD4CC, 0xD4CC, classH2},
+	{0xD4CD, 0xD4E7, classH3},
+	{0xD4E8, 0xD4E8, classH2},
+	{0xD4E9, 0xD503, classH3},
+	{0xD504, 0xD504, classH2},
+	{0xD505, 0xD51F, classH3},
+	{0xD520, 0xD520, classH2},
+	{0xD521, 0xD53B, classH3},
+	{0xD53C, 0xD53C, classH2},
+	{0xD53D, 0xD557, classH3},
+	{0xD558, 0xD558, classH2},
+	{0xD559, 0xD573, classH3},
+	{0xD574, 0xD574, classH2},
+	{0xD575, 0xD58F, classH3},
+	{0xD590, 0xD590, classH2},
+	{0xD591, 0xD5AB, classH3},
+	{0xD5AC, 0xD5AC, classH2},
+	{0xD5AD, 0xD5C7, classH3},
+	{0xD5C8, 0xD5C8, classH2},
+	{0xD5C9, 0xD5E3, classH3},
+	{0xD5E4, 0xD5E4, classH2},
+	{0xD5E5, 0xD5FF, classH3},
+	{0xD600, 0xD600, classH2},
+	{0xD601, 0xD61B, classH3},
+	{0xD61C, 0xD61C, classH2},
+	{0xD61D, 0xD637, classH3},
+	{0xD638, 0xD638, classH2},
+	{0xD639, 0xD653, classH3},
+	{0xD654, 0xD654, classH2},
+	{0xD655, 0xD66F, classH3},
+	{0xD670, 0xD670, classH2},
+	{0xD671, 0xD68B, classH3},
+	{0xD68C, 0xD68C, classH2},
+	{0xD68D, 0xD6A7, classH3},
+	{0xD6A8, 0xD6A8, classH2},
+	{0xD6A9, 0xD6C3, classH3},
+	{0xD6C4, 0xD6C4, classH2},
+	{0xD6C5, 0xD6DF, classH3},
+	{0xD6E0, 0xD6E0, classH2},
+	{0xD6E1, 0xD6FB, classH3},
+	{0xD6FC, 0xD6FC, classH2},
+	{0xD6FD, 0xD717, classH3},
+	{0xD718, 0xD718, classH2},
+	{0xD719, 0xD733, classH3},
+	{0xD734, 0xD734, classH2},
+	{0xD735, 0xD74F, classH3},
+	{0xD750, 0xD750, classH2},
+	{0xD751, 0xD76B, classH3},
+	{0xD76C, 0xD76C, classH2},
+	{0xD76D, 0xD787, classH3},
+	{0xD788, 0xD788, classH2},
+	{0xD789, 0xD7A3, classH3},
+	{0xD7B0, 0xD7C6, classJV},
+	{0xD7CB, 0xD7FB, classJT},
+	{0xF900, 0xFAFF, classID},
+	{0xFB1D, 0xFB1D, classHL},
+	{0xFB1E, 0xFB1E, classCM},
+	{0xFB1F, 0xFB28, classHL},
+	{0xFB2A, 0xFB36, classHL},
+	{0xFB38, 0xFB3C, classHL},
+	{0xFB3E, 0xFB3E, classHL},
+	{0xFB40, 0xFB41, classHL},
+	{0xFB43, 0xFB44, classHL},
+	{0xFB46, 0xFB4F, classHL},
+	{0xFD3E, 0xFD3E, classCL},
+	{0xFD3F, 0xFD3F, classOP},
+	{0xFDFC, 0xFDFC, classPO},
+	{0xFE00, 0xFE0F, classCM},
+	{0xFE10, 0xFE10, classIS},
+	{0xFE11, 0xFE12, classCL},
+	{0xFE13, 0xFE14, classIS},
+	{0xFE15, 0xFE16, classEX},
+	{0xFE17, 0xFE17, classOP},
+	{0xFE18, 0xFE18, classCL},
+	{0xFE19, 0xFE19, classIN},
+	{0xFE20, 0xFE2F, classCM},
+	{0xFE30, 0xFE34, classID},
+	{0xFE35, 0xFE35, classOP},
+	{0xFE36, 0xFE36, classCL},
+	{0xFE37, 0xFE37, classOP},
+	{0xFE38, 0xFE38, classCL},
+	{0xFE39, 0xFE39, classOP},
+	{0xFE3A, 0xFE3A, classCL},
+	{0xFE3B, 0xFE3B, classOP},
+	{0xFE3C, 0xFE3C, classCL},
+	{0xFE3D, 0xFE3D, classOP},
+	{0xFE3E, 0xFE3E, classCL},
+	{0xFE3F, 0xFE3F, classOP},
+	{0xFE40, 0xFE40, classCL},
+	{0xFE41, 0xFE41, classOP},
+	{0xFE42, 0xFE42, classCL},
+	{0xFE43, 0xFE43, classOP},
+	{0xFE44, 0xFE44, classCL},
+	{0xFE45, 0xFE46, classID},
+	{0xFE47, 0xFE47, classOP},
+	{0xFE48, 0xFE48, classCL},
+	{0xFE49, 0xFE4F, classID},
+	{0xFE50, 0xFE50, classCL},
+	{0xFE51, 0xFE51, classID},
+	{0xFE52, 0xFE52, classCL},
+	{0xFE54, 0xFE55, classNS},
+	{0xFE56, 0xFE57, classEX},
+	{0xFE58, 0xFE58, classID},
+	{0xFE59, 0xFE59, classOP},
+	{0xFE5A, 0xFE5A, classCL},
+	{0xFE5B, 0xFE5B, classOP},
+	{0xFE5C, 0xFE5C, classCL},
+	{0xFE5D, 0xFE5D, classOP},
+	{0xFE5E, 0xFE5E, classCL},
+	{0xFE5F, 0xFE66, classID},
+	{0xFE68, 0xFE68, classID},
+	{0xFE69, 0xFE69, classPR},
+	{0xFE6A, 0xFE6A, classPO},
+	{0xFE6B, 0xFE6B, classID},
+	{0xFEFF, 0xFEFF, classWJ},
+	{0xFF01, 0xFF01, classEX},
+	{0xFF02, 0xFF03, classID},
+	{0xFF04, 0xFF04, classPR},
+	{0xFF05, 0xFF05, classPO},
+	{0xFF06, 0xFF07, classID},
+	{0xFF08, 0xFF08, classOP},
+	{0xFF09, 0xFF09, classCL},
+	{0xFF0A, 0xFF0B, classID},
+	{0xFF0C, 0xFF0C, classCL},
+	{0xFF0D, 0xFF0D, classID},
+	{0xFF0E, 0xFF0E, classCL},
+	{0xFF0F, 0xFF19, classID},
+	{0xFF1A, 0xFF1B, classNS},
+	{0xFF1C, 0xFF1E, classID},
+	{0xFF1F, 0xFF1F, classEX},
+	{0xFF20, 0xFF3A, classID},
+	{0xFF3B, 0xFF3B, classOP},
+	{0xFF3C, 0xFF3C, classID},
+	{0xFF3D, 0xFF3D, classCL},
+	{0xFF3E, 0xFF5A, classID},
+	{0xFF5B, 0xFF5B, classOP},
+	{0xFF5C, 0xFF5C, classID},
+	{0xFF5D, 0xFF5D, classCL},
+	{0xFF5E, 0xFF5E, classID},
+	{0xFF5F, 0xFF5F, classOP},
+	{0xFF60, 0xFF61, classCL},
+	{0xFF62, 0xFF62, classOP},
+	{0xFF63, 0xFF64, classCL},
+	{0xFF65, 0xFF65, classNS},
+	{0xFF66, 0xFF66, classID},
+	{0xFF67, 0xFF70, classNS},
+	{0xFF71, 0xFF9D, classID},
+	{0xFF9E, 0xFF9F, classNS},
+	{0xFFA0, 0xFFBE, classID},
+	{0xFFC2, 0xFFC7, classID},
+	{0xFFCA, 0xFFCF, classID},
+	{0xFFD2, 0xFFD7, classID},
+	{0xFFDA, 0xFFDC, classID},
+	{0xFFE0, 0xFFE0, classPO},
+	{0xFFE1, 0xFFE1, classPR},
+	{0xFFE2, 0xFFE4, classID},
+	{0xFFE5, 0xFFE6, classPR},
+	{0xFFF9, 0xFFFB, classCM},
+	{0xFFFC, 0xFFFC, classCB},
+	{0x10100, 0x10102, classBA},
+	{0x101FD, 0x101FD, classCM},
+	{0x102E0, 0x102E0, classCM},
+	{0x10376, 0x1037A, classCM},
+	{0x1039F, 0x1039F, classBA},
+	{0x103D0, 0x103D0, classBA},
+	{0x104A0, 0x104A9, classNU},
+	{0x10857, 0x10857, classBA},
+	{0x1091F, 0x1091F, classBA},
+	{0x10A01, 0x10A03, classCM},
+	{0x10A05, 0x10A06, classCM},
+	{0x10A0C, 0x10A0F, classCM},
+	{0x10A38, 0x10A3A, classCM},
+	{0x10A3F, 0x10A3F, classCM},
+	{0x10A50, 0x10A57, classBA},
+	{0x10AE5, 0x10AE6, classCM},
+	{0x10AF0, 0x10AF5, classBA},
+	{0x10AF6, 0x10AF6, classIN},
+	{0x10B39, 0x10B3F, classBA},
+	{0x10D24, 0x10D27, classCM},
+	{0x10D30, 0x10D39, classNU},
+	{0x10EAB, 0x10EAC, classCM},
+	{0x10EAD, 0x10EAD, classBA},
+	{0x10F46, 0x10F50, classCM},
+	{0x10F82, 0x10F85, classCM},
+	{0x11000, 0x11002, classCM},
+	{0x11038, 0x11046, classCM},
+	{0x11047, 0x11048, classBA},
+	{0x11066, 0x1106F, classNU},
+	{0x11070, 0x11070, classCM},
+	{0x11073, 0x11074, classCM},
+	{0x1107F, 0x11082, classCM},
+	{0x110B0, 0x110BA, classCM},
+	{0x110BE, 0x110C1, classBA},
+	{0x110C2, 0x110C2, classCM},
+	{0x110F0, 0x110F9, classNU},
+	{0x11100, 0x11102, classCM},
+	{0x11127, 0x11134, classCM},
+	{0x11136, 0x1113F, classNU},
+	{0x11140, 0x11143, classBA},
+	{0x11145, 0x11146, classCM},
+	{0x11173, 0x11173, classCM},
+	{0x11175, 0x11175, classBB},
+	{0x11180, 0x11182, classCM},
+	{0x111B3, 0x111C0, classCM},
+	{0x111C5, 0x111C6, classBA},
+	{0x111C8, 0x111C8, classBA},
+	{0x111C9, 0x111CC, classCM},
+	{0x111CE, 0x111CF, classCM},
+	{0x111D0, 0x111D9, classNU},
+	{0x111DB, 0x111DB, classBB},
+	{0x111DD, 0x111DF, classBA},
+	{0x1122C, 0x11237, classCM},
+	{0x11238, 0x11239, classBA},
+	{0x1123B, 0x1123C, classBA},
+	{0x1123E, 0x1123E, classCM},
+	{0x112A9, 0x112A9, classBA},
+	{0x112DF, 0x112EA, classCM},
+	{0x112F0, 0x112F9, classNU},
+	{0x11300, 0x11303, classCM},
+	{0x1133B, 0x1133C, classCM},
+	{0x1133E, 0x11344, classCM},
+	{0x11347, 0x11348, classCM},
+	{0x1134B, 0x1134D, classCM},
+	{0x11357, 0x11357, classCM},
+	{0x11362, 0x11363, classCM},
+	{0x11366, 0x1136C, classCM},
+	{0x11370, 0x11374, classCM},
+	{0x11435, 0x11446, classCM},
+	{0x1144B, 0x1144E, classBA},
+	{0x11450, 0x11459, classNU},
+	{0x1145A, 0x1145B, classBA},
+	{0x1145E, 0x1145E, classCM},
+	{0x114B0, 0x114C3, classCM},
+	{0x114D0, 0x114D9, classNU},
+	{0x115AF, 0x115B5, classCM},
+	{0x115B8, 0x115C0, classCM},
+	{0x115C1, 0x115C1, classBB},
+	{0x115C2, 0x115C3, classBA},
+	{0x115C4, 0x115C5, classEX},
+	{0x115C9, 0x115D7, classBA},
+	{0x115DC, 0x115DD, classCM},
+	{0x11630, 0x11640, classCM},
+	{0x11641, 0x11642, classBA},
+	{0x11650, 0x11659, classNU},
+	{0x11660, 0x1166C, classBB},
+	{0x116AB, 0x116B7, classCM},
+	{0x116C0, 0x116C9, classNU},
+	{0x11700, 0x1171A, classSA},
+	{0x1171D, 0x1172B, classSA},
+	{0x11730, 0x11739, classNU},
+	{0x1173A, 0x1173B, classSA},
+	{0x1173C, 0x1173E, classBA},
+	{0x1173F, 0x11746, classSA},
+	{0x1182C, 0x1183A, classCM},
+	{0x118E0, 0x118E9, classNU},
+	{0x11930, 0x11935, classCM},
+	{0x11937, 0x11938, classCM},
+	{0x1193B, 0x1193E, classCM},
+	{0x11940, 0x11940, classCM},
+	{0x11942, 0x11943, classCM},
+	{0x11944, 0x11946, classBA},
+	{0x11950, 0x11959, classNU},
+	{0x119D1, 0x119D7, classCM},
+	{0x119DA, 0x119E0, classCM},
+	{0x119E2, 0x119E2, classBB},
+	{0x119E4, 0x119E4, classCM},
+	{0x11A01, 0x11A0A, classCM},
+	{0x11A33, 0x11A39, classCM},
+	{0x11A3B, 0x11A3E, classCM},
+	{0x11A3F, 0x11A3F, classBB},
+	{0x11A41, 0x11A44, classBA},
+	{0x11A45, 0x11A45, classBB},
+	{0x11A47, 0x11A47, classCM},
+	{0x11A51, 0x11A5B, classCM},
+	{0x11A8A, 0x11A99, classCM},
+	{0x11A9A, 0x11A9C, classBA},
+	{0x11A9E, 0x11AA0, classBB},
+	{0x11AA1, 0x11AA2, classBA},
+	{0x11C2F, 0x11C36, classCM},
+	{0x11C38, 0x11C3F, classCM},
+	{0x11C41, 0x11C45, classBA},
+	{0x11C50, 0x11C59, classNU},
+	{0x11C70, 0x11C70, classBB},
+	{0x11C71, 0x11C71, classEX},
+	{0x11C92, 0x11CA7, classCM},
+	{0x11CA9, 0x11CB6, classCM},
+	{0x11D31, 0x11D36, classCM},
+	{0x11D3A, 0x11D3A, classCM},
+	{0x11D3C, 0x11D3D, classCM},
+	{0x11D3F, 0x11D45, classCM},
+	{0x11D47, 0x11D47, classCM},
+	{0x11D50, 0x11D59, classNU},
+	{0x11D8A, 0x11D8E, classCM},
+	{0x11D90, 0x11D91, classCM},
+	{0x11D93, 0x11D97, classCM},
+	{0x11DA0, 0x11DA9, classNU},
+	{0x11EF3, 0x11EF6, classCM},
+	{0x11FDD, 0x11FE0, classPO},
+	{0x11FFF, 0x11FFF, classBA},
+	{0x12470, 0x12474, classBA},
+	{0x13258, 0x1325A, classOP},
+	{0x1325B, 0x1325D, classCL},
+	{0x13282, 0x13282, classCL},
+	{0x13286, 0x13286, classOP},
+	{0x13287, 0x13287, classCL},
+	{0x13288, 0x13288, classOP},
+	{0x13289, 0x13289, classCL},
+	{0x13379, 0x13379, classOP},
+	{0x1337A, 0x1337B, classCL},
+	{0x13430, 0x13436, classGL},
+	{0x13437, 0x13437, classOP},
+	{0x13438, 0x13438, classCL},
+	{0x145CE, 0x145CE, classOP},
+	{0x145CF, 0x145CF, classCL},
+	{0x16A60, 0x16A69, classNU},
+	{0x16A6E, 0x16A6F, classBA},
+	{0x16AC0, 0x16AC9, classNU},
+	{0x16AF0, 0x16AF4, classCM},
+	{0x16AF5, 0x16AF5, classBA},
+	{0x16B30, 0x16B36, classCM},
+	{0x16B37, 0x16B39, classBA},
+	{0x16B44, 0x16B44, classBA},
+	{0x16B50, 0x16B59, classNU},
+	{0x16E97, 0x16E98, classBA},
+	{0x16F4F, 0x16F4F, classCM},
+	{0x16F51, 0x16F87, classCM},
+	{0x16F8F, 0x16F92, classCM},
+	{0x16FE0, 0x16FE3, classNS},
+	{0x16FE4, 0x16FE4, classGL},
+	{0x16FF0, 0x16FF1, classCM},
+	{0x17000, 0x187F7, classID},
+	{0x18800, 0x18AFF, classID},
+	{0x18D00, 0x18D08, classID},
+	{0x1B000, 0x1B122, classID},
+	{0x1B150, 0x1B152, classNS},
+	{0x1B164, 0x1B167, classNS},
+	{0x1B170, 0x1B2FB, classID},
+	{0x1BC9D, 0x1BC9E, classCM},
+	{0x1BC9F, 0x1BC9F, classBA},
+	{0x1BCA0, 0x1BCA3, classCM},
+	{0x1CF00, 0x1CF2D, classCM},
+	{0x1CF30, 0x1CF46, classCM},
+	{0x1D165, 0x1D169, classCM},
+	{0x1D16D, 0x1D182, classCM},
+	{0x1D185, 0x1D18B, classCM},
+	{0x1D1AA, 0x1D1AD, classCM},
+	{0x1D242, 0x1D244, classCM},
+	{0x1D7CE, 0x1D7FF, classNU},
+	{0x1DA00, 0x1DA36, classCM},
+	{0x1DA3B, 0x1DA6C, classCM},
+	{0x1DA75, 0x1DA75, classCM},
+	{0x1DA84, 0x1DA84, classCM},
+	{0x1DA87, 0x1DA8A, classBA},
+	{0x1DA9B, 0x1DA9F, classCM},
+	{0x1DAA1, 0x1DAAF, classCM},
+	{0x1E000, 0x1E006, classCM},
+	{0x1E008, 0x1E018, classCM},
+	{0x1E01B, 0x1E021, classCM},
+	{0x1E023, 0x1E024, classCM},
+	{0x1E026, 0x1E02A, classCM},
+	{0x1E130, 0x1E136, classCM},
+	{0x1E140, 0x1E149, classNU},
+	{0x1E2AE, 0x1E2AE, classCM},
+	{0x1E2EC, 0x1E2EF, classCM},
+	{0x1E2F0, 0x1E2F9, classNU},
+	{0x1E2FF, 0x1E2FF, classPR},
+	{0x1E8D0, 0x1E8D6, classCM},
+	{0x1E944, 0x1E94A, classCM},
+	{0x1E950, 0x1E959, classNU},
+	{0x1E95E, 0x1E95F, classOP},
+	{0x1ECAC, 0x1ECAC, classPO},
+	{0x1ECB0, 0x1ECB0, classPO},
+	{0x1F000, 0x1F0FF, classID},
+	{0x1F10D, 0x1F10F, classID},
+	{0x1F16D, 0x1F16F, classID},
+	{0x1F1AD, 0x1F1E5, classID},
+	{0x1F1E6, 0x1F1FF, classRI},
+	{0x1F200, 0x1F384, classID},
+	{0x1F385, 0x1F385, classEB},
+	{0x1F386, 0x1F39B, classID},
+	{0x1F39E, 0x1F3B4, classID},
+	{0x1F3B7, 0x1F3BB, classID},
+	{0x1F3BD, 0x1F3C1, classID},
+	{0x1F3C2, 0x1F3C4, classEB},
+	{0x1F3C5, 0x1F3C6, classID},
+	{0x1F3C7, 0x1F3C7, classEB},
+	{0x1F3C8, 0x1F3C9, classID},
+	{0x1F3CA, 0x1F3CC, classEB},
+	{0x1F3CD, 0x1F3FA, classID},
+	{0x1F3FB, 0x1F3FF, classEM},
+	{0x1F400, 0x1F441, classID},
+	{0x1F442, 0x1F443, classEB},
+	{0x1F444, 0x1F445, classID},
+	{0x1F446, 0x1F450, classEB},
+	{0x1F451, 0x1F465, classID},
+	{0x1F466, 0x1F478, classEB},
+	{0x1F479, 0x1F47B, classID},
+	{0x1F47C, 0x1F47C, classEB},
+	{0x1F47D, 0x1F480, classID},
+	{0x1F481, 0x1F483, classEB},
+	{0x1F484, 0x1F484, classID},
+	{0x1F485, 0x1F487, classEB},
+	{0x1F488, 0x1F48E, classID},
+	{0x1F48F, 0x1F48F, classEB},
+	{0x1F490, 0x1F490, classID},
+	{0x1F491, 0x1F491, classEB},
+	{0x1F492, 0x1F49F, classID},
+	{0x1F4A1, 0x1F4A1, classID},
+	{0x1F4A3, 0x1F4A3, classID},
+	{0x1F4A5, 0x1F4A9, classID},
+	{0x1F4AA, 0x1F4AA, classEB},
+	{0x1F4AB, 0x1F4AE, classID},
+	{0x1F4B0, 0x1F4B0, classID},
+	{0x1F4B3, 0x1F4FF, classID},
+	{0x1F507, 0x1F516, classID},
+	{0x1F525, 0x1F531, classID},
+	{0x1F54A, 0x1F573, classID},
+	{0x1F574, 0x1F575, classEB},
+	{0x1F576, 0x1F579, classID},
+	{0x1F57A, 0x1F57A, classEB},
+	{0x1F57B, 0x1F58F, classID},
+	{0x1F590, 0x1F590, classEB},
+	{0x1F591, 0x1F594, classID},
+	{0x1F595, 0x1F596, classEB},
+	{0x1F597, 0x1F5D3, classID},
+	{0x1F5DC, 0x1F5F3, classID},
+	{0x1F5FA, 0x1F644, classID},
+	{0x1F645, 0x1F647, classEB},
+	{0x1F648, 0x1F64A, classID},
+	{0x1F64B, 0x1F64F, classEB},
+	{0x1F676, 0x1F678, classQU},
+	{0x1F679, 0x1F67B, classNS},
+	{0x1F680, 0x1F6A2, classID},
+	{0x1F6A3, 0x1F6A3, classEB},
+	{0x1F6A4, 0x1F6B3, classID},
+	{0x1F6B4, 0x1F6B6, classEB},
+	{0x1F6B7, 0x1F6BF, classID},
+	{0x1F6C0, 0x1F6C0, classEB},
+	{0x1F6C1, 0x1F6CB, classID},
+	{0x1F6CC, 0x1F6CC, classEB},
+	{0x1F6CD, 0x1F6FF, classID},
+	{0x1F774, 0x1F77F, classID},
+	{0x1F7D5, 0x1F7FF, classID},
+	{0x1F80C, 0x1F80F, classID},
+	{0x1F848, 0x1F84F, classID},
+	{0x1F85A, 0x1F85F, classID},
+	{0x1F888, 0x1F88F, classID},
+	{0x1F8AE, 0x1F8FF, classID},
+	{0x1F90C, 0x1F90C, classEB},
+	{0x1F90D, 0x1F90E, classID},
+	{0x1F90F, 0x1F90F, classEB},
+	{0x1F910, 0x1F917, classID},
+	{0x1F918, 0x1F91F, classEB},
+	{0x1F920, 0x1F925, classID},
+	{0x1F926, 0x1F926, classEB},
+	{0x1F927, 0x1F92F, classID},
+	{0x1F930, 0x1F939, classEB},
+	{0x1F93A, 0x1F93B, classID},
+	{0x1F93C, 0x1F93E, classEB},
+	{0x1F93F, 0x1F976, classID},
+	{0x1F977, 0x1F977, classEB},
+	{0x1F978, 0x1F9B4, classID},
+	{0x1F9B5, 0x1F9B6, classEB},
+	{0x1F9B7, 0x1F9B7, classID},
+	{0x1F9B8, 0x1F9B9, classEB},
+	{0x1F9BA, 0x1F9BA, classID},
+	{0x1F9BB, 0x1F9BB, classEB},
+	{0x1F9BC, 0x1F9CC, classID},
+	{0x1F9CD, 0x1F9CF, classEB},
+	{0x1F9D0, 0x1F9D0, classID},
+	{0x1F9D1, 0x1F9DD, classEB},
+	{0x1F9DE, 0x1F9FF, classID},
+	{0x1FA54, 0x1FAC2, classID},
+	{0x1FAC3, 0x1FAC5, classEB},
+	{0x1FAC6, 0x1FAEF, classID},
+	{0x1FAF0, 0x1FAF6, classEB},
+	{0x1FAF7, 0x1FAFF, classID},
+	{0x1FBF0, 0x1FBF9, classNU},
+	{0x1FC00, 0x1FFFD, classID},
+	{0x20000, 0x2FFFD, classID},
+	{0x30000, 0x3FFFD, classID},
+	{0xE0001, 0xE0001, classCM},
+	{0xE0020, 0xE007F, classCM},
+	{0xE0100, 0xE01EF, classCM},
+}
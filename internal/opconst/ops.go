@@ -4,6 +4,10 @@ package opconst
 
 type OpType byte
 
+// MaxGradientStops is the maximum number of stops in a
+// gradient operation.
+const MaxGradientStops = 8
+
 // Start at a high number for easier debugging.
 const firstOpIndex = 200
 
@@ -16,6 +20,8 @@ const (
 	TypeImage
 	TypePaint
 	TypeColor
+	TypeLinearGradient
+	TypeRadialGradient
 	TypeArea
 	TypePointerInput
 	TypePass
@@ -26,27 +32,33 @@ const (
 	TypeAux
 	TypeClip
 	TypeProfile
+	TypeClipboardRead
+	TypeClipboardWrite
 )
 
 const (
-	TypeMacroLen        = 1 + 4 + 4
-	TypeCallLen         = 1 + 4 + 4
-	TypeTransformLen    = 1 + 4*2
-	TypeLayerLen        = 1
-	TypeRedrawLen       = 1 + 8
-	TypeImageLen        = 1 + 4*4
-	TypePaintLen        = 1 + 4*4
-	TypeColorLen        = 1 + 4
-	TypeAreaLen         = 1 + 1 + 4*4
-	TypePointerInputLen = 1 + 1
-	TypePassLen         = 1 + 1
-	TypeKeyInputLen     = 1 + 1
-	TypeHideInputLen    = 1
-	TypePushLen         = 1
-	TypePopLen          = 1
-	TypeAuxLen          = 1
-	TypeClipLen         = 1 + 4*4
-	TypeProfileLen      = 1
+	TypeMacroLen          = 1 + 4 + 4
+	TypeCallLen           = 1 + 4 + 4
+	TypeTransformLen      = 1 + 4*6
+	TypeLayerLen          = 1 + 4
+	TypeRedrawLen         = 1 + 8
+	TypeImageLen          = 1 + 4*4
+	TypePaintLen          = 1 + 4*4
+	TypeColorLen          = 1 + 4
+	TypeLinearGradientLen = 1 + 1 + 4*4 + MaxGradientStops*(4+4)
+	TypeRadialGradientLen = 1 + 1 + 4*4 + MaxGradientStops*(4+4)
+	TypeAreaLen           = 1 + 1 + 4*4
+	TypePointerInputLen   = 1 + 1
+	TypePassLen           = 1 + 1
+	TypeKeyInputLen       = 1 + 1 + 1 + 1
+	TypeHideInputLen      = 1
+	TypePushLen           = 1
+	TypePopLen            = 1
+	TypeAuxLen            = 1
+	TypeClipLen           = 1 + 4*4
+	TypeProfileLen        = 1
+	TypeClipboardReadLen  = 1
+	TypeClipboardWriteLen = 1
 )
 
 func (t OpType) Size() int {
@@ -59,6 +71,8 @@ func (t OpType) Size() int {
 		TypeImageLen,
 		TypePaintLen,
 		TypeColorLen,
+		TypeLinearGradientLen,
+		TypeRadialGradientLen,
 		TypeAreaLen,
 		TypePointerInputLen,
 		TypePassLen,
@@ -69,12 +83,14 @@ func (t OpType) Size() int {
 		TypeAuxLen,
 		TypeClipLen,
 		TypeProfileLen,
+		TypeClipboardReadLen,
+		TypeClipboardWriteLen,
 	}[t-firstOpIndex]
 }
 
 func (t OpType) NumRefs() int {
 	switch t {
-	case TypeKeyInput, TypePointerInput, TypeProfile, TypeCall:
+	case TypeKeyInput, TypePointerInput, TypeProfile, TypeCall, TypeClipboardRead, TypeClipboardWrite:
 		return 1
 	case TypeImage:
 		return 2
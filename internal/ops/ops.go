@@ -8,16 +8,61 @@ import (
 
 	"gioui.org/f32"
 	"gioui.org/internal/opconst"
-	"gioui.org/op"
 )
 
-func DecodeTransformOp(d []byte) op.TransformOp {
+func DecodeTransformOp(d []byte) f32.Affine2D {
 	bo := binary.LittleEndian
 	if opconst.OpType(d[0]) != opconst.TypeTransform {
 		panic("invalid op")
 	}
-	return op.TransformOp{}.Offset(f32.Point{
-		X: math.Float32frombits(bo.Uint32(d[1:])),
-		Y: math.Float32frombits(bo.Uint32(d[5:])),
-	})
+	return f32.NewAffine2D(
+		math.Float32frombits(bo.Uint32(d[1:])),
+		math.Float32frombits(bo.Uint32(d[5:])),
+		math.Float32frombits(bo.Uint32(d[9:])),
+		math.Float32frombits(bo.Uint32(d[13:])),
+		math.Float32frombits(bo.Uint32(d[17:])),
+		math.Float32frombits(bo.Uint32(d[21:])),
+	)
+}
+
+// Quad is a quadratic Bézier curve segment of a path.
+type Quad struct {
+	From, Ctrl, To f32.Point
+}
+
+// QuadSize is the encoded size of a path segment: its contour
+// index followed by the Quad.
+const QuadSize = 4 + 4*2*3
+
+// Transform q by t.
+func (q Quad) Transform(t f32.Affine2D) Quad {
+	q.From = t.Transform(q.From)
+	q.Ctrl = t.Transform(q.Ctrl)
+	q.To = t.Transform(q.To)
+	return q
+}
+
+// EncodeQuad encodes a path segment belonging to contour into d.
+func EncodeQuad(d []byte, contour uint32, q Quad) {
+	bo := binary.LittleEndian
+	bo.PutUint32(d[0:], contour)
+	bo.PutUint32(d[4:], math.Float32bits(q.From.X))
+	bo.PutUint32(d[8:], math.Float32bits(q.From.Y))
+	bo.PutUint32(d[12:], math.Float32bits(q.Ctrl.X))
+	bo.PutUint32(d[16:], math.Float32bits(q.Ctrl.Y))
+	bo.PutUint32(d[20:], math.Float32bits(q.To.X))
+	bo.PutUint32(d[24:], math.Float32bits(q.To.Y))
+}
+
+// DecodeQuad decodes a path segment encoded by EncodeQuad.
+func DecodeQuad(d []byte) (contour uint32, q Quad) {
+	bo := binary.LittleEndian
+	contour = bo.Uint32(d[0:])
+	q.From.X = math.Float32frombits(bo.Uint32(d[4:]))
+	q.From.Y = math.Float32frombits(bo.Uint32(d[8:]))
+	q.Ctrl.X = math.Float32frombits(bo.Uint32(d[12:]))
+	q.Ctrl.Y = math.Float32frombits(bo.Uint32(d[16:]))
+	q.To.X = math.Float32frombits(bo.Uint32(d[20:]))
+	q.To.Y = math.Float32frombits(bo.Uint32(d[24:]))
+	return
 }
@@ -5,6 +5,7 @@ package ops
 import (
 	"encoding/binary"
 
+	"gioui.org/f32"
 	"gioui.org/internal/opconst"
 	"gioui.org/op"
 )
@@ -29,6 +30,10 @@ type Key struct {
 	ops     *op.Ops
 	pc      int
 	version int
+	// sx, hx, hy, sy are the linear part of the transformation
+	// an op is used with, for ops whose derived data depend
+	// on it.
+	sx, hx, hy, sy float32
 }
 
 // Shadow of op.MacroOp.
@@ -52,6 +57,15 @@ type opMacroDef struct {
 	endpc pc
 }
 
+// SetTransform returns a copy of k that is only equal to keys
+// with the same linear transformation part of t. Translations
+// are ignored.
+func (k Key) SetTransform(t f32.Affine2D) Key {
+	sx, hx, _, hy, sy, _ := t.Elems()
+	k.sx, k.hx, k.hy, k.sy = sx, hx, hy, sy
+	return k
+}
+
 // Reset start reading from the op list.
 func (r *Reader) Reset(ops *op.Ops) {
 	r.stack = r.stack[:0]
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+// Package clipboard implements operations for reading and writing
+// the system clipboard.
+package clipboard
+
+import (
+	"gioui.org/internal/opconst"
+	"gioui.org/io/event"
+	"gioui.org/op"
+)
+
+// ReadOp requests the text content of the clipboard, delivered
+// to the handler Tag as an Event.
+type ReadOp struct {
+	Tag event.Tag
+}
+
+// WriteOp replaces the content of the clipboard with Text.
+type WriteOp struct {
+	Text string
+}
+
+// Event contains the text content of the clipboard.
+type Event struct {
+	Text string
+}
+
+func (h ReadOp) Add(o *op.Ops) {
+	data := o.Write(opconst.TypeClipboardReadLen, h.Tag)
+	data[0] = byte(opconst.TypeClipboardRead)
+}
+
+func (h WriteOp) Add(o *op.Ops) {
+	data := o.Write(opconst.TypeClipboardWriteLen, &h.Text)
+	data[0] = byte(opconst.TypeClipboardWrite)
+}
+
+func (Event) ImplementsEvent() {}
@@ -21,9 +21,21 @@ import (
 // Key events are in general only delivered to the
 // focused key handler. Set the Focus flag to request
 // the focus.
+//
+// The Tab and Shift-Tab keys move the focus between
+// handlers in the order their InputOps appear in the
+// operation list. Set NoTab to leave a handler out; a
+// focused NoTab handler receives the Tab key events
+// itself.
+//
+// Without focus requests, the earliest handler has the
+// focus by default. Set NoDefaultFocus for handlers that
+// gain focus only from Tab or a request.
 type InputOp struct {
-	Tag   event.Tag
-	Focus bool
+	Tag            event.Tag
+	Focus          bool
+	NoTab          bool
+	NoDefaultFocus bool
 }
 
 // HideInputOp request that any on screen text input
@@ -88,6 +100,7 @@ const (
 	NamePageUp         = "⇞"
 	NamePageDown       = "⇟"
 	NameTab            = "⇥"
+	NameSpace          = "Space"
 )
 
 // Contain reports whether m contains all modifiers
@@ -102,6 +115,12 @@ func (h InputOp) Add(o *op.Ops) {
 	if h.Focus {
 		data[1] = 1
 	}
+	if h.NoTab {
+		data[2] = 1
+	}
+	if h.NoDefaultFocus {
+		data[3] = 1
+	}
 }
 
 func (h HideInputOp) Add(o *op.Ops) {
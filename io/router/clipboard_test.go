@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package router
+
+import (
+	"testing"
+
+	"gioui.org/io/clipboard"
+	"gioui.org/io/system"
+	"gioui.org/op"
+)
+
+func TestClipboardRead(t *testing.T) {
+	var ops op.Ops
+	var tag int
+	clipboard.ReadOp{Tag: &tag}.Add(&ops)
+
+	var r Router
+	r.Frame(&ops)
+	if !r.ReadClipboard() {
+		t.Fatal("clipboard read not requested")
+	}
+	// Repeated requests are coalesced.
+	r.Frame(&ops)
+	if r.ReadClipboard() {
+		t.Error("clipboard read requested twice")
+	}
+	r.Add(system.ClipboardEvent{Text: "Gio"})
+	evts := r.Events(&tag)
+	if len(evts) != 1 || evts[0] != (clipboard.Event{Text: "Gio"}) {
+		t.Errorf("got events %v, expected clipboard content", evts)
+	}
+	// The content is delivered only once.
+	r.Add(system.ClipboardEvent{Text: "Gio"})
+	if evts := r.Events(&tag); len(evts) != 0 {
+		t.Errorf("got events %v after delivery", evts)
+	}
+}
+
+func TestClipboardWrite(t *testing.T) {
+	var ops op.Ops
+	clipboard.WriteOp{Text: "first"}.Add(&ops)
+	clipboard.WriteOp{Text: "second"}.Add(&ops)
+
+	var r Router
+	r.Frame(&ops)
+	if text, ok := r.WriteClipboard(); !ok || text != "second" {
+		t.Errorf("got clipboard write (%q, %v), expected (%q, true)", text, ok, "second")
+	}
+	if _, ok := r.WriteClipboard(); ok {
+		t.Error("clipboard written twice")
+	}
+}
@@ -12,15 +12,24 @@ import (
 
 type TextInputState uint8
 
+// FocusDirection is the direction of a focus move.
+type FocusDirection uint8
+
 type keyQueue struct {
 	focus    event.Tag
 	handlers map[event.Tag]*keyHandler
-	reader   ops.Reader
-	state    TextInputState
+	// order contains the tab-focusable handlers in
+	// operation list order.
+	order  []event.Tag
+	reader ops.Reader
+	state  TextInputState
 }
 
 type keyHandler struct {
 	active bool
+	// noTab is set for handlers that receive Tab key
+	// events instead of the focus moving away from them.
+	noTab bool
 }
 
 type listenerPriority uint8
@@ -38,6 +47,13 @@ const (
 	TextInputOpen
 )
 
+const (
+	// FocusNext moves the focus to the next handler.
+	FocusNext FocusDirection = iota
+	// FocusPrevious moves the focus to the previous handler.
+	FocusPrevious
+)
+
 // InputState returns the last text input state as
 // determined in Frame.
 func (q *keyQueue) InputState() TextInputState {
@@ -51,6 +67,7 @@ func (q *keyQueue) Frame(root *op.Ops, events *handlerEvents) {
 	for _, h := range q.handlers {
 		h.active = false
 	}
+	q.order = q.order[:0]
 	q.reader.Reset(root)
 	focus, pri, hide := q.resolveFocus(events)
 	for k, h := range q.handlers {
@@ -63,13 +80,8 @@ func (q *keyQueue) Frame(root *op.Ops, events *handlerEvents) {
 		}
 	}
 	if focus != q.focus {
-		if q.focus != nil {
-			events.Add(q.focus, key.FocusEvent{Focus: false})
-		}
-		q.focus = focus
-		if q.focus != nil {
-			events.Add(q.focus, key.FocusEvent{Focus: true})
-		} else {
+		q.setFocus(focus, events)
+		if focus == nil {
 			hide = true
 		}
 	}
@@ -84,11 +96,68 @@ func (q *keyQueue) Frame(root *op.Ops, events *handlerEvents) {
 }
 
 func (q *keyQueue) Push(e event.Event, events *handlerEvents) {
+	if e, ok := e.(key.Event); ok && e.Name == key.NameTab && !q.focusNoTab() {
+		dir := FocusNext
+		if e.Modifiers == key.ModShift {
+			dir = FocusPrevious
+		}
+		if e.Modifiers&^key.ModShift == 0 && q.MoveFocus(dir, events) {
+			return
+		}
+	}
 	if q.focus != nil {
 		events.Add(q.focus, e)
 	}
 }
 
+// focusNoTab reports whether the focused handler receives Tab
+// key events.
+func (q *keyQueue) focusNoTab() bool {
+	h, ok := q.handlers[q.focus]
+	return ok && h.noTab
+}
+
+// MoveFocus moves the focus to the next or previous tab-focusable
+// handler, wrapping around at the ends. It reports whether the focus
+// changed.
+func (q *keyQueue) MoveFocus(dir FocusDirection, events *handlerEvents) bool {
+	n := len(q.order)
+	if n == 0 {
+		return false
+	}
+	idx := -1
+	for i, k := range q.order {
+		if k == q.focus {
+			idx = i
+			break
+		}
+	}
+	switch {
+	case dir == FocusNext:
+		idx = (idx + 1) % n
+	case idx == -1:
+		idx = n - 1
+	default:
+		idx = (idx - 1 + n) % n
+	}
+	focus := q.order[idx]
+	if focus == q.focus {
+		return false
+	}
+	q.setFocus(focus, events)
+	return true
+}
+
+func (q *keyQueue) setFocus(focus event.Tag, events *handlerEvents) {
+	if q.focus != nil {
+		events.Add(q.focus, key.FocusEvent{Focus: false})
+	}
+	q.focus = focus
+	if q.focus != nil {
+		events.Add(q.focus, key.FocusEvent{Focus: true})
+	}
+}
+
 func (q *keyQueue) resolveFocus(events *handlerEvents) (event.Tag, listenerPriority, bool) {
 	var k event.Tag
 	var pri listenerPriority
@@ -104,6 +173,8 @@ loop:
 				newPri = priNewFocus
 			case op.Tag == q.focus:
 				newPri = priCurrentFocus
+			case op.NoDefaultFocus:
+				newPri = priNone
 			default:
 				newPri = priDefault
 			}
@@ -118,6 +189,12 @@ loop:
 				// Reset the handler on (each) first appearance.
 				events.Set(op.Tag, []event.Event{key.FocusEvent{Focus: false}})
 			}
+			if !h.active {
+				h.noTab = op.NoTab
+				if !op.NoTab {
+					q.order = append(q.order, op.Tag)
+				}
+			}
 			h.active = true
 		case opconst.TypeHideInput:
 			hide = true
@@ -144,7 +221,9 @@ func decodeKeyInputOp(d []byte, refs []interface{}) key.InputOp {
 		panic("invalid op")
 	}
 	return key.InputOp{
-		Tag:   refs[0].(event.Tag),
-		Focus: d[1] != 0,
+		Tag:            refs[0].(event.Tag),
+		Focus:          d[1] != 0,
+		NoTab:          d[2] != 0,
+		NoDefaultFocus: d[3] != 0,
 	}
 }
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package router
+
+import (
+	"testing"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/op"
+)
+
+func TestKeyTabFocus(t *testing.T) {
+	handlers := []int{0, 1, 2, 3}
+	var ops op.Ops
+	key.InputOp{Tag: &handlers[0]}.Add(&ops)
+	stack := op.Push(&ops)
+	key.InputOp{Tag: &handlers[1]}.Add(&ops)
+	key.InputOp{Tag: &handlers[2], NoTab: true}.Add(&ops)
+	stack.Pop()
+	key.InputOp{Tag: &handlers[3]}.Add(&ops)
+
+	var r Router
+	r.Frame(&ops)
+	// The first handler has the focus by default.
+	assertFocus(t, &r, &handlers[0])
+
+	tab := key.Event{Name: key.NameTab}
+	backtab := key.Event{Name: key.NameTab, Modifiers: key.ModShift}
+	r.Add(tab)
+	assertFocus(t, &r, &handlers[1])
+	// Skip the NoTab handler.
+	r.Add(tab)
+	assertFocus(t, &r, &handlers[3])
+	// Wrap around.
+	r.Add(tab)
+	assertFocus(t, &r, &handlers[0])
+	r.Add(backtab)
+	assertFocus(t, &r, &handlers[3])
+
+	// Tab keys with other modifiers are delivered to the focused handler.
+	r.Events(&handlers[3])
+	r.Add(key.Event{Name: key.NameTab, Modifiers: key.ModCtrl})
+	if evts := r.Events(&handlers[3]); len(evts) != 1 {
+		t.Errorf("got %d events for ctrl-tab, expected 1", len(evts))
+	}
+
+	// The focus survives frames.
+	r.Frame(&ops)
+	if !r.MoveFocus(FocusPrevious) {
+		t.Error("MoveFocus didn't move the focus")
+	}
+	assertFocus(t, &r, &handlers[1])
+}
+
+func TestKeyTabNoTabFocus(t *testing.T) {
+	handlers := []int{0, 1}
+	var ops op.Ops
+	key.InputOp{Tag: &handlers[0]}.Add(&ops)
+	key.InputOp{Tag: &handlers[1], NoTab: true, Focus: true}.Add(&ops)
+
+	var r Router
+	r.Frame(&ops)
+	assertFocus(t, &r, &handlers[1])
+	r.Events(&handlers[0])
+	// A focused NoTab handler receives Tab and Shift-Tab.
+	tab := key.Event{Name: key.NameTab}
+	backtab := key.Event{Name: key.NameTab, Modifiers: key.ModShift}
+	r.Add(tab)
+	r.Add(backtab)
+	if evts := r.Events(&handlers[1]); len(evts) != 2 || evts[0] != tab || evts[1] != backtab {
+		t.Errorf("got events %v, expected tab and shift-tab", evts)
+	}
+	if evts := r.Events(&handlers[0]); len(evts) != 0 {
+		t.Errorf("got events %v for an unfocused handler", evts)
+	}
+	// The focus can still be moved explicitly.
+	if !r.MoveFocus(FocusNext) {
+		t.Error("MoveFocus didn't move the focus")
+	}
+	assertFocus(t, &r, &handlers[0])
+}
+
+func TestKeyTabFocusNoHandlers(t *testing.T) {
+	var ops op.Ops
+	var tag int
+	key.InputOp{Tag: &tag, NoTab: true}.Add(&ops)
+	var r Router
+	r.Frame(&ops)
+	r.Events(&tag)
+	if r.MoveFocus(FocusNext) {
+		t.Error("MoveFocus moved the focus without tab-focusable handlers")
+	}
+	// Tab is delivered to the focused handler.
+	r.Add(key.Event{Name: key.NameTab})
+	if evts := r.Events(&tag); len(evts) != 1 {
+		t.Errorf("got %d events for tab, expected 1", len(evts))
+	}
+}
+
+// assertFocus checks that the most recent event for the handler
+// with tag is a gain of focus.
+func assertFocus(t *testing.T, r *Router, tag event.Tag) {
+	t.Helper()
+	evts := r.Events(tag)
+	if n := len(evts); n == 0 || evts[n-1] != (key.FocusEvent{Focus: true}) {
+		t.Errorf("got events %v for %v, expected focus", evts, tag)
+	}
+}
+
+func TestKeyNoDefaultFocus(t *testing.T) {
+	handlers := []int{0, 1}
+	var ops op.Ops
+	key.InputOp{Tag: &handlers[0], NoDefaultFocus: true}.Add(&ops)
+	key.InputOp{Tag: &handlers[1]}.Add(&ops)
+	var r Router
+	r.Frame(&ops)
+	// The first handler without NoDefaultFocus has the focus.
+	assertFocus(t, &r, &handlers[1])
+	// Tab still focuses the handler.
+	r.Add(key.Event{Name: key.NameTab})
+	assertFocus(t, &r, &handlers[0])
+	// The focus survives frames.
+	r.Frame(&ops)
+	r.Add(key.Event{Name: "A"})
+	if evts := r.Events(&handlers[0]); len(evts) != 1 || evts[0] != (key.Event{Name: "A"}) {
+		t.Errorf("got events %v, expected the key event", evts)
+	}
+}
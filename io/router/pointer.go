@@ -44,7 +44,7 @@ type pointerInfo struct {
 type pointerHandler struct {
 	area      int
 	active    bool
-	transform op.TransformOp
+	transform f32.Affine2D
 	wantsGrab bool
 }
 
@@ -54,7 +54,7 @@ type areaOp struct {
 }
 
 type areaNode struct {
-	trans op.TransformOp
+	trans f32.Affine2D
 	next  int
 	area  areaOp
 }
@@ -66,7 +66,7 @@ const (
 	areaEllipse
 )
 
-func (q *pointerQueue) collectHandlers(r *ops.Reader, events *handlerEvents, t op.TransformOp, area, node int, pass bool) {
+func (q *pointerQueue) collectHandlers(r *ops.Reader, events *handlerEvents, t f32.Affine2D, area, node int, pass bool) {
 	for encOp, ok := r.Decode(); ok; encOp, ok = r.Decode() {
 		switch opconst.OpType(encOp.Data[0]) {
 		case opconst.TypePush:
@@ -89,7 +89,7 @@ func (q *pointerQueue) collectHandlers(r *ops.Reader, events *handlerEvents, t o
 			node = len(q.hitTree) - 1
 		case opconst.TypeTransform:
 			dop := ops.DecodeTransformOp(encOp.Data)
-			t = t.Multiply(op.TransformOp(dop))
+			t = t.Mul(dop)
 		case opconst.TypePointerInput:
 			op := decodePointerInputOp(encOp.Data, encOp.Refs)
 			q.hitTree = append(q.hitTree, hitNode{
@@ -168,7 +168,7 @@ func (q *pointerQueue) Frame(root *op.Ops, events *handlerEvents) {
 	q.hitTree = q.hitTree[:0]
 	q.areas = q.areas[:0]
 	q.reader.Reset(root)
-	q.collectHandlers(&q.reader, events, op.TransformOp{}, -1, -1, false)
+	q.collectHandlers(&q.reader, events, f32.Affine2D{}, -1, -1, false)
 	for k, h := range q.handlers {
 		if !h.active {
 			q.dropHandlers(events, k)
@@ -262,7 +262,7 @@ func (q *pointerQueue) deliverEvent(p *pointerInfo, events *handlerEvents, e poi
 		if p.pressed && len(p.handlers) == 1 {
 			e.Priority = pointer.Grabbed
 		}
-		e.Position = h.transform.Invert().Transform(e.Position)
+		e.Position, e.Scroll = h.localCoords(e.Position, e.Scroll)
 
 		events.Add(k, e)
 	}
@@ -287,7 +287,7 @@ func (q *pointerQueue) deliverEnterLeaveEvents(p *pointerInfo, events *handlerEv
 			}
 		}
 
-		e.Position = h.transform.Invert().Transform(e.Position)
+		e.Position, e.Scroll = h.localCoords(e.Position, e.Scroll)
 
 		switch {
 		case !hit && entered != -1:
@@ -302,6 +302,16 @@ func (q *pointerQueue) deliverEnterLeaveEvents(p *pointerInfo, events *handlerEv
 	}
 }
 
+// localCoords maps a position and a scroll distance to the
+// coordinate space of the handler.
+func (h *pointerHandler) localCoords(pos, scroll f32.Point) (f32.Point, f32.Point) {
+	inv := h.transform.Invert()
+	pos = inv.Transform(pos)
+	// Scroll distances are vectors and not affected by offsets.
+	scroll = inv.Transform(scroll).Sub(inv.Transform(f32.Point{}))
+	return pos, scroll
+}
+
 func (op *areaOp) Decode(d []byte) {
 	if opconst.OpType(d[0]) != opconst.TypeArea {
 		panic("invalid op")
@@ -5,6 +5,7 @@ package router
 import (
 	"fmt"
 	"image"
+	"math"
 	"reflect"
 	"testing"
 
@@ -382,6 +383,81 @@ func TestMultitouch(t *testing.T) {
 	assertEventSequence(t, r.Events(h2), pointer.Cancel, pointer.Enter, pointer.Press, pointer.Release)
 }
 
+func TestPointerTransform(t *testing.T) {
+	var ops op.Ops
+
+	// Rotate the area (0, 0) - (100, 50) by 90 degrees around the
+	// origin and scale it by 2, mapping it to (-100, 0) - (0, 200).
+	h := new(int)
+	a := f32.Affine2D{}.Scale(f32.Point{}, f32.Pt(2, 2)).Rotate(f32.Point{}, math.Pi/2)
+	op.Affine(a).Add(&ops)
+	addPointerHandler(&ops, h, image.Rect(0, 0, 100, 50))
+
+	var r Router
+	r.Frame(&ops)
+	r.Add(
+		// Hit.
+		pointer.Event{
+			Type:     pointer.Press,
+			Position: f32.Pt(-20, 100),
+			Scroll:   f32.Pt(0, 10),
+		},
+		// Miss, the untransformed area.
+		pointer.Event{
+			Type:      pointer.Press,
+			Position:  f32.Pt(20, 20),
+			PointerID: 1,
+		},
+	)
+	var presses []pointer.Event
+	for _, e := range r.Events(h) {
+		if e, ok := e.(pointer.Event); ok && e.Type == pointer.Press {
+			presses = append(presses, e)
+		}
+	}
+	if len(presses) != 1 {
+		t.Fatalf("got %d press events, expected 1", len(presses))
+	}
+	e := presses[0]
+	if !ptEq(e.Position, f32.Pt(50, 10)) {
+		t.Errorf("got position %v, expected (50, 10)", e.Position)
+	}
+	if !ptEq(e.Scroll, f32.Pt(5, 0)) {
+		t.Errorf("got scroll %v, expected (5, 0)", e.Scroll)
+	}
+}
+
+func ptEq(p1, p2 f32.Point) bool {
+	d := p1.Sub(p2)
+	return math.Abs(float64(d.X)) < 1e-4 && math.Abs(float64(d.Y)) < 1e-4
+}
+
+func TestPointerTags(t *testing.T) {
+	handler1 := new(int)
+	handler2 := new(int)
+	var ops op.Ops
+	addPointerHandler(&ops, handler1, image.Rect(0, 0, 100, 100))
+	addPointerHandler(&ops, handler2, image.Rect(50, 50, 200, 200))
+
+	var r Router
+	r.Frame(&ops)
+	tests := []struct {
+		pos  f32.Point
+		tags []event.Tag
+	}{
+		{f32.Point{X: 25, Y: 25}, []event.Tag{handler1}},
+		// handler2 occludes handler1.
+		{f32.Point{X: 75, Y: 75}, []event.Tag{handler2}},
+		{f32.Point{X: 150, Y: 150}, []event.Tag{handler2}},
+		{f32.Point{X: 250, Y: 250}, nil},
+	}
+	for _, test := range tests {
+		if got := r.Tags(test.pos); !reflect.DeepEqual(got, test.tags) {
+			t.Errorf("%v: got tags %v, expected %v", test.pos, got, test.tags)
+		}
+	}
+}
+
 // addPointerHandler adds a pointer.InputOp for the tag in a
 // rectangular area.
 func addPointerHandler(ops *op.Ops, tag event.Tag, area image.Rectangle) {
@@ -14,12 +14,15 @@ import (
 	"encoding/binary"
 	"time"
 
+	"gioui.org/f32"
 	"gioui.org/internal/opconst"
 	"gioui.org/internal/ops"
+	"gioui.org/io/clipboard"
 	"gioui.org/io/event"
 	"gioui.org/io/key"
 	"gioui.org/io/pointer"
 	"gioui.org/io/profile"
+	"gioui.org/io/system"
 	"gioui.org/op"
 )
 
@@ -28,6 +31,7 @@ import (
 type Router struct {
 	pqueue pointerQueue
 	kqueue keyQueue
+	cqueue clipboardQueue
 
 	handlers handlerEvents
 
@@ -88,11 +92,44 @@ func (q *Router) Add(events ...event.Event) bool {
 			q.pqueue.Push(e, &q.handlers)
 		case key.EditEvent, key.Event, key.FocusEvent:
 			q.kqueue.Push(e, &q.handlers)
+		case system.ClipboardEvent:
+			q.cqueue.Push(clipboard.Event{Text: e.Text}, &q.handlers)
 		}
 	}
 	return q.handlers.HadEvents()
 }
 
+// Tags returns the tags of the pointer handlers hit by a pointer
+// at pos, in the order they would receive a pointer event. The
+// handlers are those declared in the most recent call to Frame.
+func (q *Router) Tags(pos f32.Point) []event.Tag {
+	var tags []event.Tag
+	q.pqueue.opHit(&tags, pos)
+	return tags
+}
+
+// MoveFocus moves the keyboard focus to the next or previous key
+// handler in the order of the most recent call to Frame, as if by
+// pressing Tab or Shift-Tab. Handlers declared with key.InputOp.NoTab
+// are skipped. MoveFocus reports whether the focus changed.
+func (q *Router) MoveFocus(dir FocusDirection) bool {
+	return q.kqueue.MoveFocus(dir, &q.handlers)
+}
+
+// ReadClipboard reports whether a handler has requested the
+// clipboard content since the last call to ReadClipboard. The
+// content is delivered to the handlers when a system.ClipboardEvent
+// is added to the router.
+func (q *Router) ReadClipboard() bool {
+	return q.cqueue.ReadClipboard()
+}
+
+// WriteClipboard returns the most recent text to be copied to the
+// clipboard, if any.
+func (q *Router) WriteClipboard() (string, bool) {
+	return q.cqueue.WriteClipboard()
+}
+
 // TextInputState returns the input state from the most recent
 // call to Frame.
 func (q *Router) TextInputState() TextInputState {
@@ -115,6 +152,10 @@ func (q *Router) collect() {
 			}
 			q.profiling = true
 			q.profHandlers[op.Tag] = struct{}{}
+		case opconst.TypeClipboardRead:
+			q.cqueue.ProcessReadClipboard(encOp.Data, encOp.Refs)
+		case opconst.TypeClipboardWrite:
+			q.cqueue.ProcessWriteClipboard(encOp.Data, encOp.Refs)
 		}
 	}
 }
@@ -9,14 +9,14 @@ import (
 
 	"gioui.org/f32"
 	"gioui.org/internal/opconst"
-	"gioui.org/internal/path"
+	"gioui.org/internal/ops"
 	"gioui.org/op"
 )
 
 // Path constructs a Op clip path described by lines and
 // Bézier curves, where drawing outside the Path is discarded.
-// The inside-ness of a pixel is determines by the even-odd rule,
-// similar to the SVG rule of the same name.
+// The inside-ness of a pixel is determines by the non-zero winding
+// rule, similar to the SVG rule of the same name.
 //
 // Path generates no garbage and can be used for dynamic paths; path
 // data is stored directly in the Ops list supplied to Begin.
@@ -54,17 +54,23 @@ func (p Op) Add(o *op.Ops) {
 func (p *Path) Begin(ops *op.Ops) {
 	p.ops = ops
 	p.macro = op.Record(ops)
-	// Write the TypeAux opcode and a byte for marking whether the
-	// path has had its MaxY filled out. If not, the gpu will fill it
-	// before using it.
-	data := ops.Write(2)
+	// Write the TypeAux opcode. The path segments follow and are
+	// encoded for the GPU when the current transformation is known.
+	data := ops.Write(opconst.TypeAuxLen)
 	data[0] = byte(opconst.TypeAux)
 }
 
 // MoveTo moves the pen to the given position.
 func (p *Path) Move(to f32.Point) {
-	p.end()
 	to = to.Add(p.pen)
+	p.moveTo(to)
+}
+
+// Pos returns the current pen position.
+func (p *Path) Pos() f32.Point { return p.pen }
+
+func (p *Path) moveTo(to f32.Point) {
+	p.end()
 	p.pen = to
 }
 
@@ -93,44 +99,25 @@ func (p *Path) Quad(ctrl, to f32.Point) {
 }
 
 func (p *Path) quadTo(ctrl, to f32.Point) {
-	// Zero width curves don't contribute to stenciling.
-	if p.pen.X == to.X && p.pen.X == ctrl.X {
-		p.pen = to
-		return
-	}
-
 	bounds := f32.Rectangle{
 		Min: p.pen,
 		Max: to,
 	}.Canon()
 
-	// If the curve contain areas where a vertical line
-	// intersects it twice, split the curve in two x monotone
-	// lower and upper curves. The stencil fragment program
-	// expects only one intersection per curve.
-
-	// Find the t where the derivative in x is 0.
+	// Find the x and y extrema, if any.
 	v0 := ctrl.Sub(p.pen)
 	v1 := to.Sub(ctrl)
 	d := v0.X - v1.X
-	// t = v0 / d. Split if t is in ]0;1[.
 	if v0.X > 0 && d > v0.X || v0.X < 0 && d < v0.X {
 		t := v0.X / d
-		ctrl0 := p.pen.Mul(1 - t).Add(ctrl.Mul(t))
-		ctrl1 := ctrl.Mul(1 - t).Add(to.Mul(t))
-		mid := ctrl0.Mul(1 - t).Add(ctrl1.Mul(t))
-		p.simpleQuadTo(ctrl0, mid)
-		p.simpleQuadTo(ctrl1, to)
-		if mid.X > bounds.Max.X {
-			bounds.Max.X = mid.X
+		x := (1-t)*(1-t)*p.pen.X + 2*(1-t)*t*ctrl.X + t*t*to.X
+		if x > bounds.Max.X {
+			bounds.Max.X = x
 		}
-		if mid.X < bounds.Min.X {
-			bounds.Min.X = mid.X
+		if x < bounds.Min.X {
+			bounds.Min.X = x
 		}
-	} else {
-		p.simpleQuadTo(ctrl, to)
 	}
-	// Find the y extremum, if any.
 	d = v0.Y - v1.Y
 	if v0.Y > 0 && d > v0.Y || v0.Y < 0 && d < v0.Y {
 		t := v0.Y / d
@@ -143,6 +130,14 @@ func (p *Path) quadTo(ctrl, to f32.Point) {
 		}
 	}
 	p.expand(bounds)
+
+	data := p.ops.Write(ops.QuadSize)
+	ops.EncodeQuad(data, uint32(p.contour), ops.Quad{
+		From: p.pen,
+		Ctrl: ctrl,
+		To:   to,
+	})
+	p.pen = to
 }
 
 // Cube records a cubic Bézier from the pen through
@@ -161,12 +156,18 @@ func (p *Path) Cube(ctrl0, ctrl1, to f32.Point) {
 	if h := hull.Dy(); h > l {
 		l = h
 	}
-	p.approxCubeTo(0, l*0.001, ctrl0, ctrl1, to)
+	approxCubeTo(p, 0, l*0.001, p.pen, ctrl0, ctrl1, to)
 }
 
-// approxCube approximates a cubic Bézier by a series of quadratic
-// curves.
-func (p *Path) approxCubeTo(splits int, maxDist float32, ctrl0, ctrl1, to f32.Point) int {
+// quadBuilder is the interface for Path and Stroke used
+// by approxCubeTo.
+type quadBuilder interface {
+	quadTo(ctrl, to f32.Point)
+}
+
+// approxCube approximates a cubic Bézier from pen by a series of
+// quadratic curves.
+func approxCubeTo(p quadBuilder, splits int, maxDist float32, pen, ctrl0, ctrl1, to f32.Point) int {
 	// The idea is from
 	// https://caffeineowl.com/graphics/2d/vectorial/cubic2quad01.html
 	// where a quadratic approximates a cubic by eliminating its t³ term
@@ -191,7 +192,7 @@ func (p *Path) approxCubeTo(splits int, maxDist float32, ctrl0, ctrl1, to f32.Po
 	// and use the midpoint between the two curves Q1 and Q2 as control point:
 	//
 	// C = (3ctrl0 - pen + 3ctrl1 - to)/4
-	c := ctrl0.Mul(3).Sub(p.pen).Add(ctrl1.Mul(3)).Sub(to).Mul(1.0 / 4.0)
+	c := ctrl0.Mul(3).Sub(pen).Add(ctrl1.Mul(3)).Sub(to).Mul(1.0 / 4.0)
 	const maxSplits = 32
 	if splits >= maxSplits {
 		p.quadTo(c, to)
@@ -203,7 +204,7 @@ func (p *Path) approxCubeTo(splits int, maxDist float32, ctrl0, ctrl1, to f32.Po
 	// d = sqrt(3)/36*|to - 3ctrl1 + 3ctrl0 - pen|
 	//
 	// To save a square root, compare d² with the squared tolerance.
-	v := to.Sub(ctrl1.Mul(3)).Add(ctrl0.Mul(3)).Sub(p.pen)
+	v := to.Sub(ctrl1.Mul(3)).Add(ctrl0.Mul(3)).Sub(pen)
 	d2 := (v.X*v.X + v.Y*v.Y) * 3 / (36 * 36)
 	if d2 <= maxDist*maxDist {
 		p.quadTo(c, to)
@@ -211,15 +212,15 @@ func (p *Path) approxCubeTo(splits int, maxDist float32, ctrl0, ctrl1, to f32.Po
 	}
 	// De Casteljau split the curve and approximate the halves.
 	t := float32(0.5)
-	c0 := p.pen.Add(ctrl0.Sub(p.pen).Mul(t))
+	c0 := pen.Add(ctrl0.Sub(pen).Mul(t))
 	c1 := ctrl0.Add(ctrl1.Sub(ctrl0).Mul(t))
 	c2 := ctrl1.Add(to.Sub(ctrl1).Mul(t))
 	c01 := c0.Add(c1.Sub(c0).Mul(t))
 	c12 := c1.Add(c2.Sub(c1).Mul(t))
 	c0112 := c01.Add(c12.Sub(c01).Mul(t))
 	splits++
-	splits = p.approxCubeTo(splits, maxDist, c0, c01, c0112)
-	splits = p.approxCubeTo(splits, maxDist, c12, c2, to)
+	splits = approxCubeTo(p, splits, maxDist, pen, c0, c01, c0112)
+	splits = approxCubeTo(p, splits, maxDist, c0112, c12, c2, to)
 	return splits
 }
 
@@ -235,49 +236,6 @@ func (p *Path) expand(b f32.Rectangle) {
 	p.bounds = p.bounds.Union(b)
 }
 
-func (p *Path) vertex(cornerx, cornery int16, ctrl, to f32.Point) {
-	var corner float32
-	// Encode corner.
-	if cornerx == 1 {
-		corner += .5
-	}
-	if cornery == 1 {
-		corner += .25
-	}
-	v := path.Vertex{
-		Corner: corner,
-		FromX:  p.pen.X,
-		FromY:  p.pen.Y,
-		CtrlX:  ctrl.X,
-		CtrlY:  ctrl.Y,
-		ToX:    to.X,
-		ToY:    to.Y,
-	}
-	data := p.ops.Write(path.VertStride)
-	bo := binary.LittleEndian
-	bo.PutUint32(data[0:], math.Float32bits(corner))
-	// Put the contour index in MaxY.
-	bo.PutUint32(data[4:], uint32(p.contour))
-	bo.PutUint32(data[8:], math.Float32bits(v.FromX))
-	bo.PutUint32(data[12:], math.Float32bits(v.FromY))
-	bo.PutUint32(data[16:], math.Float32bits(v.CtrlX))
-	bo.PutUint32(data[20:], math.Float32bits(v.CtrlY))
-	bo.PutUint32(data[24:], math.Float32bits(v.ToX))
-	bo.PutUint32(data[28:], math.Float32bits(v.ToY))
-}
-
-func (p *Path) simpleQuadTo(ctrl, to f32.Point) {
-	// NW.
-	p.vertex(-1, 1, ctrl, to)
-	// NE.
-	p.vertex(1, 1, ctrl, to)
-	// SW.
-	p.vertex(-1, -1, ctrl, to)
-	// SE.
-	p.vertex(1, -1, ctrl, to)
-	p.pen = to
-}
-
 // End the path and return a clip operation that represents it.
 func (p *Path) End() Op {
 	p.end()
@@ -9,8 +9,9 @@ to the intersection of the current clip and the clip area it
 represents. If you need to reset the current clip to its value
 before applying an Op, use op.StackOp.
 
-General clipping areas are constructed with Path. Simpler special
-cases such as rectangular clip areas also exist as convenient
-constructors.
+General clipping areas are constructed with Path. Stroke constructs
+the outline of stroked lines and curves, with configurable width,
+joins, caps and dashes. Simpler special cases such as rectangular
+clip areas also exist as convenient constructors.
 */
 package clip
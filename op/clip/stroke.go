@@ -0,0 +1,593 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package clip
+
+import (
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/internal/ops"
+	"gioui.org/op"
+)
+
+// Stroke constructs a Op clip path from the outline of lines and
+// Bézier curves stroked according to Style.
+//
+// Stroke retains its internal buffers and generates no garbage
+// when reused.
+type Stroke struct {
+	Style StrokeStyle
+
+	path  Path
+	pen   f32.Point
+	start f32.Point
+	// contour is the current contour.
+	contour []ops.Quad
+	// dash and firstDash are used for splitting contours
+	// into dashes.
+	dash      []ops.Quad
+	firstDash []ops.Quad
+}
+
+// StrokeStyle describes how a path is stroked.
+type StrokeStyle struct {
+	// Width of the stroke.
+	Width float32
+	// Cap describes the shape of the ends of open contours
+	// and dashes.
+	Cap StrokeCap
+	// Join describes the shape of the corners between
+	// segments.
+	Join StrokeJoin
+	// Miter is the limit of the ratio between the length of a
+	// MiterJoin and the stroke width. Corners exceeding the limit
+	// are beveled. A zero Miter means a limit of 4.
+	Miter float32
+	// Dashes is the dash pattern, the alternating lengths of
+	// dashes and gaps. An odd number of lengths is repeated to
+	// yield an even number. An empty Dashes draws solid strokes.
+	Dashes []float32
+	// DashPhase is the distance into the dash pattern at
+	// the start of each contour.
+	DashPhase float32
+}
+
+// StrokeCap is the shape of the ends of a stroked
+// contour.
+type StrokeCap uint8
+
+// StrokeJoin is the shape of the corners of a stroked
+// contour.
+type StrokeJoin uint8
+
+const (
+	// ButtCap ends the stroke exactly at the end point.
+	ButtCap StrokeCap = iota
+	// RoundCap ends the stroke with a half circle.
+	RoundCap
+	// SquareCap extends the stroke by half its width.
+	SquareCap
+)
+
+const (
+	// MiterJoin extends the outer edges of the stroke
+	// until they meet.
+	MiterJoin StrokeJoin = iota
+	// RoundJoin joins the outer edges by a circular arc.
+	RoundJoin
+	// BevelJoin connects the outer edges by a straight
+	// line.
+	BevelJoin
+)
+
+// Begin the stroked path, storing the path data and final Op
+// into ops.
+func (s *Stroke) Begin(ops *op.Ops) {
+	s.path.Begin(ops)
+	s.pen = f32.Point{}
+	s.start = f32.Point{}
+	s.contour = s.contour[:0]
+}
+
+// Move moves the pen by the amount specified by delta, starting
+// a new contour.
+func (s *Stroke) Move(delta f32.Point) {
+	s.endContour(false)
+	s.pen = s.pen.Add(delta)
+	s.start = s.pen
+}
+
+// Line moves the pen by the amount specified by delta, recording
+// a line.
+func (s *Stroke) Line(delta f32.Point) {
+	to := delta.Add(s.pen)
+	s.quadTo(to.Add(s.pen).Mul(.5), to)
+}
+
+// Quad records a quadratic Bézier from the pen to end
+// with the control point ctrl.
+func (s *Stroke) Quad(ctrl, to f32.Point) {
+	s.quadTo(ctrl.Add(s.pen), to.Add(s.pen))
+}
+
+// Cube records a cubic Bézier from the pen through
+// two control points ending in to.
+func (s *Stroke) Cube(ctrl0, ctrl1, to f32.Point) {
+	ctrl0 = ctrl0.Add(s.pen)
+	ctrl1 = ctrl1.Add(s.pen)
+	to = to.Add(s.pen)
+	hull := f32.Rectangle{
+		Min: s.pen,
+		Max: ctrl0,
+	}.Canon().Add(ctrl1).Add(to)
+	l := hull.Dx()
+	if h := hull.Dy(); h > l {
+		l = h
+	}
+	approxCubeTo(s, 0, l*0.001, s.pen, ctrl0, ctrl1, to)
+}
+
+// Close records a line from the pen to the start of the current
+// contour and joins the two ends of the contour.
+func (s *Stroke) Close() {
+	if s.pen != s.start {
+		s.quadTo(s.pen.Add(s.start).Mul(.5), s.start)
+	}
+	s.endContour(true)
+}
+
+// End the stroked path and return a clip operation that
+// represents its outline.
+func (s *Stroke) End() Op {
+	s.endContour(false)
+	return s.path.End()
+}
+
+func (s *Stroke) quadTo(ctrl, to f32.Point) {
+	// Drop zero length segments; they have no direction.
+	if s.pen != to || s.pen != ctrl {
+		s.contour = append(s.contour, ops.Quad{From: s.pen, Ctrl: ctrl, To: to})
+	}
+	s.pen = to
+}
+
+func (s *Stroke) endContour(closed bool) {
+	contour := s.contour
+	s.contour = s.contour[:0]
+	if len(contour) == 0 || s.Style.Width <= 0 {
+		return
+	}
+	if len(s.Style.Dashes) > 0 {
+		s.dashContour(contour, closed)
+		return
+	}
+	if closed {
+		s.strokeClosed(contour)
+	} else {
+		s.strokeOpen(contour)
+	}
+}
+
+// strokeOpen records the outline of an open contour.
+func (s *Stroke) strokeOpen(contour []ops.Quad) {
+	hw := s.Style.Width / 2
+	first, last := contour[0], contour[len(contour)-1]
+	s.path.moveTo(first.From.Add(normal(startTangent(first), hw)))
+	s.offsetSide(contour)
+	s.capTo(last.To, endTangent(last))
+	for i := range contour {
+		q := reverseQuad(contour[len(contour)-1-i])
+		if i > 0 {
+			s.join(q.From, endTangent(reverseQuad(contour[len(contour)-i])), startTangent(q))
+		}
+		s.offsetQuad(q, hw, 0)
+	}
+	s.capTo(first.From, endTangent(reverseQuad(first)))
+}
+
+// strokeClosed records the outline of a closed contour as
+// two loops, one for each side.
+func (s *Stroke) strokeClosed(contour []ops.Quad) {
+	hw := s.Style.Width / 2
+	first, last := contour[0], contour[len(contour)-1]
+	s.path.moveTo(first.From.Add(normal(startTangent(first), hw)))
+	s.offsetSide(contour)
+	s.join(first.From, endTangent(last), startTangent(first))
+	// The other side is the reversed contour.
+	r := reverseQuad(last)
+	s.path.moveTo(r.From.Add(normal(startTangent(r), hw)))
+	prev := r
+	for i := range contour {
+		q := reverseQuad(contour[len(contour)-1-i])
+		if i > 0 {
+			s.join(q.From, endTangent(prev), startTangent(q))
+		}
+		s.offsetQuad(q, hw, 0)
+		prev = q
+	}
+	s.join(r.From, endTangent(prev), startTangent(r))
+}
+
+// offsetSide records the offset of the left side of contour,
+// including the joins between its segments.
+func (s *Stroke) offsetSide(contour []ops.Quad) {
+	hw := s.Style.Width / 2
+	for i, q := range contour {
+		if i > 0 {
+			s.join(q.From, endTangent(contour[i-1]), startTangent(q))
+		}
+		s.offsetQuad(q, hw, 0)
+	}
+}
+
+// join records the join at p between a segment ending
+// with the tangent t0 and a segment starting with the
+// tangent t1. The pen is assumed to be at the offset
+// end of the first segment.
+func (s *Stroke) join(p, t0, t1 f32.Point) {
+	hw := s.Style.Width / 2
+	n0, n1 := normal(t0, hw), normal(t1, hw)
+	to := p.Add(n1)
+	c := cross(t0, t1)
+	switch {
+	case c == 0 && dot(t0, t1) > 0:
+		// No corner.
+		s.path.lineTo(to)
+		return
+	case c < 0:
+		// The inner side of the corner. Connecting through
+		// the joint avoids holes in the outline.
+		s.path.lineTo(p)
+		s.path.lineTo(to)
+		return
+	}
+	switch s.Style.Join {
+	case RoundJoin:
+		sweep := angle(n0, n1)
+		if c == 0 {
+			// The contour reverses direction; go around the
+			// joint like a round cap.
+			sweep = math.Pi
+		}
+		s.arcTo(p, n0, n1, hw, sweep)
+	case MiterJoin:
+		limit := s.Style.Miter
+		if limit == 0 {
+			limit = 4
+		}
+		// The ratio between miter length and stroke width
+		// is 1/sin(φ/2) for the angle φ between the segments,
+		// or 1/cos(θ/2) for the angle θ between the normals.
+		cos := dot(n0, n1) / (hw * hw)
+		if cos2 := (1 + cos) / 2; cos2 > 0 && 1/cos2 <= limit*limit {
+			// The miter point is along the bisector of the normals.
+			bisect := n0.Add(n1)
+			miter := p.Add(bisect.Mul(hw * hw / dot(bisect, n0)))
+			s.path.lineTo(miter)
+		}
+		s.path.lineTo(to)
+	default:
+		s.path.lineTo(to)
+	}
+}
+
+// capTo records the cap at the end point p of a segment
+// with the end tangent t. The pen is assumed to be at the
+// left offset of p.
+func (s *Stroke) capTo(p, t f32.Point) {
+	hw := s.Style.Width / 2
+	n := normal(t, hw)
+	switch s.Style.Cap {
+	case RoundCap:
+		s.arcTo(p, n, n.Mul(-1), hw, math.Pi)
+	case SquareCap:
+		// Extend the stroke along the tangent.
+		ext := f32.Point{X: -n.Y, Y: n.X}
+		s.path.lineTo(p.Add(n).Add(ext))
+		s.path.lineTo(p.Sub(n).Add(ext))
+		s.path.lineTo(p.Sub(n))
+	default:
+		s.path.lineTo(p.Sub(n))
+	}
+}
+
+// arcTo records a circular arc around center with radius r
+// from the vector from to the vector to, sweeping the
+// angle sweep.
+func (s *Stroke) arcTo(center, from, to f32.Point, r float32, sweep float64) {
+	// Approximate the arc by quadratic curves of at most
+	// 45 degrees each.
+	n := int(math.Ceil(math.Abs(sweep) / (math.Pi / 4)))
+	if n == 0 {
+		s.path.lineTo(center.Add(to))
+		return
+	}
+	step := sweep / float64(n)
+	sin, cos := math.Sincos(step)
+	// The control point is on the bisector at distance r/cos(step/2).
+	ctrlScale := float32(1 / (1 + cos))
+	u := from
+	for i := 0; i < n; i++ {
+		v := f32.Point{
+			X: u.X*float32(cos) - u.Y*float32(sin),
+			Y: u.X*float32(sin) + u.Y*float32(cos),
+		}
+		if i == n-1 {
+			v = to
+		}
+		ctrl := center.Add(u.Add(v).Mul(ctrlScale))
+		s.path.quadTo(ctrl, center.Add(v))
+		u = v
+	}
+}
+
+// offsetQuad records the approximate offset of q by the
+// distance d to its left side.
+func (s *Stroke) offsetQuad(q ops.Quad, d float32, depth int) {
+	t0, t1 := startTangent(q), endTangent(q)
+	from := q.From.Add(normal(t0, d))
+	to := q.To.Add(normal(t1, d))
+	if isLine(q) {
+		s.path.quadTo(from.Add(to).Mul(.5), to)
+		return
+	}
+	// The control point is at the intersection of the offset
+	// tangents at the end points.
+	ctrl, ok := intersect(from, t0, to, t1)
+	const maxDepth = 8
+	if depth < maxDepth {
+		// Compare the approximation with the exact offset
+		// in the middle of the curve.
+		mid := evalQuad(q, .5).Add(normal(derivQuad(q, .5), d))
+		approx := from.Mul(.25).Add(ctrl.Mul(.5)).Add(to.Mul(.25))
+		tol := d * 0.01
+		if !ok || dist(mid, approx) > tol {
+			q0, q1 := splitQuad(q, .5)
+			s.offsetQuad(q0, d, depth+1)
+			s.offsetQuad(q1, d, depth+1)
+			return
+		}
+	}
+	if !ok {
+		ctrl = from.Add(to).Mul(.5)
+	}
+	s.path.quadTo(ctrl, to)
+}
+
+// dashContour splits contour into dashes and strokes them.
+func (s *Stroke) dashContour(contour []ops.Quad, closed bool) {
+	dashes := s.Style.Dashes
+	var total float32
+	for _, d := range dashes {
+		if d < 0 {
+			// Invalid pattern; draw solid strokes.
+			total = 0
+			break
+		}
+		total += d
+	}
+	n := len(dashes)
+	if n%2 == 1 {
+		// Repeat the odd pattern.
+		n *= 2
+		total *= 2
+	}
+	if total <= 0 {
+		if closed {
+			s.strokeClosed(contour)
+		} else {
+			s.strokeOpen(contour)
+		}
+		return
+	}
+	// Find the starting dash from the phase.
+	idx := 0
+	phase := float32(math.Mod(float64(s.Style.DashPhase), float64(total)))
+	if phase < 0 {
+		phase += total
+	}
+	for phase >= dashes[idx%len(dashes)] {
+		phase -= dashes[idx%len(dashes)]
+		idx = (idx + 1) % n
+	}
+	left := dashes[idx%len(dashes)] - phase
+	on := idx%2 == 0
+	startsOn := on
+	s.dash = s.dash[:0]
+	s.firstDash = s.firstDash[:0]
+	first := true
+	emit := func() {
+		if first && closed && startsOn {
+			// Keep the first dash for joining it with the
+			// last dash.
+			s.firstDash = append(s.firstDash, s.dash...)
+		} else if len(s.dash) > 0 {
+			s.strokeOpen(s.dash)
+		}
+		first = false
+		s.dash = s.dash[:0]
+	}
+	for _, q := range contour {
+		l := quadLen(q)
+		var t0, pos float32
+		for pos+left < l {
+			pos += left
+			t1 := quadParam(q, pos)
+			if on {
+				s.dash = append(s.dash, subQuad(q, t0, t1))
+				emit()
+			} else {
+				first = false
+			}
+			t0 = t1
+			idx = (idx + 1) % n
+			left = dashes[idx%len(dashes)]
+			on = !on
+		}
+		left -= l - pos
+		if on && t0 < 1 {
+			s.dash = append(s.dash, subQuad(q, t0, 1))
+		}
+	}
+	if on && closed && startsOn && !first {
+		// Join the last and the first dash.
+		s.dash = append(s.dash, s.firstDash...)
+		s.firstDash = s.firstDash[:0]
+	}
+	if on && len(s.dash) > 0 {
+		if first && closed && startsOn {
+			// The contour is one solid dash.
+			s.strokeClosed(s.dash)
+			s.dash = s.dash[:0]
+			return
+		}
+		s.strokeOpen(s.dash)
+	}
+	if len(s.firstDash) > 0 {
+		s.strokeOpen(s.firstDash)
+	}
+}
+
+func isLine(q ops.Quad) bool {
+	return cross(q.Ctrl.Sub(q.From), q.To.Sub(q.From)) == 0
+}
+
+// isMidLine reports whether q is a line with its control point
+// in the middle, as recorded by Line.
+func isMidLine(q ops.Quad) bool {
+	return q.Ctrl == q.From.Add(q.To).Mul(.5)
+}
+
+func startTangent(q ops.Quad) f32.Point {
+	if t := q.Ctrl.Sub(q.From); t != (f32.Point{}) {
+		return t
+	}
+	return q.To.Sub(q.From)
+}
+
+func endTangent(q ops.Quad) f32.Point {
+	if t := q.To.Sub(q.Ctrl); t != (f32.Point{}) {
+		return t
+	}
+	return q.To.Sub(q.From)
+}
+
+func reverseQuad(q ops.Quad) ops.Quad {
+	return ops.Quad{From: q.To, Ctrl: q.Ctrl, To: q.From}
+}
+
+// normal returns the vector of length l perpendicular
+// to t, pointing to its left side.
+func normal(t f32.Point, l float32) f32.Point {
+	n := length(t)
+	if n == 0 {
+		return f32.Point{}
+	}
+	return f32.Point{X: t.Y, Y: -t.X}.Mul(l / n)
+}
+
+func evalQuad(q ops.Quad, t float32) f32.Point {
+	u := 1 - t
+	return q.From.Mul(u * u).Add(q.Ctrl.Mul(2 * u * t)).Add(q.To.Mul(t * t))
+}
+
+func derivQuad(q ops.Quad, t float32) f32.Point {
+	return q.Ctrl.Sub(q.From).Mul(2 * (1 - t)).Add(q.To.Sub(q.Ctrl).Mul(2 * t))
+}
+
+// splitQuad splits q at t.
+func splitQuad(q ops.Quad, t float32) (ops.Quad, ops.Quad) {
+	c0 := q.From.Add(q.Ctrl.Sub(q.From).Mul(t))
+	c1 := q.Ctrl.Add(q.To.Sub(q.Ctrl).Mul(t))
+	mid := c0.Add(c1.Sub(c0).Mul(t))
+	return ops.Quad{From: q.From, Ctrl: c0, To: mid}, ops.Quad{From: mid, Ctrl: c1, To: q.To}
+}
+
+// subQuad returns the part of q between t0 and t1.
+func subQuad(q ops.Quad, t0, t1 float32) ops.Quad {
+	from := evalQuad(q, t0)
+	return ops.Quad{
+		From: from,
+		Ctrl: from.Add(derivQuad(q, t0).Mul((t1 - t0) / 2)),
+		To:   evalQuad(q, t1),
+	}
+}
+
+// quadSamples is the number of line segments used for
+// approximating the length of curves.
+const quadSamples = 16
+
+// quadLen returns the approximate length of q.
+func quadLen(q ops.Quad) float32 {
+	if isMidLine(q) {
+		return dist(q.From, q.To)
+	}
+	var l float32
+	prev := q.From
+	for i := 1; i <= quadSamples; i++ {
+		p := evalQuad(q, float32(i)/quadSamples)
+		l += dist(prev, p)
+		prev = p
+	}
+	return l
+}
+
+// quadParam returns the approximate t where the length
+// of q from its start equals l.
+func quadParam(q ops.Quad, l float32) float32 {
+	if isMidLine(q) {
+		if d := dist(q.From, q.To); d > 0 {
+			// The curve has constant speed.
+			return l / d
+		}
+		return 0
+	}
+	prev := q.From
+	for i := 1; i <= quadSamples; i++ {
+		t := float32(i) / quadSamples
+		p := evalQuad(q, t)
+		d := dist(prev, p)
+		if d >= l {
+			if d == 0 {
+				return t
+			}
+			return t - (1-l/d)/quadSamples
+		}
+		l -= d
+		prev = p
+	}
+	return 1
+}
+
+// intersect returns the intersection between the line through
+// p0 with direction d0 and the line through p1 with direction
+// d1.
+func intersect(p0, d0, p1, d1 f32.Point) (f32.Point, bool) {
+	det := cross(d0, d1)
+	if det == 0 {
+		return f32.Point{}, false
+	}
+	t := cross(p1.Sub(p0), d1) / det
+	return p0.Add(d0.Mul(t)), true
+}
+
+// angle returns the signed angle from v0 to v1.
+func angle(v0, v1 f32.Point) float64 {
+	return math.Atan2(float64(cross(v0, v1)), float64(dot(v0, v1)))
+}
+
+func cross(a, b f32.Point) float32 {
+	return a.X*b.Y - a.Y*b.X
+}
+
+func dot(a, b f32.Point) float32 {
+	return a.X*b.X + a.Y*b.Y
+}
+
+func length(v f32.Point) float32 {
+	return float32(math.Hypot(float64(v.X), float64(v.Y)))
+}
+
+func dist(a, b f32.Point) float32 {
+	return length(b.Sub(a))
+}
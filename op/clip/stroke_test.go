@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package clip
+
+import (
+	"math"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/internal/opconst"
+	"gioui.org/internal/ops"
+	"gioui.org/op"
+)
+
+func TestStrokeCaps(t *testing.T) {
+	tests := []struct {
+		cap    StrokeCap
+		bounds f32.Rectangle
+	}{
+		{ButtCap, f32.Rect(10, 5, 110, 15)},
+		{SquareCap, f32.Rect(5, 5, 115, 15)},
+		{RoundCap, f32.Rect(5, 5, 115, 15)},
+	}
+	for _, test := range tests {
+		var ops op.Ops
+		s := Stroke{Style: StrokeStyle{Width: 10, Cap: test.cap}}
+		s.Begin(&ops)
+		s.Move(f32.Pt(10, 10))
+		s.Line(f32.Pt(100, 0))
+		if b := s.End().bounds; !rectEq(b, test.bounds) {
+			t.Errorf("cap %d: got bounds %v, expected %v", test.cap, b, test.bounds)
+		}
+	}
+}
+
+func TestStrokeJoins(t *testing.T) {
+	bounds := func(join StrokeJoin, miter float32) f32.Rectangle {
+		var ops op.Ops
+		// A sharp corner at (100, 0).
+		s := Stroke{Style: StrokeStyle{Width: 10, Join: join, Miter: miter}}
+		s.Begin(&ops)
+		s.Move(f32.Pt(0, 0))
+		s.Line(f32.Pt(100, 0))
+		s.Line(f32.Pt(-100, 50))
+		return s.End().bounds
+	}
+	bevel := bounds(BevelJoin, 0)
+	round := bounds(RoundJoin, 0)
+	miter := bounds(MiterJoin, 10)
+	// The end of the second line is offset along its normal.
+	phi := math.Atan2(50, 100)
+	n := f32.Pt(float32(5*math.Sin(phi)), float32(5*math.Cos(phi)))
+	if exp := f32.Rect(-n.X, -5, 100+n.X, 50+n.Y); !rectEq(bevel, exp) {
+		t.Errorf("bevel join: got bounds %v, expected %v", bevel, exp)
+	}
+	// Circular arcs are approximated.
+	if d := round.Max.X - 105; d < 0 || d > 0.01 {
+		t.Errorf("round join: got bounds %v, expected max x 105", round)
+	}
+	// The miter length is 1/sin(φ/2) times the width for
+	// the angle φ between the segments.
+	tip := 100 + 5/math.Tan(phi/2)
+	if exp := f32.Rect(-n.X, -5, float32(tip), 50+n.Y); !rectEq(miter, exp) {
+		t.Errorf("miter join: got bounds %v, expected %v", miter, exp)
+	}
+	// The default miter limit is exceeded.
+	if m := bounds(MiterJoin, 0); !rectEq(m, bevel) {
+		t.Errorf("miter join: got bounds %v, expected bevel bounds %v", m, bevel)
+	}
+}
+
+func TestStrokeClosed(t *testing.T) {
+	var ops op.Ops
+	s := Stroke{Style: StrokeStyle{Width: 4}}
+	s.Begin(&ops)
+	s.Move(f32.Pt(10, 10))
+	s.Line(f32.Pt(20, 0))
+	s.Line(f32.Pt(0, 20))
+	s.Line(f32.Pt(-20, 0))
+	s.Close()
+	if b, exp := s.End().bounds, f32.Rect(8, 8, 32, 32); !rectEq(b, exp) {
+		t.Errorf("got bounds %v, expected %v", b, exp)
+	}
+}
+
+func TestStrokeDashes(t *testing.T) {
+	tests := []struct {
+		phase  float32
+		dashes []f32.Rectangle
+	}{
+		// The dashes cover [0;5], [15;25], ..., [95;100].
+		{5, []f32.Rectangle{
+			f32.Rect(0, -1, 5, 1), f32.Rect(15, -1, 25, 1), f32.Rect(35, -1, 45, 1),
+			f32.Rect(55, -1, 65, 1), f32.Rect(75, -1, 85, 1), f32.Rect(95, -1, 100, 1),
+		}},
+		// The dashes cover [5;15], ..., [85;95].
+		{15, []f32.Rectangle{
+			f32.Rect(5, -1, 15, 1), f32.Rect(25, -1, 35, 1), f32.Rect(45, -1, 55, 1),
+			f32.Rect(65, -1, 75, 1), f32.Rect(85, -1, 95, 1),
+		}},
+	}
+	for _, test := range tests {
+		var ops op.Ops
+		s := Stroke{Style: StrokeStyle{
+			Width:     2,
+			Dashes:    []float32{10, 10},
+			DashPhase: test.phase,
+		}}
+		s.Begin(&ops)
+		s.Move(f32.Pt(0, 0))
+		s.Line(f32.Pt(100, 0))
+		cl := s.End()
+		exp := test.dashes[0].Union(test.dashes[len(test.dashes)-1])
+		if !rectEq(cl.bounds, exp) {
+			t.Errorf("phase %v: got bounds %v, expected %v", test.phase, cl.bounds, exp)
+		}
+		// Every dash is a separate contour, leaving the gaps empty.
+		cl.Add(&ops)
+		contours := contourBounds(&ops)
+		if len(contours) != len(test.dashes) {
+			t.Errorf("phase %v: got %d contours, expected %d", test.phase, len(contours), len(test.dashes))
+			continue
+		}
+		for i, b := range contours {
+			if !rectEq(b, test.dashes[i]) {
+				t.Errorf("phase %v: dash %d: got bounds %v, expected %v", test.phase, i, b, test.dashes[i])
+			}
+		}
+	}
+}
+
+// contourBounds returns the bounds of every non-empty path contour
+// in o.
+func contourBounds(o *op.Ops) []f32.Rectangle {
+	var bounds []f32.Rectangle
+	var r ops.Reader
+	r.Reset(o)
+	last := -1
+	for {
+		encOp, ok := r.Decode()
+		if !ok {
+			return bounds
+		}
+		if opconst.OpType(encOp.Data[0]) != opconst.TypeAux {
+			continue
+		}
+		data := encOp.Data[opconst.TypeAuxLen:]
+		for len(data) >= ops.QuadSize {
+			c, q := ops.DecodeQuad(data)
+			data = data[ops.QuadSize:]
+			if int(c) != last {
+				last = int(c)
+				bounds = append(bounds, f32.Rectangle{Min: q.From, Max: q.From})
+			}
+			b := &bounds[len(bounds)-1]
+			for _, p := range []f32.Point{q.From, q.Ctrl, q.To} {
+				*b = b.Union(f32.Rectangle{Min: p, Max: p})
+			}
+		}
+	}
+}
+
+func rectEq(r1, r2 f32.Rectangle) bool {
+	const tol = 1e-3
+	eq := func(a, b float32) bool {
+		return math.Abs(float64(a-b)) < tol
+	}
+	return eq(r1.Min.X, r2.Min.X) && eq(r1.Min.Y, r2.Min.Y) &&
+		eq(r1.Max.X, r2.Max.X) && eq(r1.Max.Y, r2.Max.Y)
+}
+
+func TestStrokeCurve(t *testing.T) {
+	var ops op.Ops
+	// A circle of radius 50 around (100, 100).
+	const c = 0.55228475 // 4*(sqrt(2)-1)/3
+	const r = 50
+	s := Stroke{Style: StrokeStyle{Width: 10}}
+	s.Begin(&ops)
+	s.Move(f32.Pt(150, 100))
+	s.Cube(f32.Pt(0, r*c), f32.Pt(-r+r*c, r), f32.Pt(-r, r))
+	s.Cube(f32.Pt(-r*c, 0), f32.Pt(-r, -r+r*c), f32.Pt(-r, -r))
+	s.Cube(f32.Pt(0, -r*c), f32.Pt(r-r*c, -r), f32.Pt(r, -r))
+	s.Cube(f32.Pt(r*c, 0), f32.Pt(r, r-r*c), f32.Pt(r, r))
+	s.Close()
+	b := s.End().bounds
+	if exp := f32.Rect(45, 45, 155, 155); !rectEq(b, exp) {
+		t.Errorf("got bounds %v, expected %v", b, exp)
+	}
+}
@@ -41,6 +41,10 @@ The StackOp saves the current state to the state stack and restores it later:
 	// Apply a transform to subsequent operations.
 	op.TransformOp{}.Offset(...).Add(ops)
 	...
+	// Scale and rotate subsequent operations.
+	a := f32.Affine2D{}.Scale(f32.Point{}, f32.Pt(2, 2)).Rotate(f32.Point{}, math.Pi/4)
+	op.Affine(a).Add(ops)
+	...
 	// Restore the previous transform.
 	stack.Pop()
 
@@ -117,10 +121,10 @@ type InvalidateOp struct {
 	At time.Time
 }
 
-// TransformOp applies a transform to the current transform.
+// TransformOp applies a transform to the current transform. The zero value
+// for TransformOp represents the identity transform.
 type TransformOp struct {
-	// TODO: general transformations.
-	offset f32.Point
+	t f32.Affine2D
 }
 
 // stack tracks the integer identities of StackOp and MacroOp
@@ -261,25 +265,32 @@ func (r InvalidateOp) Add(o *Ops) {
 	}
 }
 
-// Offset the transformation.
+// Affine creates a TransformOp representing the transformation a.
+func Affine(a f32.Affine2D) TransformOp {
+	return TransformOp{t: a}
+}
+
+// Offset the transformation. The offset is applied before the
+// transformation, in its coordinate space.
 func (t TransformOp) Offset(o f32.Point) TransformOp {
-	return t.Multiply(TransformOp{o})
+	return t.Multiply(TransformOp{t: f32.Affine2D{}.Offset(o)})
 }
 
 // Invert the transformation.
 func (t TransformOp) Invert() TransformOp {
-	return TransformOp{offset: t.offset.Mul(-1)}
+	return TransformOp{t: t.t.Invert()}
 }
 
 // Transform a point.
 func (t TransformOp) Transform(p f32.Point) f32.Point {
-	return p.Add(t.offset)
+	return t.t.Transform(p)
 }
 
-// Multiply by a transformation.
+// Multiply by a transformation. The resulting transformation applies
+// t2 and then t.
 func (t TransformOp) Multiply(t2 TransformOp) TransformOp {
 	return TransformOp{
-		offset: t.offset.Add(t2.offset),
+		t: t.t.Mul(t2.t),
 	}
 }
 
@@ -287,8 +298,13 @@ func (t TransformOp) Add(o *Ops) {
 	data := o.Write(opconst.TypeTransformLen)
 	data[0] = byte(opconst.TypeTransform)
 	bo := binary.LittleEndian
-	bo.PutUint32(data[1:], math.Float32bits(t.offset.X))
-	bo.PutUint32(data[5:], math.Float32bits(t.offset.Y))
+	sx, hx, ox, hy, sy, oy := t.t.Elems()
+	bo.PutUint32(data[1:], math.Float32bits(sx))
+	bo.PutUint32(data[5:], math.Float32bits(hx))
+	bo.PutUint32(data[9:], math.Float32bits(ox))
+	bo.PutUint32(data[13:], math.Float32bits(hy))
+	bo.PutUint32(data[17:], math.Float32bits(sy))
+	bo.PutUint32(data[21:], math.Float32bits(oy))
 }
 
 func (s *stack) push() stackID {
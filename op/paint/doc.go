@@ -6,7 +6,10 @@ Package paint provides drawing operations for 2D graphics.
 The PaintOp operation draws the current brush into a rectangular
 area, taking the current clip path and transformation into account.
 
-The current brush is set by either a ColorOp for a constant color, or
-ImageOp for an image.
+The current brush is set by either a ColorOp for a constant color,
+ImageOp for an image, or LinearGradientOp and RadialGradientOp for
+gradients of colors.
+
+The OpacityOp operation fades a group of operations as a whole.
 */
 package paint
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package paint
+
+import (
+	"encoding/binary"
+	"image/color"
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/internal/opconst"
+	"gioui.org/op"
+)
+
+// MaxGradientStops is the maximum number of stops in a gradient.
+// Gradients with more stops are approximated by dropping the stops
+// that deviate the least from their neighbours.
+const MaxGradientStops = opconst.MaxGradientStops
+
+// GradientStop is a color at a position along a gradient.
+type GradientStop struct {
+	// Offset is the position of the stop, in the range [0;1].
+	Offset float32
+	Color  color.RGBA
+}
+
+// LinearGradientOp sets the brush to a gradient that varies
+// linearly between the Start and End points. Colors before the first
+// stop and after the last stop are the colors of those stops.
+//
+// Stops must be sorted by increasing offset. At most MaxGradientStops
+// stops are drawn; see MaxGradientStops for how extra stops are
+// handled.
+type LinearGradientOp struct {
+	Start, End f32.Point
+	Stops      []GradientStop
+}
+
+// RadialGradientOp sets the brush to a gradient that varies with the
+// distance from Center. Offset 0 is at the center and offset 1 is at
+// the distance Radius from the center. Colors outside the range of
+// the stops are the colors of the first and last stops.
+//
+// Stops must be sorted by increasing offset. At most MaxGradientStops
+// stops are drawn; see MaxGradientStops for how extra stops are
+// handled.
+type RadialGradientOp struct {
+	Center f32.Point
+	Radius float32
+	Stops  []GradientStop
+}
+
+// Add the gradient to the operation list.
+func (g LinearGradientOp) Add(o *op.Ops) {
+	data := o.Write(opconst.TypeLinearGradientLen)
+	data[0] = byte(opconst.TypeLinearGradient)
+	encodeGradient(data, g.Start, g.End, g.Stops)
+}
+
+// Add the gradient to the operation list.
+func (g RadialGradientOp) Add(o *op.Ops) {
+	data := o.Write(opconst.TypeRadialGradientLen)
+	data[0] = byte(opconst.TypeRadialGradient)
+	encodeGradient(data, g.Center, f32.Point{X: g.Radius}, g.Stops)
+}
+
+func encodeGradient(data []byte, p0, p1 f32.Point, stops []GradientStop) {
+	stops = reduceStops(stops)
+	bo := binary.LittleEndian
+	data[1] = byte(len(stops))
+	bo.PutUint32(data[2:], math.Float32bits(p0.X))
+	bo.PutUint32(data[6:], math.Float32bits(p0.Y))
+	bo.PutUint32(data[10:], math.Float32bits(p1.X))
+	bo.PutUint32(data[14:], math.Float32bits(p1.Y))
+	data = data[18:]
+	for _, s := range stops {
+		bo.PutUint32(data, math.Float32bits(s.Offset))
+		data[4] = s.Color.R
+		data[5] = s.Color.G
+		data[6] = s.Color.B
+		data[7] = s.Color.A
+		data = data[8:]
+	}
+}
+
+// reduceStops approximates stops with at most MaxGradientStops stops.
+// It repeatedly removes the interior stop whose color is closest to
+// the interpolation of its neighbours, which preserves the first and
+// last stops as well as sharp color transitions.
+func reduceStops(stops []GradientStop) []GradientStop {
+	if len(stops) <= MaxGradientStops {
+		return stops
+	}
+	stops = append([]GradientStop(nil), stops...)
+	for len(stops) > MaxGradientStops {
+		best, bestDist := 1, math.MaxInt32
+		for i := 1; i < len(stops)-1; i++ {
+			s0, s, s1 := stops[i-1], stops[i], stops[i+1]
+			var t float32
+			if d := s1.Offset - s0.Offset; d > 0 {
+				t = (s.Offset - s0.Offset) / d
+			}
+			if dist := colorDist(s.Color, lerpColor(s0.Color, s1.Color, t)); dist < bestDist {
+				best, bestDist = i, dist
+			}
+		}
+		stops = append(stops[:best], stops[best+1:]...)
+	}
+	return stops
+}
+
+func lerpColor(c0, c1 color.RGBA, t float32) color.RGBA {
+	lerp := func(a, b uint8) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*t + .5)
+	}
+	return color.RGBA{
+		R: lerp(c0.R, c1.R),
+		G: lerp(c0.G, c1.G),
+		B: lerp(c0.B, c1.B),
+		A: lerp(c0.A, c1.A),
+	}
+}
+
+func colorDist(c0, c1 color.RGBA) int {
+	abs := func(a, b uint8) int {
+		if a > b {
+			return int(a - b)
+		}
+		return int(b - a)
+	}
+	return abs(c0.R, c1.R) + abs(c0.G, c1.G) + abs(c0.B, c1.B) + abs(c0.A, c1.A)
+}
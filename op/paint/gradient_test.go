@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package paint
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestReduceStops(t *testing.T) {
+	black := color.RGBA{A: 0xff}
+	white := color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	red := color.RGBA{R: 0xff, A: 0xff}
+	// A smooth ramp from black to white, then a sharp transition
+	// to red.
+	var stops []GradientStop
+	for i := 0; i <= 8; i++ {
+		v := uint8(i * 0xff / 8)
+		stops = append(stops, GradientStop{
+			Offset: float32(i) / 16,
+			Color:  color.RGBA{R: v, G: v, B: v, A: 0xff},
+		})
+	}
+	stops = append(stops,
+		GradientStop{Offset: 0.5, Color: red},
+		GradientStop{Offset: 1, Color: red},
+	)
+	orig := append([]GradientStop(nil), stops...)
+	got := reduceStops(stops)
+	if len(got) != MaxGradientStops {
+		t.Fatalf("got %d stops, expected %d", len(got), MaxGradientStops)
+	}
+	for i := range stops {
+		if stops[i] != orig[i] {
+			t.Fatalf("stop %d modified: got %v, expected %v", i, stops[i], orig[i])
+		}
+	}
+	exp := []GradientStop{
+		{Offset: 0, Color: black},
+		{Offset: 0.5, Color: white},
+		{Offset: 0.5, Color: red},
+		{Offset: 1, Color: red},
+	}
+	for _, e := range exp {
+		found := false
+		for _, s := range got {
+			if s == e {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("stop %v was dropped", e)
+		}
+	}
+}
@@ -44,6 +44,18 @@ type PaintOp struct {
 	Rect f32.Rectangle
 }
 
+// OpacityOp draws the operations following it, up to the restoration
+// of the current state by op.StackOp, to a separate layer. The layer is
+// then blended onto the content below it with the given opacity.
+//
+// Unlike a translucent color for every paint, an OpacityOp fades the
+// operations as a group: overlapping operations inside the layer
+// don't show through each other.
+type OpacityOp struct {
+	// Opacity in the range [0;1].
+	Opacity float32
+}
+
 // NewImageOp creates an ImageOp backed by src. See
 // gioui.org/io/system.FrameEvent for a description of when data
 // referenced by operations is safe to re-use.
@@ -125,3 +137,17 @@ func (d PaintOp) Add(o *op.Ops) {
 	bo.PutUint32(data[9:], math.Float32bits(d.Rect.Max.X))
 	bo.PutUint32(data[13:], math.Float32bits(d.Rect.Max.Y))
 }
+
+func (p OpacityOp) Add(o *op.Ops) {
+	opacity := p.Opacity
+	switch {
+	case opacity < 0:
+		opacity = 0
+	case opacity > 1:
+		opacity = 1
+	}
+	data := o.Write(opconst.TypeLayerLen)
+	data[0] = byte(opconst.TypeLayer)
+	bo := binary.LittleEndian
+	bo.PutUint32(data[1:], math.Float32bits(opacity))
+}
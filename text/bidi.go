@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"gioui.org/internal/bidi"
+)
+
+// ResolveDirections resolves the embedding levels of the glyphs of
+// lines with the Unicode Bidirectional Algorithm and sets the Direction
+// and Runs of the lines. The lines must be consecutive lines of a text,
+// such as the lines returned by Face.Layout, where newlines separate
+// paragraphs. The levels are resolved from the glyph runes; the glyphs
+// stay in logical order.
+func ResolveDirections(lines []Line) {
+	start := 0
+	for i, l := range lines {
+		n := len(l.Layout)
+		if i == len(lines)-1 || n > 0 && l.Layout[n-1].Rune == '\n' {
+			resolveParagraph(lines[start : i+1])
+			start = i + 1
+		}
+	}
+}
+
+func resolveParagraph(lines []Line) {
+	var runes []rune
+	for _, l := range lines {
+		for _, g := range l.Layout {
+			runes = append(runes, g.Rune)
+		}
+	}
+	levels, para := bidi.Paragraph(runes)
+	for i := range lines {
+		l := &lines[i]
+		n := len(l.Layout)
+		lrunes, llevels := runes[:n], levels[:n]
+		runes, levels = runes[n:], levels[n:]
+		l.Direction = directionOf(para)
+		bidi.Line(lrunes, llevels, para)
+		for j, lvl := range llevels {
+			l.Layout[j].Level = lvl
+		}
+		l.Runs = lineRuns(l.Layout)
+	}
+}
+
+// lineRuns returns the runs of a line of glyphs in visual order, from
+// the embedding levels of the glyphs. It returns nil if every level is
+// zero.
+func lineRuns(glyphs []Glyph) []BidiRun {
+	ltr := true
+	levels := make([]uint8, len(glyphs))
+	for i, g := range glyphs {
+		levels[i] = g.Level
+		if g.Level != 0 {
+			ltr = false
+		}
+	}
+	if ltr {
+		return nil
+	}
+	var runs []BidiRun
+	for _, r := range bidi.Runs(levels) {
+		runs = append(runs, BidiRun{Start: r.Start, End: r.End, Direction: directionOf(r.Level)})
+	}
+	return runs
+}
+
+// VisualOrder returns the visual order of glyphs, or nil if the glyphs
+// are displayed in logical order. Shaper implementations use it to
+// draw the glyphs of a line from left to right.
+func VisualOrder(glyphs []Glyph) []int {
+	for _, g := range glyphs {
+		if g.Level&1 == 1 {
+			levels := make([]uint8, len(glyphs))
+			for i, g := range glyphs {
+				levels[i] = g.Level
+			}
+			return bidi.Reorder(levels)
+		}
+	}
+	return nil
+}
+
+// levelKey returns the embedding levels of glyphs as a string, or the
+// empty string if all levels are zero.
+func levelKey(glyphs []Glyph) string {
+	for i, g := range glyphs {
+		if g.Level != 0 {
+			levels := make([]byte, len(glyphs))
+			for j := i; j < len(glyphs); j++ {
+				levels[j] = glyphs[j].Level
+			}
+			return string(levels)
+		}
+	}
+	return ""
+}
+
+func directionOf(level uint8) Direction {
+	if level&1 == 1 {
+		return RTL
+	}
+	return LTR
+}
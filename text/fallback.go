@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"strings"
+	"unicode"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"golang.org/x/image/math/fixed"
+)
+
+// faceRun is a run of text in a single face of a fallback chain.
+type faceRun struct {
+	// face is the index of the face in the chain.
+	face int
+	// n is the number of runes in the run.
+	n int
+}
+
+// chooseFace returns the index of the first face in chain that has a
+// glyph for r. Spaces and combining marks stay in the face of the
+// preceding rune, prev, if possible. Runes without glyphs in any face
+// are taken from the first face.
+func chooseFace(chain []Face, r rune, prev int) int {
+	if prev != -1 {
+		if r == '\n' {
+			return prev
+		}
+		if (unicode.IsSpace(r) || unicode.Is(unicode.Mn, r)) && hasGlyph(chain[prev], r) {
+			return prev
+		}
+	}
+	for i, f := range chain {
+		if hasGlyph(f, r) {
+			return i
+		}
+	}
+	return 0
+}
+
+// hasGlyph reports whether f has a glyph for r. Faces that don't
+// implement FallbackFace are assumed to have glyphs for every rune.
+func hasGlyph(f Face, r rune) bool {
+	if f, ok := f.(FallbackFace); ok {
+		return f.HasGlyph(r)
+	}
+	return true
+}
+
+// splitRunes splits a sequence of runes into runs of the same face.
+func splitRunes(chain []Face, runes []rune) []faceRun {
+	var runs []faceRun
+	prev := -1
+	for _, r := range runes {
+		f := chooseFace(chain, r, prev)
+		if f != prev {
+			runs = append(runs, faceRun{face: f})
+		}
+		runs[len(runs)-1].n++
+		prev = f
+	}
+	return runs
+}
+
+// faceMetrics returns the line measurements of f.
+func faceMetrics(f Face, ppem fixed.Int26_6) spanMetrics {
+	lines, _ := f.Layout(ppem, 0, strings.NewReader(""))
+	if len(lines) == 0 {
+		return spanMetrics{}
+	}
+	l := lines[0]
+	return spanMetrics{
+		ascent:   l.Ascent,
+		descent:  l.Descent,
+		bounds:   l.Bounds,
+		overhang: l.Bounds.Max.X - l.Width,
+	}
+}
+
+// layoutFallback lays out str with the faces of chain. The ascent and
+// descent of every line are the ascent and descent of the first face,
+// so the line spacing doesn't depend on the faces on the line.
+func layoutFallback(chain []Face, ppem fixed.Int26_6, maxWidth int, str string) []Line {
+	runes := []rune(str)
+	metrics := make([]spanMetrics, len(chain))
+	measured := make([]bool, len(chain))
+	measure := func(f int) {
+		if !measured[f] {
+			measured[f] = true
+			metrics[f] = faceMetrics(chain[f], ppem)
+		}
+	}
+	measure(0)
+	var glyphs []spanGlyph
+	for _, run := range splitRunes(chain, runes) {
+		measure(run.face)
+		txt := string(runes[:run.n])
+		runes = runes[run.n:]
+		lines, _ := chain[run.face].Layout(ppem, inf, strings.NewReader(txt))
+		for _, l := range lines {
+			for _, g := range l.Layout {
+				glyphs = append(glyphs, spanGlyph{Glyph: g, span: run.face})
+			}
+		}
+	}
+	var lines []Line
+	for _, l := range breakLines(glyphs, maxWidth) {
+		sl := spanLine(metrics, l, 0)
+		line := Line{
+			Layout:  make([]Glyph, len(l)),
+			Width:   sl.Width,
+			Ascent:  metrics[0].ascent,
+			Descent: metrics[0].descent,
+			Bounds:  sl.Bounds,
+		}
+		for i, g := range l {
+			line.Layout[i] = g.Glyph
+			line.Len += g.ClusterLen()
+		}
+		lines = append(lines, line)
+	}
+	// The faces resolved the directions of their runs in isolation.
+	ResolveDirections(lines)
+	return lines
+}
+
+// shapeFallback shapes a line laid out by layoutFallback, with the
+// start of the line at off.
+func shapeFallback(chain []Face, ppem, off fixed.Int26_6, str []Glyph) op.CallOp {
+	runes := make([]rune, len(str))
+	for i, g := range str {
+		runes[i] = g.Rune
+	}
+	runs := splitRunes(chain, runes)
+	if len(runs) == 1 {
+		f := chain[runs[0].face]
+		// Faces that can't outline text at an offset are drawn
+		// at whole pixels.
+		if _, ok := f.(FallbackFace); off == 0 || !ok {
+			return f.Shape(ppem, str)
+		}
+	}
+	faces := make([]int, 0, len(str))
+	for _, run := range runs {
+		for i := 0; i < run.n; i++ {
+			faces = append(faces, run.face)
+		}
+	}
+	order := VisualOrder(str)
+	glyph := func(i int) int {
+		if order != nil {
+			return order[i]
+		}
+		return i
+	}
+	// outlineRun is a visual run of glyphs in the same face and
+	// level. Its glyphs are contiguous in logical order.
+	type outlineRun struct {
+		face   int
+		off    f32.Point
+		glyphs []Glyph
+	}
+	var oruns []outlineRun
+	x := off
+	for i := 0; i < len(str); {
+		first := glyph(i)
+		face, level := faces[first], str[first].Level
+		start, end := first, first+1
+		var adv fixed.Int26_6
+		j := i
+		for ; j < len(str); j++ {
+			g := glyph(j)
+			if faces[g] != face || str[g].Level != level {
+				break
+			}
+			if g < start {
+				start = g
+			}
+			if g+1 > end {
+				end = g + 1
+			}
+			adv += str[g].Advance
+		}
+		oruns = append(oruns, outlineRun{
+			face:   face,
+			off:    f32.Point{X: float32(x) / 64},
+			glyphs: str[start:end],
+		})
+		x += adv
+		i = j
+	}
+	ops := new(op.Ops)
+	m := op.Record(ops)
+	// Draw the color glyphs before recording the outlines of the
+	// others into the clip path.
+	for _, r := range oruns {
+		if f, ok := chain[r.face].(ColorFace); ok {
+			f.Paint(ops, ppem, r.off, r.glyphs)
+		}
+	}
+	var p clip.Path
+	p.Begin(ops)
+	for _, r := range oruns {
+		// Runs of more than one face and runs at an offset
+		// contain only fallback faces.
+		f := chain[r.face].(FallbackFace)
+		f.Outline(&p, ppem, r.off, r.glyphs)
+	}
+	p.End().Add(ops)
+	return m.Stop()
+}
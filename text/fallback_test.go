@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// testFace is a face with glyphs of a fixed size for a set of runes.
+type testFace struct {
+	runes string
+	size  fixed.Int26_6
+	// noLen leaves the Len of glyphs unset.
+	noLen bool
+	// outlines records the calls to Outline.
+	outlines []outlineCall
+}
+
+type outlineCall struct {
+	off  f32.Point
+	text string
+}
+
+func (f *testFace) Layout(ppem fixed.Int26_6, maxWidth int, txt io.Reader) ([]Line, error) {
+	str, err := ioutil.ReadAll(txt)
+	if err != nil {
+		return nil, err
+	}
+	line := Line{
+		Ascent:  f.size,
+		Descent: f.size / 2,
+		Bounds: fixed.Rectangle26_6{
+			Min: fixed.Point26_6{Y: -f.size},
+			Max: fixed.Point26_6{X: f.size, Y: f.size / 2},
+		},
+	}
+	for _, r := range string(str) {
+		g := Glyph{Rune: r}
+		if !f.noLen {
+			g.Len = len(string(r))
+		}
+		if r != '\n' {
+			g.Advance = f.size
+		}
+		line.Layout = append(line.Layout, g)
+		line.Width += g.Advance
+		line.Len += g.ClusterLen()
+	}
+	return []Line{line}, nil
+}
+
+func (f *testFace) Shape(ppem fixed.Int26_6, str []Glyph) op.CallOp {
+	return op.CallOp{}
+}
+
+func (f *testFace) Metrics(ppem fixed.Int26_6) font.Metrics {
+	return font.Metrics{Ascent: f.size, Height: f.size * 3 / 2}
+}
+
+func (f *testFace) HasGlyph(r rune) bool {
+	return strings.ContainsRune(f.runes, r)
+}
+
+func (f *testFace) Outline(p *clip.Path, ppem fixed.Int26_6, off f32.Point, str []Glyph) {
+	var txt []rune
+	for _, g := range str {
+		txt = append(txt, g.Rune)
+	}
+	f.outlines = append(f.outlines, outlineCall{off: off, text: string(txt)})
+}
+
+func TestFallbackLayout(t *testing.T) {
+	latin := &testFace{runes: "ab ", size: fixed.I(10)}
+	cjk := &testFace{runes: "日本 ", size: fixed.I(20)}
+	emoji := &testFace{runes: "☺", size: fixed.I(30)}
+	shaper := new(FontRegistry)
+	shaper.Register(Font{}, latin, cjk)
+	shaper.SetFallback(emoji)
+
+	lines := shaper.LayoutString(Font{}, fixed.I(10), 1000, Spacing{}, "ab 日本 ☺a")
+	if len(lines) != 1 {
+		t.Fatalf("got %d lines, expected 1", len(lines))
+	}
+	l := lines[0]
+	// a, b, space from latin; 日, 本, space from cjk; ☺ from emoji;
+	// a from latin.
+	if exp := fixed.I(10*3 + 20*3 + 30 + 10); l.Width != exp {
+		t.Errorf("got width %v, expected %v", l.Width, exp)
+	}
+	if l.Ascent != latin.size || l.Descent != latin.size/2 {
+		t.Errorf("got ascent %v and descent %v, expected the metrics of the primary face", l.Ascent, l.Descent)
+	}
+	if l.Bounds.Min.Y != -emoji.size {
+		t.Errorf("got bounds %v, expected the bounds of the tallest face", l.Bounds)
+	}
+	shaper.ShapeString(Font{}, fixed.I(10), 0, "ab 日本 ☺a", l.Layout)
+	check := func(f *testFace, exp ...outlineCall) {
+		t.Helper()
+		if len(f.outlines) != len(exp) {
+			t.Fatalf("got outlines %v, expected %v", f.outlines, exp)
+		}
+		for i, c := range exp {
+			if f.outlines[i] != c {
+				t.Errorf("got outline %v, expected %v", f.outlines[i], c)
+			}
+		}
+	}
+	check(latin, outlineCall{text: "ab "}, outlineCall{off: f32.Pt(120, 0), text: "a"})
+	check(cjk, outlineCall{off: f32.Pt(30, 0), text: "日本 "})
+	check(emoji, outlineCall{off: f32.Pt(90, 0), text: "☺"})
+
+	// Wrapping spans faces, and ideographs wrap without spaces.
+	lines = shaper.LayoutString(Font{}, fixed.I(10), 65, Spacing{}, "ab 日本 ☺a")
+	var got []string
+	for _, l := range lines {
+		var txt []rune
+		for _, g := range l.Layout {
+			txt = append(txt, g.Rune)
+		}
+		got = append(got, string(txt))
+	}
+	exp := []string{"ab 日", "本 ", "☺a"}
+	if strings.Join(got, "|") != strings.Join(exp, "|") {
+		t.Errorf("got lines %q, expected %q", got, exp)
+	}
+}
+
+func TestFallbackSingleFace(t *testing.T) {
+	latin := &testFace{runes: "ab", size: fixed.I(10)}
+	cjk := &testFace{runes: "日本", size: fixed.I(20)}
+	shaper := new(FontRegistry)
+	shaper.Register(Font{}, latin, cjk)
+	lines := shaper.LayoutString(Font{}, fixed.I(10), 1000, Spacing{}, "ab")
+	shaper.ShapeString(Font{}, fixed.I(10), 0, "ab", lines[0].Layout)
+	// Text in a single face is shaped by the face itself.
+	if len(latin.outlines) > 0 {
+		t.Errorf("single face text shaped with Outline")
+	}
+}
+
+// colorFace is a testFace with color glyphs.
+type colorFace struct {
+	testFace
+	// paints records the calls to Paint.
+	paints []outlineCall
+}
+
+func (f *colorFace) Paint(ops *op.Ops, ppem fixed.Int26_6, off f32.Point, str []Glyph) {
+	var txt []rune
+	for _, g := range str {
+		txt = append(txt, g.Rune)
+	}
+	f.paints = append(f.paints, outlineCall{off: off, text: string(txt)})
+}
+
+func TestFallbackColor(t *testing.T) {
+	latin := &testFace{runes: "ab", size: fixed.I(10)}
+	emoji := &colorFace{testFace: testFace{runes: "☺", size: fixed.I(10)}}
+	shaper := new(FontRegistry)
+	shaper.Register(Font{}, latin, emoji)
+	lines := shaper.LayoutString(Font{}, fixed.I(10), 1000, Spacing{}, "a☺b")
+	shaper.ShapeString(Font{}, fixed.I(10), 0, "a☺b", lines[0].Layout)
+	exp := outlineCall{off: f32.Pt(10, 0), text: "☺"}
+	if len(emoji.paints) != 1 || emoji.paints[0] != exp {
+		t.Errorf("got color glyphs %v, expected %v", emoji.paints, []outlineCall{exp})
+	}
+	if len(latin.outlines) != 2 {
+		t.Errorf("got outlines %v, expected 2", latin.outlines)
+	}
+}
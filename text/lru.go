@@ -32,12 +32,21 @@ type path struct {
 type layoutKey struct {
 	ppem     fixed.Int26_6
 	maxWidth int
+	spacing  Spacing
 	str      string
 }
 
 type pathKey struct {
 	ppem fixed.Int26_6
 	str  string
+	// levels are the embedding levels of the glyphs, or empty if
+	// they are all zero.
+	levels string
+	// width is the sum of the glyph advances, which depends on the
+	// letter spacing.
+	width fixed.Int26_6
+	// offset is the subpixel offset of the start of the line.
+	offset fixed.Int26_6
 }
 
 const maxSize = 1000
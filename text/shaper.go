@@ -4,6 +4,7 @@ package text
 
 import (
 	"io"
+	"io/ioutil"
 	"strings"
 
 	"golang.org/x/image/font"
@@ -12,72 +13,214 @@ import (
 	"golang.org/x/image/math/fixed"
 )
 
+// inf is a line width that never wraps.
+const inf = 1e6
+
 // Shaper implements layout and shaping of text.
 type Shaper interface {
 	// Layout a text according to a set of options.
-	Layout(font Font, size fixed.Int26_6, maxWidth int, txt io.Reader) ([]Line, error)
+	Layout(font Font, size fixed.Int26_6, maxWidth int, spacing Spacing, txt io.Reader) ([]Line, error)
 	// Shape a line of text and return a clipping operation for its outline.
-	Shape(font Font, size fixed.Int26_6, layout []Glyph) op.CallOp
+	// Color glyphs are drawn by the operation itself. The line starts
+	// at x, and the operation is to be drawn at LineOrigin(x): the
+	// glyphs are offset by the rest of x.
+	Shape(font Font, size fixed.Int26_6, x fixed.Int26_6, layout []Glyph) op.CallOp
 
 	// LayoutString is like Layout, but for strings..
-	LayoutString(font Font, size fixed.Int26_6, maxWidth int, str string) []Line
+	LayoutString(font Font, size fixed.Int26_6, maxWidth int, spacing Spacing, str string) []Line
 	// ShapeString is like Shape for lines previously laid out by LayoutString.
-	ShapeString(font Font, size fixed.Int26_6, str string, layout []Glyph) op.CallOp
+	ShapeString(font Font, size fixed.Int26_6, x fixed.Int26_6, str string, layout []Glyph) op.CallOp
 
 	// Metrics returns the font metrics for font.
 	Metrics(font Font, size fixed.Int26_6) font.Metrics
 }
 
+// DecorationShaper is a Shaper that knows the position and thickness
+// of the decoration lines of its fonts. Text of other Shapers is
+// decorated according to DefaultDecorationMetrics.
+type DecorationShaper interface {
+	Shaper
+	// DecorationMetrics returns the decoration line metrics for
+	// font.
+	DecorationMetrics(font Font, size fixed.Int26_6) DecorationMetrics
+}
+
 // FontRegistry implements layout and shaping of text from a set of
 // registered fonts.
 //
 // If a font matches no registered shape, FontRegistry falls back to the
 // first registered face.
 //
+// A VariableFace serves every weight and style of its typeface that
+// has no face registered for it.
+//
+// Fonts of named typefaces without a registered face are loaded from
+// the FaceLoader set by SetLoader, if any, before falling back to the
+// registered faces.
+//
+// Runes missing from the face of a font are taken from its fallback
+// faces, followed by the fallback faces of the registry. The faces
+// are tried in order and only faces that implement FallbackFace are
+// checked for missing runes.
+//
+// The Hinting of a font selects the hinted version of its face and
+// fallback faces, for the faces that implement HintingFace.
+//
+// Lines are shaped at the fraction of a pixel of their start,
+// rounded to a quarter of a pixel.
+//
 // The LayoutString and ShapeString results are cached and re-used if
 // possible.
 type FontRegistry struct {
 	def   Typeface
 	faces map[Font]*face
+	// variables are the variable faces of typefaces.
+	variables map[Typeface]*face
+	fallbacks []FallbackFace
+	loader    FaceLoader
+	// loaded are the faces loaded by loader, including the nil
+	// faces of fonts it failed to load.
+	loaded map[Font]*face
 }
 
 type face struct {
-	face        Face
+	face      Face
+	fallbacks []FallbackFace
+	// hinting is the hinting of the face, applied to the fallback
+	// faces of its chain.
+	hinting Hinting
+	// hinted are the hinted versions of the face.
+	hinted map[Hinting]*face
+	// chain is the face followed by its fallback faces and the
+	// fallback faces of the registry.
+	chain       []Face
 	layoutCache layoutCache
 	pathCache   pathCache
 }
 
-func (s *FontRegistry) Register(font Font, tf Face) {
+// Register a face for a font, along with the faces to use for runes
+// missing from it.
+func (s *FontRegistry) Register(font Font, tf Face, fallbacks ...FallbackFace) {
 	if s.faces == nil {
 		s.def = font.Typeface
 		s.faces = make(map[Font]*face)
+		s.variables = make(map[Typeface]*face)
 	}
 	if font.Weight == 0 {
 		font.Weight = Normal
 	}
-	s.faces[font] = &face{
-		face: tf,
+	if vf, ok := tf.(VariableFace); ok {
+		if inst := vf.Instance(font.Weight, font.Style); inst != nil {
+			s.variables[font.Typeface] = &face{face: tf, fallbacks: fallbacks}
+			tf = inst
+		}
+	}
+	f := &face{
+		face:      tf,
+		fallbacks: fallbacks,
+	}
+	f.chain = s.chain(f)
+	s.faces[font] = f
+}
+
+// SetFallback sets the faces to use for runes missing from every
+// registered font and its fallback faces.
+func (s *FontRegistry) SetFallback(fallbacks ...FallbackFace) {
+	s.fallbacks = fallbacks
+	reset := func(f *face) {
+		f.chain = s.chain(f)
+		// Discard layouts and shapes from the previous chain.
+		f.layoutCache = layoutCache{}
+		f.pathCache = pathCache{}
+	}
+	for _, faces := range []map[Font]*face{s.faces, s.loaded} {
+		for _, f := range faces {
+			if f == nil {
+				continue
+			}
+			reset(f)
+			for _, hf := range f.hinted {
+				reset(hf)
+			}
+		}
+	}
+}
+
+// SetLoader sets the loader of faces for fonts that have no registered
+// face. Faces are loaded when first used.
+func (s *FontRegistry) SetLoader(l FaceLoader) {
+	s.loader = l
+	s.loaded = make(map[Font]*face)
+}
+
+func (s *FontRegistry) chain(f *face) []Face {
+	chain := []Face{f.face}
+	for _, fb := range f.fallbacks {
+		chain = append(chain, hintFallback(fb, f.hinting))
+	}
+	for _, fb := range s.fallbacks {
+		chain = append(chain, hintFallback(fb, f.hinting))
 	}
+	return chain
 }
 
-func (s *FontRegistry) Layout(font Font, size fixed.Int26_6, maxWidth int, txt io.Reader) ([]Line, error) {
+// hinted returns the version of tf with hinting h, or tf if h is the
+// default or tf doesn't implement HintingFace.
+func (s *FontRegistry) hinted(tf *face, h Hinting) *face {
+	if tf == nil || h == HintingDefault {
+		return tf
+	}
+	hf, ok := tf.face.(HintingFace)
+	if !ok {
+		return tf
+	}
+	if f, ok := tf.hinted[h]; ok {
+		return f
+	}
+	f := &face{
+		face:      hf.Hinted(h),
+		fallbacks: tf.fallbacks,
+		hinting:   h,
+	}
+	f.chain = s.chain(f)
+	if tf.hinted == nil {
+		tf.hinted = make(map[Hinting]*face)
+	}
+	tf.hinted[h] = f
+	return f
+}
+
+// hintFallback returns the version of fb with hinting h, if any.
+func hintFallback(fb FallbackFace, h Hinting) FallbackFace {
+	if h == HintingDefault {
+		return fb
+	}
+	if hf, ok := fb.(HintingFace); ok {
+		if f, ok := hf.Hinted(h).(FallbackFace); ok {
+			return f
+		}
+	}
+	return fb
+}
+
+func (s *FontRegistry) Layout(font Font, size fixed.Int26_6, maxWidth int, spacing Spacing, txt io.Reader) ([]Line, error) {
 	tf := s.faceForFont(font)
-	return tf.face.Layout(size, maxWidth, txt)
+	return tf.layoutText(size, maxWidth, spacing, txt)
 }
 
-func (s *FontRegistry) Shape(font Font, size fixed.Int26_6, layout []Glyph) op.CallOp {
+func (s *FontRegistry) Shape(font Font, size fixed.Int26_6, x fixed.Int26_6, layout []Glyph) op.CallOp {
 	tf := s.faceForFont(font)
-	return tf.face.Shape(size, layout)
+	return shapeFallback(tf.chain, size, subpixel(x), layout)
 }
 
-func (s *FontRegistry) LayoutString(font Font, size fixed.Int26_6, maxWidth int, str string) []Line {
+func (s *FontRegistry) LayoutString(font Font, size fixed.Int26_6, maxWidth int, spacing Spacing, str string) []Line {
 	tf := s.faceForFont(font)
-	return tf.layout(size, maxWidth, str)
+	return tf.layout(size, maxWidth, spacing, str)
 }
 
-func (s *FontRegistry) ShapeString(font Font, size fixed.Int26_6, str string, layout []Glyph) op.CallOp {
+func (s *FontRegistry) ShapeString(font Font, size fixed.Int26_6, x fixed.Int26_6, str string, layout []Glyph) op.CallOp {
 	tf := s.faceForFont(font)
-	return tf.shape(size, str, layout)
+	return tf.shape(size, subpixel(x), str, layout)
 }
 
 func (s *FontRegistry) Metrics(font Font, size fixed.Int26_6) font.Metrics {
@@ -85,8 +228,19 @@ func (s *FontRegistry) Metrics(font Font, size fixed.Int26_6) font.Metrics {
 	return tf.metrics(size)
 }
 
+func (s *FontRegistry) DecorationMetrics(font Font, size fixed.Int26_6) DecorationMetrics {
+	tf := s.faceForFont(font)
+	return tf.decorationMetrics(size)
+}
+
 func (s *FontRegistry) faceForStyle(font Font) *face {
 	tf := s.faces[font]
+	if tf == nil {
+		tf = s.instance(font)
+	}
+	if tf == nil {
+		tf = s.load(font)
+	}
 	if tf == nil {
 		font := font
 		font.Weight = Normal
@@ -106,44 +260,156 @@ func (s *FontRegistry) faceForStyle(font Font) *face {
 	return tf
 }
 
+// instance returns the face for font from the variable face of its
+// typeface, or nil if the typeface has none.
+func (s *FontRegistry) instance(font Font) *face {
+	vf := s.variables[font.Typeface]
+	if vf == nil {
+		return nil
+	}
+	if font.Weight == 0 {
+		font.Weight = Normal
+		if tf := s.faces[font]; tf != nil {
+			return tf
+		}
+	}
+	inst := vf.face.(VariableFace).Instance(font.Weight, font.Style)
+	if inst == nil {
+		return nil
+	}
+	f := &face{
+		face:      inst,
+		fallbacks: vf.fallbacks,
+	}
+	f.chain = s.chain(f)
+	s.faces[font] = f
+	return f
+}
+
+// load returns the face for font from the loader, or nil if there is
+// no loader or the loader has no face for font.
+func (s *FontRegistry) load(font Font) *face {
+	if s.loader == nil || font.Typeface == "" {
+		return nil
+	}
+	if font.Weight == 0 {
+		font.Weight = Normal
+		if tf := s.faces[font]; tf != nil {
+			return tf
+		}
+	}
+	if tf, ok := s.loaded[font]; ok {
+		return tf
+	}
+	var f *face
+	if tf := s.loader.Load(font); tf != nil {
+		f = &face{face: tf}
+		f.chain = s.chain(f)
+	}
+	s.loaded[font] = f
+	return f
+}
+
 func (s *FontRegistry) faceForFont(font Font) *face {
+	h := font.Hinting
+	font.Hinting = HintingDefault
 	tf := s.faceForStyle(font)
 	if tf == nil {
 		font.Typeface = s.def
 		tf = s.faceForStyle(font)
 	}
-	return tf
+	return s.hinted(tf, h)
 }
 
-func (t *face) layout(ppem fixed.Int26_6, maxWidth int, str string) []Line {
+// subpixelSteps is the number of positions within a pixel that lines
+// are shaped at.
+const subpixelSteps = 4
+
+// subpixelStep is the distance between the subpixel positions.
+const subpixelStep = fixed.Int26_6(64 / subpixelSteps)
+
+// LineOrigin returns the whole pixel where the operation for a line
+// starting at x is drawn. It is x rounded to a subpixel position, and
+// then down to a whole pixel.
+func LineOrigin(x fixed.Int26_6) int {
+	return (x + subpixelStep/2).Floor()
+}
+
+// subpixel returns the offset from LineOrigin(x) of a line starting at
+// x, rounded to a subpixel step. The offset is less than a pixel.
+func subpixel(x fixed.Int26_6) fixed.Int26_6 {
+	x = (x + subpixelStep/2) &^ (subpixelStep - 1)
+	return x - fixed.I(x.Floor())
+}
+
+func (t *face) layout(ppem fixed.Int26_6, maxWidth int, spacing Spacing, str string) []Line {
 	if t == nil {
 		return nil
 	}
 	lk := layoutKey{
 		ppem:     ppem,
 		maxWidth: maxWidth,
+		spacing:  spacing,
 		str:      str,
 	}
 	if l, ok := t.layoutCache.Get(lk); ok {
 		return l
 	}
-	l, _ := t.face.Layout(ppem, maxWidth, strings.NewReader(str))
+	l, _ := t.layoutText(ppem, maxWidth, spacing, strings.NewReader(str))
 	t.layoutCache.Put(lk, l)
 	return l
 }
 
-func (t *face) shape(ppem fixed.Int26_6, str string, layout []Glyph) op.CallOp {
+// layoutText lays out txt with the faces of the chain and applies
+// spacing to the lines.
+func (t *face) layoutText(ppem fixed.Int26_6, maxWidth int, spacing Spacing, txt io.Reader) ([]Line, error) {
+	if t == nil {
+		return nil, nil
+	}
+	width := maxWidth
+	if spacing.Letter != 0 {
+		// Wrap the lines after spacing their letters.
+		width = inf
+	}
+	var lines []Line
+	if len(t.chain) == 1 {
+		l, err := t.face.Layout(ppem, width, txt)
+		if err != nil {
+			return nil, err
+		}
+		lines = l
+	} else {
+		str, err := ioutil.ReadAll(txt)
+		if err != nil {
+			return nil, err
+		}
+		lines = layoutFallback(t.chain, ppem, width, string(str))
+	}
+	if spacing.Letter != 0 {
+		spaceLetters(lines, spacing.Letter)
+		lines = WrapLines(lines, maxWidth, WrapHeuristically)
+	}
+	spaceLines(lines, spacing)
+	return lines, nil
+}
+
+func (t *face) shape(ppem, off fixed.Int26_6, str string, layout []Glyph) op.CallOp {
 	if t == nil {
 		return op.CallOp{}
 	}
 	pk := pathKey{
-		ppem: ppem,
-		str:  str,
+		ppem:   ppem,
+		str:    str,
+		levels: levelKey(layout),
+		offset: off,
+	}
+	for _, g := range layout {
+		pk.width += g.Advance
 	}
 	if clip, ok := t.pathCache.Get(pk); ok {
 		return clip
 	}
-	clip := t.face.Shape(ppem, layout)
+	clip := shapeFallback(t.chain, ppem, off, layout)
 	t.pathCache.Put(pk, clip)
 	return clip
 }
@@ -151,3 +417,13 @@ func (t *face) shape(ppem fixed.Int26_6, str string, layout []Glyph) op.CallOp {
 func (t *face) metrics(ppem fixed.Int26_6) font.Metrics {
 	return t.face.Metrics(ppem)
 }
+
+func (t *face) decorationMetrics(ppem fixed.Int26_6) DecorationMetrics {
+	if t == nil {
+		return DecorationMetrics{}
+	}
+	if f, ok := t.face.(DecorationFace); ok {
+		return f.DecorationMetrics(ppem)
+	}
+	return DefaultDecorationMetrics(ppem)
+}
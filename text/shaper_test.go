@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"testing"
+
+	"gioui.org/f32"
+	"golang.org/x/image/math/fixed"
+)
+
+// variableFace is a testFace with instances for weights and styles.
+type variableFace struct {
+	testFace
+	// instances records the calls to Instance.
+	instances []Font
+}
+
+type instanceFace struct {
+	testFace
+	font Font
+}
+
+func (f *variableFace) Instance(weight Weight, style Style) Face {
+	f.instances = append(f.instances, Font{Weight: weight, Style: style})
+	return &instanceFace{font: Font{Weight: weight, Style: style}}
+}
+
+func TestVariableFace(t *testing.T) {
+	vf := new(variableFace)
+	bold := new(testFace)
+	shaper := new(FontRegistry)
+	shaper.Register(Font{}, vf)
+	shaper.Register(Font{Weight: Bold}, bold)
+	tests := []struct {
+		font Font
+		exp  Font
+	}{
+		{Font{}, Font{Weight: Normal}},
+		{Font{Weight: Medium}, Font{Weight: Medium}},
+		{Font{Style: Italic}, Font{Weight: Normal, Style: Italic}},
+	}
+	for _, test := range tests {
+		f := shaper.faceForFont(test.font)
+		inst, ok := f.face.(*instanceFace)
+		if !ok || inst.font != test.exp {
+			t.Errorf("%v: got face %v, expected instance %v", test.font, f.face, test.exp)
+		}
+	}
+	// Registered faces take precedence over instances.
+	if f := shaper.faceForFont(Font{Weight: Bold}); f.face != bold {
+		t.Errorf("got face %v for a registered font", f.face)
+	}
+	// Instances are created once.
+	shaper.faceForFont(Font{Weight: Medium})
+	if exp := 3; len(vf.instances) != exp {
+		t.Errorf("got %d instances, expected %d", len(vf.instances), exp)
+	}
+}
+
+// testLoader loads faces for a typeface.
+type testLoader struct {
+	typeface Typeface
+	// loads records the calls to Load.
+	loads []Font
+}
+
+func (l *testLoader) Load(font Font) Face {
+	l.loads = append(l.loads, font)
+	if font.Typeface != l.typeface {
+		return nil
+	}
+	return &instanceFace{font: font}
+}
+
+func TestFaceLoader(t *testing.T) {
+	def := new(testFace)
+	loader := &testLoader{typeface: "System"}
+	shaper := new(FontRegistry)
+	shaper.Register(Font{Typeface: "Go"}, def)
+	shaper.SetLoader(loader)
+	sys := Font{Typeface: "System", Weight: Bold}
+	for i := 0; i < 2; i++ {
+		f := shaper.faceForFont(sys)
+		if inst, ok := f.face.(*instanceFace); !ok || inst.font != sys {
+			t.Errorf("got face %v, expected loaded face", f.face)
+		}
+	}
+	// Registered faces take precedence, and fonts missing from
+	// the loader fall back to the default face.
+	for _, font := range []Font{{}, {Typeface: "Go"}, {Typeface: "Missing"}, {Typeface: "Missing"}} {
+		if f := shaper.faceForFont(font); f.face != def {
+			t.Errorf("%v: got face %v, expected the default face", font, f.face)
+		}
+	}
+	// Faces are loaded once.
+	exp := []Font{sys, {Typeface: "Missing", Weight: Normal}}
+	if len(loader.loads) != len(exp) || loader.loads[0] != exp[0] || loader.loads[1] != exp[1] {
+		t.Errorf("got loads %v, expected %v", loader.loads, exp)
+	}
+}
+
+// hintingFace is a testFace with hinted versions.
+type hintingFace struct {
+	testFace
+	hinting Hinting
+	// hinted records the calls to Hinted.
+	hinted []Hinting
+}
+
+func (f *hintingFace) Hinted(h Hinting) Face {
+	f.hinted = append(f.hinted, h)
+	return &hintingFace{testFace: f.testFace, hinting: h}
+}
+
+func TestHintingFace(t *testing.T) {
+	hf := &hintingFace{testFace: testFace{runes: "a", size: fixed.I(10)}}
+	fb := &hintingFace{testFace: testFace{runes: "b", size: fixed.I(10)}}
+	plain := &testFace{runes: "c", size: fixed.I(10)}
+	shaper := new(FontRegistry)
+	shaper.Register(Font{}, hf, fb)
+	shaper.SetFallback(plain)
+	if f := shaper.faceForFont(Font{}); f.face != hf {
+		t.Errorf("got face %v for the default hinting", f.face)
+	}
+	for _, font := range []Font{{Hinting: HintingNone}, {Weight: Bold, Hinting: HintingNone}} {
+		f := shaper.faceForFont(font)
+		if h, ok := f.face.(*hintingFace); !ok || h.hinting != HintingNone {
+			t.Errorf("%v: got face %v, expected a face without hinting", font, f.face)
+		}
+		// Fallback faces are hinted too, if they support it.
+		if h, ok := f.chain[1].(*hintingFace); !ok || h.hinting != HintingNone {
+			t.Errorf("%v: got fallback face %v, expected a face without hinting", font, f.chain[1])
+		}
+		if f.chain[2] != plain {
+			t.Errorf("%v: got fallback face %v, expected the registry fallback", font, f.chain[2])
+		}
+	}
+	// Hinted faces are created once.
+	if len(hf.hinted) != 1 || len(fb.hinted) != 1 {
+		t.Errorf("got hinted faces %v and %v, expected one each", hf.hinted, fb.hinted)
+	}
+}
+
+func TestSubpixelShape(t *testing.T) {
+	latin := &testFace{runes: "ab", size: fixed.I(10)}
+	shaper := new(FontRegistry)
+	shaper.Register(Font{}, latin)
+	lines := shaper.LayoutString(Font{}, fixed.I(10), 1000, Spacing{}, "ab")
+	tests := []struct {
+		x      fixed.Int26_6
+		origin int
+		exp    []outlineCall
+	}{
+		// Lines at whole pixels are shaped by the face itself.
+		{fixed.I(10) + 6, 10, nil},
+		{fixed.I(10) + 19, 10, []outlineCall{{off: f32.Pt(.25, 0), text: "ab"}}},
+		// Offsets of the same subpixel step are cached.
+		{fixed.I(3) + 22, 3, nil},
+		{fixed.I(-2) + 42, -2, []outlineCall{{off: f32.Pt(.75, 0), text: "ab"}}},
+		// Offsets that round to a whole pixel start at the next
+		// pixel.
+		{fixed.I(10) + 58, 11, nil},
+		{fixed.I(-1) + 60, 0, nil},
+	}
+	for _, test := range tests {
+		if got := LineOrigin(test.x); got != test.origin {
+			t.Errorf("x %v: got origin %d, expected %d", test.x, got, test.origin)
+		}
+		latin.outlines = nil
+		shaper.ShapeString(Font{}, fixed.I(10), test.x, "ab", lines[0].Layout)
+		if len(latin.outlines) != len(test.exp) || len(test.exp) > 0 && latin.outlines[0] != test.exp[0] {
+			t.Errorf("x %v: got outlines %v, expected %v", test.x, latin.outlines, test.exp)
+		}
+	}
+}
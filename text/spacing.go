@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"golang.org/x/image/math/fixed"
+)
+
+// Spacing adjusts the spacing of laid out text. The zero Spacing
+// leaves the text as laid out by its Face.
+type Spacing struct {
+	// LineHeight scales the height of lines. The extra height is
+	// divided evenly above and below the text of the lines. Zero
+	// means the line height of the font.
+	LineHeight float32
+	// Letter is added to the advance of every cluster, in pixels.
+	Letter fixed.Int26_6
+	// Paragraph is added below every line that ends a paragraph,
+	// in pixels.
+	Paragraph fixed.Int26_6
+}
+
+// spaceLetters adds the letter spacing to the clusters of lines. The
+// spacing follows the cluster in the direction of its text.
+func spaceLetters(lines []Line, letter fixed.Int26_6) {
+	for i := range lines {
+		l := &lines[i]
+		glyphs := l.Layout
+		overhang := l.Bounds.Max.X - (l.Width - lastAdvance(glyphs))
+		for j := 0; j < len(glyphs); {
+			end := j + 1
+			for end < len(glyphs) && glyphs[end].Continuation {
+				end++
+			}
+			if glyphs[j].Rune != '\n' {
+				// The visually last glyph of the cluster.
+				k := end - 1
+				if glyphs[j].Level&1 == 1 {
+					k = j
+				}
+				glyphs[k].Advance += letter
+				l.Width += letter
+			}
+			j = end
+		}
+		l.Bounds.Max.X = l.Width - lastAdvance(glyphs) + overhang
+	}
+}
+
+// spaceLines adjusts the heights of lines according to the line
+// height and paragraph spacing of sp.
+func spaceLines(lines []Line, sp Spacing) {
+	for i := range lines {
+		l := &lines[i]
+		if sp.LineHeight != 0 {
+			h := l.Ascent + l.Descent
+			extra := fixed.Int26_6(float32(h)*sp.LineHeight) - h
+			l.Ascent += extra / 2
+			l.Descent += extra - extra/2
+		}
+		if n := len(l.Layout); n > 0 && l.Layout[n-1].Rune == '\n' {
+			l.Descent += sp.Paragraph
+		}
+	}
+}
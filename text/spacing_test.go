@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestSpacing(t *testing.T) {
+	face := &testFace{size: fixed.I(10)}
+	shaper := new(FontRegistry)
+	shaper.Register(Font{}, face)
+	sp := Spacing{LineHeight: 2, Letter: fixed.I(5), Paragraph: fixed.I(3)}
+	// The letter spacing wraps "ab cd" that would fit on a line
+	// without it.
+	lines := shaper.LayoutString(Font{}, fixed.I(10), 50, sp, "ab cd\ne")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, expected 3", len(lines))
+	}
+	if w, exp := lines[0].Width, fixed.I(3*15); w != exp {
+		t.Errorf("got width %v, expected %v", w, exp)
+	}
+	// Lines are twice as high, with the extra height divided above
+	// and below.
+	plain := shaper.LayoutString(Font{}, fixed.I(10), 50, Spacing{}, "e")[0]
+	if a, exp := lines[2].Ascent, plain.Ascent+(plain.Ascent+plain.Descent)/2; a != exp {
+		t.Errorf("got ascent %v, expected %v", a, exp)
+	}
+	if d, exp := lines[1].Descent-lines[0].Descent, sp.Paragraph; d != exp {
+		t.Errorf("got paragraph spacing %v, expected %v", d, exp)
+	}
+}
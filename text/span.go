@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"gioui.org/internal/bidi"
+	"golang.org/x/image/math/fixed"
+)
+
+// Span is a run of text in a single font and size.
+type Span struct {
+	Font Font
+	Size fixed.Int26_6
+	Text string
+}
+
+// SpanLine contains the measurements of a line of spans.
+type SpanLine struct {
+	// Runs are the parts of the spans on the line in visual
+	// order, from left to right. A span is split into several runs
+	// if its text changes direction.
+	Runs []Run
+	// Width is the width of the line.
+	Width fixed.Int26_6
+	// Ascent is the largest height above the baseline of the
+	// spans on the line.
+	Ascent fixed.Int26_6
+	// Descent is the largest height below the baseline of the
+	// spans on the line, including the line gap.
+	Descent fixed.Int26_6
+	// Bounds is the visible bounds of the line.
+	Bounds fixed.Rectangle26_6
+	// Direction is the base direction of the paragraph of the
+	// line.
+	Direction Direction
+}
+
+// Run is the part of a span on a single line.
+type Run struct {
+	// Span is the index of the span.
+	Span int
+	// Start and End are the UTF8 byte offsets of the run in
+	// the span text.
+	Start, End int
+	// X is the position of the run relative to the start of
+	// the line.
+	X fixed.Int26_6
+	// Width is the width of the run.
+	Width  fixed.Int26_6
+	Layout []Glyph
+}
+
+// spanGlyph is a glyph of a span.
+type spanGlyph struct {
+	Glyph
+	// span is the index of the span, or the index of the face
+	// in a fallback chain.
+	span int
+	// off is the byte offset of the glyph in the span text.
+	off int
+}
+
+// spanMetrics are the line measurements of a span or face.
+type spanMetrics struct {
+	ascent, descent fixed.Int26_6
+	bounds          fixed.Rectangle26_6
+	// overhang is the width of the visible bounds that extends
+	// beyond the advance of the final glyph.
+	overhang fixed.Int26_6
+}
+
+// LayoutSpans lays out a sequence of spans as a paragraph. Lines are
+// broken at newlines and wrapped to fit within maxWidth, regardless of
+// the span boundaries. Like Shaper.Layout, the last line is empty if
+// the text ends in a newline.
+//
+// The glyphs of a run are shaped by passing its Layout to the Shaper
+// along with the Font and Size of its span.
+func LayoutSpans(s Shaper, spans []Span, maxWidth int) []SpanLine {
+	if len(spans) == 0 {
+		return nil
+	}
+	// Measure the spans without wrapping.
+	var glyphs []spanGlyph
+	metrics := make([]spanMetrics, len(spans))
+	for i, sp := range spans {
+		off := 0
+		for j, l := range s.LayoutString(sp.Font, sp.Size, inf, Spacing{}, sp.Text) {
+			if j == 0 {
+				metrics[i] = spanMetrics{
+					ascent:   l.Ascent,
+					descent:  l.Descent,
+					bounds:   l.Bounds,
+					overhang: l.Bounds.Max.X - l.Width,
+				}
+			}
+			for _, g := range l.Layout {
+				glyphs = append(glyphs, spanGlyph{Glyph: g, span: i, off: off})
+				off += g.ClusterLen()
+			}
+		}
+	}
+	var lines []SpanLine
+	glines := breakLines(glyphs, maxWidth)
+	dirs := resolveSpanDirections(glines)
+	for i, l := range glines {
+		// Empty lines take their measurements from the last span.
+		line := spanLine(metrics, l, len(spans)-1)
+		line.Direction = dirs[i]
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// resolveSpanDirections resolves the embedding levels of lines of
+// glyphs and returns the direction of every line.
+func resolveSpanDirections(glines [][]spanGlyph) []Direction {
+	lines := make([]Line, len(glines))
+	for i, l := range glines {
+		lines[i].Layout = make([]Glyph, len(l))
+		for j, g := range l {
+			lines[i].Layout[j] = g.Glyph
+		}
+	}
+	ResolveDirections(lines)
+	dirs := make([]Direction, len(lines))
+	for i, l := range lines {
+		dirs[i] = l.Direction
+		for j, g := range l.Layout {
+			glines[i][j].Level = g.Level
+		}
+	}
+	return dirs
+}
+
+// breakLines splits glyphs into lines at mandatory breaks such as
+// newlines and wraps them to fit within maxWidth. The last line is
+// empty if the glyphs end in a newline.
+func breakLines(glyphs []spanGlyph, maxWidth int) [][]spanGlyph {
+	glyph := func(i int) Glyph { return glyphs[i].Glyph }
+	var lines [][]spanGlyph
+	start := 0
+	for _, end := range lineEnds(len(glyphs), glyph, maxWidth, WrapHeuristically) {
+		lines = append(lines, glyphs[start:end])
+		start = end
+	}
+	if n := len(glyphs); n > 0 && glyphs[n-1].Rune == '\n' {
+		lines = append(lines, glyphs[n:])
+	}
+	return lines
+}
+
+// spanLine returns the line of glyphs. The span with index def
+// determines the measurements of empty lines.
+func spanLine(metrics []spanMetrics, glyphs []spanGlyph, def int) SpanLine {
+	if len(glyphs) > 0 {
+		def = glyphs[0].span
+	}
+	m := metrics[def]
+	line := SpanLine{
+		Ascent:  m.ascent,
+		Descent: m.descent,
+		Bounds:  m.bounds,
+	}
+	line.Bounds.Max.X = m.overhang
+	// Split the glyphs into runs of the same span and level.
+	var levels []uint8
+	rtl := false
+	for len(glyphs) > 0 {
+		g := glyphs[0]
+		run := Run{
+			Span:  g.span,
+			Start: g.off,
+			End:   g.off,
+		}
+		for len(glyphs) > 0 && glyphs[0].span == run.Span && glyphs[0].Level == g.Level {
+			g := glyphs[0]
+			glyphs = glyphs[1:]
+			run.Layout = append(run.Layout, g.Glyph)
+			run.End += g.ClusterLen()
+			run.Width += g.Advance
+		}
+		line.Runs = append(line.Runs, run)
+		levels = append(levels, g.Level)
+		if g.Level&1 == 1 {
+			rtl = true
+		}
+	}
+	if rtl {
+		runs := make([]Run, len(line.Runs))
+		for i, idx := range bidi.Reorder(levels) {
+			runs[i] = line.Runs[idx]
+		}
+		line.Runs = runs
+	}
+	for i := range line.Runs {
+		run := &line.Runs[i]
+		run.X = line.Width
+		line.Width += run.Width
+		m := metrics[run.Span]
+		if m.ascent > line.Ascent {
+			line.Ascent = m.ascent
+		}
+		if m.descent > line.Descent {
+			line.Descent = m.descent
+		}
+		if y := m.bounds.Min.Y; y < line.Bounds.Min.Y {
+			line.Bounds.Min.Y = y
+		}
+		if y := m.bounds.Max.Y; y > line.Bounds.Max.Y {
+			line.Bounds.Max.Y = y
+		}
+		if x := run.X + m.bounds.Min.X; x < line.Bounds.Min.X {
+			line.Bounds.Min.X = x
+		}
+		line.Bounds.Max.X = line.Width + m.overhang
+	}
+	return line
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text_test
+
+import (
+	"testing"
+
+	"gioui.org/font/opentype"
+	"gioui.org/text"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestLayoutSpans(t *testing.T) {
+	face, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shaper := new(text.FontRegistry)
+	shaper.Register(text.Font{}, face)
+	spans := []text.Span{
+		{Size: fixed.I(10), Text: "small text "},
+		{Size: fixed.I(20), Text: "large text\n"},
+		{Size: fixed.I(10), Text: "end"},
+	}
+	single := text.LayoutSpans(shaper, spans[:2], 1000)
+	if n := len(single); n != 2 {
+		t.Fatalf("got %d lines, expected 2", n)
+	}
+	if n := len(single[0].Runs); n != 2 {
+		t.Fatalf("got %d runs, expected 2", n)
+	}
+	small, large := single[0].Runs[0], single[0].Runs[1]
+	if large.X != small.Width {
+		t.Errorf("second run at %v, expected %v", large.X, small.Width)
+	}
+	if w := small.Width + large.Width; single[0].Width != w {
+		t.Errorf("got width %v, expected %v", single[0].Width, w)
+	}
+	lm := shaper.Metrics(text.Font{}, fixed.I(20))
+	if single[0].Ascent != lm.Ascent {
+		t.Errorf("got ascent %v, expected the ascent %v of the large span", single[0].Ascent, lm.Ascent)
+	}
+	// Wrap in the middle of the second span.
+	width := (small.Width + large.Width*3/4).Ceil()
+	lines := text.LayoutSpans(shaper, spans, width)
+	var got []string
+	for _, l := range lines {
+		var s string
+		for _, r := range l.Runs {
+			s += spans[r.Span].Text[r.Start:r.End]
+		}
+		got = append(got, s)
+		if l.Width > fixed.I(width) {
+			t.Errorf("line %q is wider than %d", s, width)
+		}
+	}
+	exp := []string{"small text large ", "text\n", "end"}
+	if len(got) != len(exp) {
+		t.Fatalf("got lines %q, expected %q", got, exp)
+	}
+	for i := range exp {
+		if got[i] != exp[i] {
+			t.Errorf("got line %d %q, expected %q", i, got[i], exp[i])
+		}
+	}
+	if a := lines[2].Ascent; a >= lm.Ascent {
+		t.Errorf("last line ascent %v is not smaller than %v", a, lm.Ascent)
+	}
+}
@@ -4,8 +4,11 @@ package text
 
 import (
 	"io"
+	"unicode/utf8"
 
+	"gioui.org/f32"
 	"gioui.org/op"
+	"gioui.org/op/clip"
 	"golang.org/x/image/font"
 	"golang.org/x/image/math/fixed"
 )
@@ -24,13 +27,73 @@ type Line struct {
 	Descent fixed.Int26_6
 	// Bounds is the visible bounds of the line.
 	Bounds fixed.Rectangle26_6
+	// Direction is the base direction of the paragraph of the
+	// line.
+	Direction Direction
+	// Runs are the directional runs of the line in visual order,
+	// from left to right. Lines without runs are entirely left to
+	// right.
+	Runs []BidiRun
 }
 
+// Glyph is a shaped glyph of a line. The glyphs of a line are
+// grouped in clusters, each mapping a sequence of runes to one or
+// more glyphs. For example, a ligature is a cluster of several runes
+// and a single glyph.
 type Glyph struct {
-	Rune    rune
-	Advance fixed.Int26_6
+	// ID is the index of the glyph in its font.
+	ID GlyphID
+	// Rune is the first rune of the cluster of the glyph.
+	Rune rune
+	// Len is the length in UTF-8 bytes of the runes of the cluster
+	// starting with the glyph. A zero Len means utf8.RuneLen(Rune),
+	// the length of a cluster of a single rune. Len is ignored for
+	// continuation glyphs. Use ClusterLen for the length of the
+	// text of a glyph.
+	Len int
+	// Continuation marks a glyph that belongs to the cluster of
+	// the previous glyph, such as the second glyph of a rune
+	// decomposed into several glyphs.
+	Continuation bool
+	Advance      fixed.Int26_6
+	// Offset is the offset of the glyph from its pen position,
+	// such as for positioning marks. Positive Y is down.
+	Offset fixed.Point26_6
+	// Level is the bidirectional embedding level of the glyph.
+	// Glyphs at odd levels are displayed right to left.
+	Level uint8
+}
+
+// ClusterLen returns the length in UTF-8 bytes of the text of the
+// cluster starting with g, or zero if g is a continuation glyph.
+func (g Glyph) ClusterLen() int {
+	switch {
+	case g.Continuation:
+		return 0
+	case g.Len > 0:
+		return g.Len
+	}
+	if n := utf8.RuneLen(g.Rune); n > 0 {
+		return n
+	}
+	// Runes that can't be encoded in UTF-8 count as one byte.
+	return 1
+}
+
+// GlyphID is the index of a glyph in a font.
+type GlyphID uint16
+
+// BidiRun is a run of glyphs with the same embedding level.
+type BidiRun struct {
+	// Start and End are the indices of the run glyphs in
+	// Line.Layout.
+	Start, End int
+	Direction  Direction
 }
 
+// Direction is the direction of text.
+type Direction uint8
+
 // Style is the font style.
 type Style int
 
@@ -44,15 +107,127 @@ type Font struct {
 	Style    Style
 	// Weight is the text weight. If zero, Normal is used instead.
 	Weight Weight
+	// Hinting selects the hinting of the face of the font. If
+	// zero, the face's own hinting is used.
+	Hinting Hinting
 }
 
 // Face implements text layout and shaping for a particular font.
 type Face interface {
 	Layout(ppem fixed.Int26_6, maxWidth int, txt io.Reader) ([]Line, error)
+	// Shape returns the operations for drawing str. The glyphs
+	// drawn with the current brush are left as the clip path in
+	// effect after the operations, while color glyphs such as emoji
+	// are drawn by the operations themselves.
 	Shape(ppem fixed.Int26_6, str []Glyph) op.CallOp
 	Metrics(ppem fixed.Int26_6) font.Metrics
 }
 
+// FallbackFace is a Face that can be part of a chain of fallback
+// faces, where each rune is taken from the first face that has a
+// glyph for it.
+type FallbackFace interface {
+	Face
+	// HasGlyph reports whether the face has a glyph for r.
+	HasGlyph(r rune) bool
+	// Outline adds the outlines of str to p, with the start of
+	// the text at off.
+	Outline(p *clip.Path, ppem fixed.Int26_6, off f32.Point, str []Glyph)
+}
+
+// ColorFace is a FallbackFace with color glyphs, such as emoji.
+type ColorFace interface {
+	FallbackFace
+	// Paint adds the operations for drawing the color glyphs of str
+	// to ops, with the start of the text at off. The parts of the
+	// glyphs in the foreground color are drawn with the current
+	// brush. Outline leaves out the color glyphs.
+	Paint(ops *op.Ops, ppem fixed.Int26_6, off f32.Point, str []Glyph)
+}
+
+// VariableFace is a Face for a range of weights and styles, such as
+// a variable font.
+type VariableFace interface {
+	Face
+	// Instance returns the face for a weight and style, or nil if
+	// the face doesn't vary.
+	Instance(weight Weight, style Style) Face
+}
+
+// HintingFace is a Face with selectable hinting.
+type HintingFace interface {
+	Face
+	// Hinted returns the face with hinting h.
+	Hinted(h Hinting) Face
+}
+
+// FaceLoader loads faces for fonts on demand, such as from the fonts
+// installed on the system.
+type FaceLoader interface {
+	// Load returns the face for font, or nil if there is none.
+	Load(font Font) Face
+}
+
+// DecorationFace is a Face that knows the position and thickness of
+// the decoration lines of its text.
+type DecorationFace interface {
+	Face
+	DecorationMetrics(ppem fixed.Int26_6) DecorationMetrics
+}
+
+// DecorationMetrics are the vertical positions of the decoration lines
+// of a face, relative to the baseline. Positive Y is down.
+type DecorationMetrics struct {
+	// Underline is the position of the top of the underline and
+	// UnderlineThickness its thickness.
+	Underline, UnderlineThickness fixed.Int26_6
+	// Strikethrough is the position of the top of the
+	// strikethrough line and StrikethroughThickness its thickness.
+	Strikethrough, StrikethroughThickness fixed.Int26_6
+}
+
+// DefaultDecorationMetrics returns decoration metrics approximating
+// those of typical fonts, for faces and shapers that don't know the
+// metrics of their fonts.
+func DefaultDecorationMetrics(ppem fixed.Int26_6) DecorationMetrics {
+	thickness := ppem / 14
+	return DecorationMetrics{
+		Underline:              ppem / 10,
+		UnderlineThickness:     thickness,
+		Strikethrough:          -ppem/4 - thickness/2,
+		StrikethroughThickness: thickness,
+	}
+}
+
+// Decoration is a set of lines drawn along text.
+type Decoration uint8
+
+const (
+	// Underline draws a line below the baseline.
+	Underline Decoration = 1 << iota
+	// Strikethrough draws a line through the text.
+	Strikethrough
+)
+
+// Hinting is the fitting of text to the pixel grid. Hinted text is
+// crisper, but moves in steps of whole pixels when scaled or
+// scrolled.
+type Hinting uint8
+
+const (
+	// HintingDefault is the hinting of the face.
+	HintingDefault Hinting = iota
+	// HintingNone positions and measures text in fractions of
+	// pixels.
+	HintingNone
+	// HintingVertical rounds the vertical metrics to whole pixels,
+	// but not the advances of the glyphs.
+	HintingVertical
+	// HintingFull rounds both the vertical metrics and the advances
+	// of the glyphs to whole pixels.
+	HintingFull
+)
+
 // Typeface identifies a particular typeface design. The empty
 // string denotes the default typeface.
 type Typeface string
@@ -68,6 +243,28 @@ const (
 	Middle
 )
 
+// Truncation determines where an ellipsis replaces the text that
+// doesn't fit a limited number of lines.
+type Truncation uint8
+
+const (
+	// TruncateNone truncates the text without an ellipsis.
+	TruncateNone Truncation = iota
+	// TruncateEnd replaces the end of the text with an ellipsis.
+	TruncateEnd
+	// TruncateMiddle replaces the middle of the text with an
+	// ellipsis.
+	TruncateMiddle
+	// TruncateStart replaces the start of the text with an
+	// ellipsis.
+	TruncateStart
+)
+
+const (
+	LTR Direction = iota
+	RTL
+)
+
 const (
 	Regular Style = iota
 	Italic
@@ -91,3 +288,44 @@ func (a Alignment) String() string {
 		panic("unreachable")
 	}
 }
+
+func (t Truncation) String() string {
+	switch t {
+	case TruncateNone:
+		return "TruncateNone"
+	case TruncateEnd:
+		return "TruncateEnd"
+	case TruncateMiddle:
+		return "TruncateMiddle"
+	case TruncateStart:
+		return "TruncateStart"
+	default:
+		panic("unreachable")
+	}
+}
+
+func (h Hinting) String() string {
+	switch h {
+	case HintingDefault:
+		return "HintingDefault"
+	case HintingNone:
+		return "HintingNone"
+	case HintingVertical:
+		return "HintingVertical"
+	case HintingFull:
+		return "HintingFull"
+	default:
+		panic("unreachable")
+	}
+}
+
+func (d Direction) String() string {
+	switch d {
+	case LTR:
+		return "LTR"
+	case RTL:
+		return "RTL"
+	default:
+		panic("unreachable")
+	}
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import "testing"
+
+func TestGlyphClusterLen(t *testing.T) {
+	tests := []struct {
+		g   Glyph
+		len int
+	}{
+		// A zero Len is the length of the rune.
+		{Glyph{Rune: 'a'}, 1},
+		{Glyph{Rune: '語'}, 3},
+		// A ligature of several runes.
+		{Glyph{Rune: 'f', Len: 2}, 2},
+		{Glyph{Rune: 'a', Len: 3, Continuation: true}, 0},
+		{Glyph{Rune: 0xd800}, 1},
+	}
+	for _, test := range tests {
+		if got := test.g.ClusterLen(); got != test.len {
+			t.Errorf("%+v: got length %d, expected %d", test.g, got, test.len)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"unicode"
+
+	"gioui.org/internal/linebreak"
+	"golang.org/x/image/math/fixed"
+)
+
+// WrapPolicy determines where lines are wrapped.
+type WrapPolicy uint8
+
+const (
+	// WrapHeuristically wraps lines at the break opportunities of
+	// the Unicode line breaking algorithm, and breaks words too long
+	// to fit on a line between clusters.
+	WrapHeuristically WrapPolicy = iota
+	// WrapWords wraps lines at the break opportunities of the Unicode
+	// line breaking algorithm only. Words too long to fit on a line
+	// overflow it.
+	WrapWords
+)
+
+// WrapLines wraps every line of lines to fit within maxWidth and
+// returns the wrapped lines. The wrapped lines take their measurements
+// from the line they are part of, and their directions are resolved
+// as by ResolveDirections.
+//
+// Faces use WrapLines to wrap the lines of their paragraphs, so that
+// every Face breaks lines according to the Unicode line breaking
+// algorithm, https://unicode.org/reports/tr14/. Lines are broken only
+// between clusters and trailing spaces may extend beyond maxWidth.
+func WrapLines(lines []Line, maxWidth int, policy WrapPolicy) []Line {
+	var wrapped []Line
+	for _, l := range lines {
+		// overhang is the width of the bounds beyond the start of
+		// the final glyph.
+		overhang := l.Bounds.Max.X - (l.Width - lastAdvance(l.Layout))
+		glyph := func(i int) Glyph { return l.Layout[i] }
+		start := 0
+		for _, end := range lineEnds(len(l.Layout), glyph, maxWidth, policy) {
+			line := l
+			line.Layout = l.Layout[start:end:end]
+			line.Len, line.Width = 0, 0
+			for _, g := range line.Layout {
+				line.Len += g.ClusterLen()
+				line.Width += g.Advance
+			}
+			line.Bounds.Max.X = line.Width - lastAdvance(line.Layout) + overhang
+			wrapped = append(wrapped, line)
+			start = end
+		}
+	}
+	ResolveDirections(wrapped)
+	return wrapped
+}
+
+// lastAdvance returns the advance of the final glyph of a line, not
+// counting newlines.
+func lastAdvance(glyphs []Glyph) fixed.Int26_6 {
+	for i := len(glyphs) - 1; i >= 0; i-- {
+		if g := glyphs[i]; g.Rune != '\n' {
+			return g.Advance
+		}
+	}
+	return 0
+}
+
+// lineEnds wraps a sequence of n glyphs to fit within maxWidth and
+// returns the index following every line. The glyph function returns
+// glyph i. Lines end after mandatory breaks such as newlines, and
+// the final line ends at n.
+func lineEnds(n int, glyph func(i int) Glyph, maxWidth int, policy WrapPolicy) []int {
+	// Find the break opportunities between clusters from the first
+	// rune of every cluster.
+	var runes []rune
+	var starts []int
+	for i := 0; i < n; i++ {
+		if g := glyph(i); i == 0 || !g.Continuation {
+			runes = append(runes, g.Rune)
+			starts = append(starts, i)
+		}
+	}
+	starts = append(starts, n)
+	brks := linebreak.Breaks(runes)
+	maxX := fixed.I(maxWidth)
+	var ends []int
+	// x is the width of the line up to cluster c, and brk is the
+	// cluster following the most recent break opportunity of the
+	// line, at width brkX.
+	var x, brkX fixed.Int26_6
+	start, brk := 0, 0
+	for c := 0; c < len(runes); {
+		var adv fixed.Int26_6
+		for i := starts[c]; i < starts[c+1]; i++ {
+			adv += glyph(i).Advance
+		}
+		// Spaces don't wrap, but hang beyond the end of the line.
+		if c > start && x+adv > maxX && !unicode.IsSpace(runes[c]) {
+			switch {
+			case brk > start:
+				ends = append(ends, starts[brk])
+				start, x = brk, x-brkX
+				brkX = 0
+				// Re-check the cluster on the new line.
+				continue
+			case policy == WrapHeuristically:
+				// Break off the overlong word.
+				ends = append(ends, starts[c])
+				start, brk, x, brkX = c, c, 0, 0
+			}
+		}
+		x += adv
+		c++
+		switch brks[c-1] {
+		case linebreak.Mandatory:
+			if c < len(runes) {
+				ends = append(ends, starts[c])
+				start, brk, x, brkX = c, c, 0, 0
+			}
+		case linebreak.Allowed:
+			brk, brkX = c, x
+		}
+	}
+	return append(ends, n)
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestWrapLines(t *testing.T) {
+	face := &testFace{size: fixed.I(10)}
+	tests := []struct {
+		text     string
+		maxWidth int
+		policy   WrapPolicy
+		lines    []string
+	}{
+		{"ab cd", 1000, WrapHeuristically, []string{"ab cd"}},
+		// Trailing spaces hang beyond the end of the line.
+		{"ab cd", 20, WrapHeuristically, []string{"ab ", "cd"}},
+		{"ab\ncd", 1000, WrapHeuristically, []string{"ab\n", "cd"}},
+		{"日本語", 20, WrapHeuristically, []string{"日本", "語"}},
+		{"example.com/path", 120, WrapHeuristically, []string{"example.com/", "path"}},
+		// Overlong words are broken if the policy allows it.
+		{"abcde fg", 30, WrapHeuristically, []string{"abc", "de ", "fg"}},
+		{"abcde fg", 30, WrapWords, []string{"abcde ", "fg"}},
+	}
+	// Glyphs without Len have the length of their rune.
+	for _, noLen := range []bool{false, true} {
+		face.noLen = noLen
+		for _, test := range tests {
+			lines, _ := face.Layout(fixed.I(10), test.maxWidth, strings.NewReader(test.text))
+			lines = WrapLines(lines, test.maxWidth, test.policy)
+			var got []string
+			for _, l := range lines {
+				var txt []rune
+				var w fixed.Int26_6
+				for _, g := range l.Layout {
+					txt = append(txt, g.Rune)
+					w += g.Advance
+				}
+				if l.Width != w || l.Len != len(string(txt)) {
+					t.Errorf("%q: line %q has width %v and length %d", test.text, string(txt), l.Width, l.Len)
+				}
+				got = append(got, string(txt))
+			}
+			if strings.Join(got, "|") != strings.Join(test.lines, "|") {
+				t.Errorf("%q: got lines %q, expected %q", test.text, got, test.lines)
+			}
+		}
+	}
+}
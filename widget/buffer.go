@@ -177,3 +177,43 @@ func (e *editBuffer) runeAt(idx int) (rune, int) {
 	}
 	return utf8.DecodeRune(e.text[idx:])
 }
+
+// runeOffset returns the number of runes before the byte offset
+// off.
+func (e *editBuffer) runeOffset(off int) int {
+	var runes int
+	for idx := 0; idx < off; runes++ {
+		_, s := e.runeAt(idx)
+		idx += s
+	}
+	return runes
+}
+
+// byteOffset returns the byte offset of the rune at the rune offset
+// runes, or the buffer length if runes is past the end of the buffer.
+func (e *editBuffer) byteOffset(runes int) int {
+	var idx int
+	for ; runes > 0 && idx < e.len(); runes-- {
+		_, s := e.runeAt(idx)
+		idx += s
+	}
+	return idx
+}
+
+// substring returns the text between the byte offsets start and end.
+func (e *editBuffer) substring(start, end int) string {
+	var b strings.Builder
+	b.Grow(end - start)
+	if start < e.gapstart {
+		n := end
+		if n > e.gapstart {
+			n = e.gapstart
+		}
+		b.Write(e.text[start:n])
+		start = n
+	}
+	if end > start {
+		b.Write(e.text[start+e.gapLen() : end+e.gapLen()])
+	}
+	return b.String()
+}
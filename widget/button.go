@@ -23,6 +23,9 @@ type Clickable struct {
 	// clicks bounded.
 	prevClicks int
 	history    []Press
+
+	focused      bool
+	requestFocus bool
 }
 
 // Click represents a click.
@@ -59,6 +62,18 @@ func (b *Clickable) Clicks() []Click {
 	return clicks
 }
 
+// Focus requests the keyboard focus for the Clickable. A focused
+// Clickable is clicked by the return, enter and space keys.
+// Clickables are also focused by Tab, but never by default.
+func (b *Clickable) Focus() {
+	b.requestFocus = true
+}
+
+// Focused reports whether the Clickable has the keyboard focus.
+func (b *Clickable) Focused() bool {
+	return b.focused
+}
+
 // History is the past pointer presses useful for drawing markers.
 // History is retained for a short duration (about a second).
 func (b *Clickable) History() []Press {
@@ -70,6 +85,9 @@ func (b *Clickable) Layout(gtx layout.Context) layout.Dimensions {
 	stack := op.Push(gtx.Ops)
 	pointer.Rect(image.Rectangle{Max: gtx.Constraints.Min}).Add(gtx.Ops)
 	b.click.Add(gtx.Ops)
+	// Don't take the focus from text inputs by default.
+	key.InputOp{Tag: b, Focus: b.requestFocus, NoDefaultFocus: true}.Add(gtx.Ops)
+	b.requestFocus = false
 	stack.Pop()
 	for len(b.history) > 0 {
 		c := b.history[0]
@@ -103,4 +121,18 @@ func (b *Clickable) update(gtx layout.Context) {
 			})
 		}
 	}
+	for _, e := range gtx.Events(b) {
+		switch e := e.(type) {
+		case key.FocusEvent:
+			b.focused = e.Focus
+		case key.Event:
+			switch e.Name {
+			case key.NameReturn, key.NameEnter, key.NameSpace:
+				b.clicks = append(b.clicks, Click{
+					Modifiers: e.Modifiers,
+					NumClicks: 1,
+				})
+			}
+		}
+	}
 }
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget_test
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/widgettest"
+)
+
+func TestClickableDefaultFocus(t *testing.T) {
+	btn := new(widget.Clickable)
+	e := new(widget.Editor)
+	shaper := newShaper(t)
+	h := widgettest.New(image.Pt(300, 100), func(gtx layout.Context) layout.Dimensions {
+		// The button precedes the editor.
+		btn.Layout(gtx)
+		return e.Layout(gtx, shaper, text.Font{}, unit.Px(10))
+	})
+	defer h.Release()
+	h.Frame()
+
+	h.Type("hello")
+	if got, exp := e.Text(), "hello"; got != exp {
+		t.Errorf("got editor text %q, expected %q", got, exp)
+	}
+	if btn.Focused() {
+		t.Error("the button took the focus by default")
+	}
+	// Tab moves the focus to the button.
+	h.Key(key.NameTab, 0)
+	if !btn.Focused() {
+		t.Error("Tab didn't focus the button")
+	}
+	h.Type("world")
+	if got, exp := e.Text(), "hello"; got != exp {
+		t.Errorf("got editor text %q after moving the focus, expected %q", got, exp)
+	}
+}
+
+func TestClickableKeys(t *testing.T) {
+	btn := new(widget.Clickable)
+	h := widgettest.New(image.Pt(100, 100), func(gtx layout.Context) layout.Dimensions {
+		return btn.Layout(gtx)
+	})
+	defer h.Release()
+	h.Frame()
+	h.Key(key.NameSpace, 0)
+	if btn.Clicked() {
+		t.Error("an unfocused button was clicked by a key")
+	}
+
+	btn.Focus()
+	// The focus event is delivered in the frame after the request.
+	h.Frame()
+	h.Frame()
+	if !btn.Focused() {
+		t.Fatal("the button didn't gain the focus")
+	}
+	tests := []struct {
+		name   string
+		clicks int
+	}{
+		{key.NameReturn, 1},
+		{key.NameEnter, 1},
+		{key.NameSpace, 1},
+		{"A", 0},
+	}
+	for _, test := range tests {
+		h.Key(test.name, key.ModShift)
+		clicks := btn.Clicks()
+		if len(clicks) != test.clicks {
+			t.Errorf("%s: got %d clicks, expected %d", test.name, len(clicks), test.clicks)
+			continue
+		}
+		for _, c := range clicks {
+			if c.NumClicks != 1 || c.Modifiers != key.ModShift {
+				t.Errorf("%s: got click %+v, expected a single click with shift", test.name, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"gioui.org/text"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// visualGlyphs returns the indices of the glyphs of a line in visual
+// order.
+func visualGlyphs(l text.Line) []int {
+	order := make([]int, 0, len(l.Layout))
+	if len(l.Runs) == 0 {
+		for i := range l.Layout {
+			order = append(order, i)
+		}
+		return order
+	}
+	for _, r := range l.Runs {
+		if r.Direction == text.LTR {
+			for i := r.Start; i < r.End; i++ {
+				order = append(order, i)
+			}
+		} else {
+			for i := r.End - 1; i >= r.Start; i-- {
+				order = append(order, i)
+			}
+		}
+	}
+	return order
+}
+
+// glyphPositions returns the position of the left edge of every glyph
+// of a line, relative to the start of the line. The positions are in
+// logical order.
+func glyphPositions(l text.Line) []fixed.Int26_6 {
+	pos := make([]fixed.Int26_6, len(l.Layout))
+	var x fixed.Int26_6
+	for _, i := range visualGlyphs(l) {
+		pos[i] = x
+		x += l.Layout[i].Advance
+	}
+	return pos
+}
+
+// selectionSpans returns the horizontal extents of the glyphs of a line
+// between the byte offsets start and end, relative to the start of the
+// line. Extents of glyphs next to each other on the screen are merged.
+func selectionSpans(l text.Line, start, end int) [][2]fixed.Int26_6 {
+	// offs are the offsets of the clusters of the glyphs.
+	offs := make([]int, len(l.Layout))
+	off, cluster := 0, 0
+	for i, g := range l.Layout {
+		if !g.Continuation {
+			cluster = off
+		}
+		offs[i] = cluster
+		off += g.ClusterLen()
+	}
+	var spans [][2]fixed.Int26_6
+	var x fixed.Int26_6
+	for _, i := range visualGlyphs(l) {
+		x0 := x
+		x += l.Layout[i].Advance
+		if offs[i] < start || offs[i] >= end {
+			continue
+		}
+		if n := len(spans); n > 0 && spans[n-1][1] == x0 {
+			spans[n-1][1] = x
+		} else {
+			spans = append(spans, [2]fixed.Int26_6{x0, x})
+		}
+	}
+	return spans
+}
+
+// caretPositions returns the position of a caret before each of the
+// first n+1 glyphs of a line, relative to the start of the line. A
+// caret before a glyph is at its leading edge: the left edge for left
+// to right glyphs and the right edge for right to left glyphs. A caret
+// after the final glyph is at its trailing edge.
+func caretPositions(l text.Line, n int) []fixed.Int26_6 {
+	pos := glyphPositions(l)
+	carets := make([]fixed.Int26_6, n+1)
+	for col := range carets {
+		switch {
+		case col < len(l.Layout):
+			g := l.Layout[col]
+			carets[col] = pos[col]
+			if g.Level&1 == 1 {
+				carets[col] += g.Advance
+			}
+		case len(l.Layout) > 0:
+			last := len(l.Layout) - 1
+			g := l.Layout[last]
+			carets[col] = pos[last]
+			if g.Level&1 == 0 {
+				carets[col] += g.Advance
+			}
+		}
+	}
+	return carets
+}
+
+// closestCaret returns the column among the first n+1 caret positions
+// of a line that is closest to x, and its position.
+func closestCaret(l text.Line, x fixed.Int26_6, n int) (int, fixed.Int26_6) {
+	carets := caretPositions(l, n)
+	col := 0
+	for c, cx := range carets {
+		if !isCaretCol(l, c) {
+			continue
+		}
+		if abs26_6(cx-x) < abs26_6(carets[col]-x) {
+			col = c
+		}
+	}
+	return col, carets[col]
+}
+
+// adjacentCaret returns the column among the first n+1 caret positions
+// of a line that is visually closest to the caret at col, to the right
+// if right is set and to the left otherwise. It returns false if there
+// is no such column.
+func adjacentCaret(l text.Line, col, n int, right bool) (int, bool) {
+	carets := caretPositions(l, n)
+	x := carets[col]
+	next := -1
+	for c, cx := range carets {
+		if !isCaretCol(l, c) {
+			continue
+		}
+		d := cx - x
+		if !right {
+			d = -d
+		}
+		if d <= 0 {
+			continue
+		}
+		if next == -1 || abs26_6(cx-x) < abs26_6(carets[next]-x) {
+			next = c
+		}
+	}
+	return next, next != -1
+}
+
+// isCaretCol reports whether the caret can be placed before glyph
+// col of a line. The caret can't be placed inside clusters.
+func isCaretCol(l text.Line, col int) bool {
+	return col >= len(l.Layout) || col == 0 || !l.Layout[col].Continuation
+}
+
+// clusterOffset returns the byte offset of the caret column col of a
+// line, relative to the start of the line.
+func clusterOffset(l text.Line, col int) int {
+	off := 0
+	for _, g := range l.Layout[:col] {
+		off += g.ClusterLen()
+	}
+	return off
+}
+
+// lastCluster returns the column of the final cluster of a line.
+func lastCluster(l text.Line) int {
+	col := len(l.Layout) - 1
+	for col > 0 && l.Layout[col].Continuation {
+		col--
+	}
+	if col < 0 {
+		return 0
+	}
+	return col
+}
+
+func abs26_6(v fixed.Int26_6) fixed.Int26_6 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"unicode"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// decorationMetrics returns the decoration metrics of font from s, or
+// the default metrics if s doesn't know them.
+func decorationMetrics(s text.Shaper, font text.Font, size fixed.Int26_6) text.DecorationMetrics {
+	if s, ok := s.(text.DecorationShaper); ok {
+		return s.DecorationMetrics(font, size)
+	}
+	return text.DefaultDecorationMetrics(size)
+}
+
+// paintDecorations paints the decoration lines of a line of glyphs
+// with the current brush, clipped to bounds like the text. The
+// baseline of the glyphs starts at off.
+func paintDecorations(ops *op.Ops, deco text.Decoration, m text.DecorationMetrics, glyphs []text.Glyph, off f32.Point, bounds f32.Rectangle) {
+	if deco == 0 || len(glyphs) == 0 {
+		return
+	}
+	// Trailing spaces are not decorated. They end up at the left
+	// of lines that end at a right-to-left level.
+	var start, end fixed.Int26_6
+	n := len(glyphs)
+	for _, g := range glyphs {
+		end += g.Advance
+	}
+	for n > 0 && unicode.IsSpace(glyphs[n-1].Rune) {
+		n--
+		if glyphs[n].Level&1 == 1 {
+			start += glyphs[n].Advance
+		} else {
+			end -= glyphs[n].Advance
+		}
+	}
+	if start >= end {
+		return
+	}
+	defer op.Push(ops).Pop()
+	clip.Rect{Rect: bounds}.Add(ops)
+	paintLine := func(y, thickness fixed.Int26_6) {
+		// Align the line to the pixel grid.
+		top := float32((fixed.Int26_6(off.Y*64) + y).Round())
+		h := float32(thickness.Round())
+		if h < 1 {
+			h = 1
+		}
+		paint.PaintOp{Rect: f32.Rectangle{
+			Min: f32.Point{X: off.X + float32(start)/64, Y: top},
+			Max: f32.Point{X: off.X + float32(end)/64, Y: top + h},
+		}}.Add(ops)
+	}
+	if deco&text.Underline != 0 {
+		paintLine(m.Underline, m.UnderlineThickness)
+	}
+	if deco&text.Strikethrough != 0 {
+		paintLine(m.Strikethrough, m.StrikethroughThickness)
+	}
+}
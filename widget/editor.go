@@ -5,12 +5,15 @@ package widget
 import (
 	"image"
 	"math"
+	"sort"
 	"strings"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"gioui.org/f32"
 	"gioui.org/gesture"
+	"gioui.org/io/clipboard"
 	"gioui.org/io/key"
 	"gioui.org/io/pointer"
 	"gioui.org/layout"
@@ -25,6 +28,9 @@ import (
 // Editor implements an editable and scrollable text area.
 type Editor struct {
 	Alignment text.Alignment
+	// MaxHistory is the maximum number of changes kept for undo. If
+	// zero, the last 100 changes are kept.
+	MaxHistory int
 	// SingleLine force the text to stay on a single line.
 	// SingleLine also sets the scrolling direction to
 	// horizontal.
@@ -32,32 +38,50 @@ type Editor struct {
 	// Submit enabled translation of carriage return keys to SubmitEvents.
 	// If not enabled, carriage returns are inserted as newlines in the text.
 	Submit bool
+	// LineHeight scales the height of lines. Zero means the line
+	// height of the font.
+	LineHeight float32
+	// LetterSpacing is the extra space after every letter.
+	LetterSpacing unit.Value
+	// ParagraphSpacing is the extra space between paragraphs.
+	ParagraphSpacing unit.Value
+	// Decoration is the set of lines drawn along the text.
+	Decoration text.Decoration
 
 	eventKey     int
 	font         text.Font
 	shaper       text.Shaper
 	textSize     fixed.Int26_6
+	spacing      text.Spacing
 	blinkStart   time.Time
 	focused      bool
 	rr           editBuffer
 	maxWidth     int
 	viewSize     image.Point
 	valid        bool
-	lines        []text.Line
 	shapes       []line
 	dims         layout.Dimensions
 	requestFocus bool
 	caretOn      bool
 	caretScroll  bool
 
+	// paras caches the layout of the paragraphs of the text.
+	paras []paragraph
+
 	// carXOff is the offset to the current caret
 	// position when moving between lines.
 	carXOff fixed.Int26_6
+	// anchor is the byte offset of the end of the selection
+	// opposite the caret. The selection is empty if anchor
+	// equals the caret position.
+	anchor  int
+	history editHistory
 
 	scroller  gesture.Scroll
 	scrollOff image.Point
 
 	clicker gesture.Click
+	dragger gesture.Drag
 
 	// events is the list of events not yet processed.
 	events []EditorEvent
@@ -80,7 +104,12 @@ type SubmitEvent struct {
 
 type line struct {
 	offset f32.Point
+	// x is the start of the visible glyphs. The glyphs of clip
+	// are offset by the fraction of a pixel between offset.X
+	// and x.
+	x      float32
 	clip   op.CallOp
+	layout []text.Glyph
 }
 
 const (
@@ -113,7 +142,8 @@ func (e *Editor) processEvents(gtx layout.Context) {
 
 func (e *Editor) makeValid() {
 	if !e.valid {
-		e.lines, e.dims = e.layoutText(e.shaper)
+		e.layoutParagraphs()
+		e.dims = e.linesDimens()
 		e.valid = true
 	}
 }
@@ -147,12 +177,35 @@ func (e *Editor) processPointer(gtx layout.Context) {
 				X: int(math.Round(float64(evt.Position.X))),
 				Y: int(math.Round(float64(evt.Position.Y))),
 			})
+			// Shift-click extends the selection.
+			if !evt.Modifiers.Contain(key.ModShift) {
+				e.anchor = e.rr.caret
+			}
+			switch evt.NumClicks {
+			case 2:
+				e.selectWord()
+			case 3:
+				e.selectLine()
+			}
+			e.history.seal()
 			e.requestFocus = true
 			if e.scroller.State() != gesture.StateFlinging {
 				e.caretScroll = true
 			}
 		}
 	}
+	for _, evt := range e.dragger.Events(gtx) {
+		// Touch drags scroll the editor.
+		if evt.Type != pointer.Move || evt.Source != pointer.Mouse {
+			continue
+		}
+		e.blinkStart = gtx.Now()
+		e.moveCoord(gtx, image.Point{
+			X: int(math.Round(float64(evt.Position.X))),
+			Y: int(math.Round(float64(evt.Position.Y))),
+		})
+		e.caretScroll = true
+	}
 	if (sdist > 0 && soff >= smax) || (sdist < 0 && soff <= smin) {
 		e.scroller.Stop()
 	}
@@ -179,14 +232,18 @@ func (e *Editor) processKey(gtx layout.Context) {
 					return
 				}
 			}
-			if e.command(ke) {
+			if e.command(gtx, ke) {
 				e.caretScroll = true
 				e.scroller.Stop()
 			}
 		case key.EditEvent:
 			e.caretScroll = true
 			e.scroller.Stop()
-			e.append(ke.Text)
+			e.append(ke.Text, editTyping)
+		case clipboard.Event:
+			e.caretScroll = true
+			e.scroller.Stop()
+			e.append(ke.Text, editOther)
 		}
 		if e.rr.Changed() {
 			e.events = append(e.events, ChangeEvent{})
@@ -194,24 +251,66 @@ func (e *Editor) processKey(gtx layout.Context) {
 	}
 }
 
-func (e *Editor) command(k key.Event) bool {
+func (e *Editor) command(gtx layout.Context, k key.Event) bool {
+	if k.Modifiers.Contain(key.ModShortcut) {
+		switch k.Name {
+		case "A":
+			e.anchor = 0
+			e.rr.caret = e.rr.len()
+			return true
+		case "C", "X":
+			if text := e.SelectedText(); text != "" {
+				clipboard.WriteOp{Text: text}.Add(gtx.Ops)
+				if k.Name == "X" {
+					e.deleteSelection()
+				}
+			}
+			return true
+		case "V":
+			clipboard.ReadOp{Tag: &e.eventKey}.Add(gtx.Ops)
+			return true
+		case "Z":
+			if k.Modifiers.Contain(key.ModShift) {
+				e.Redo()
+			} else {
+				e.Undo()
+			}
+			return true
+		case "Y":
+			e.Redo()
+			return true
+		}
+	}
+	// Navigation with shift extends the selection.
+	extend := k.Modifiers.Contain(key.ModShift)
 	switch k.Name {
 	case key.NameReturn, key.NameEnter:
-		e.append("\n")
+		e.append("\n", editOther)
+		return true
 	case key.NameDeleteBackward:
-		e.Delete(-1)
+		e.deleteRunes(-1, editDeleting)
+		return true
 	case key.NameDeleteForward:
-		e.Delete(1)
+		e.deleteRunes(1, editDeleting)
+		return true
 	case key.NameUpArrow:
 		line, _, carX, _ := e.layoutCaret()
 		e.carXOff = e.moveToLine(carX+e.carXOff, line-1)
 	case key.NameDownArrow:
 		line, _, carX, _ := e.layoutCaret()
 		e.carXOff = e.moveToLine(carX+e.carXOff, line+1)
-	case key.NameLeftArrow:
-		e.Move(-1)
-	case key.NameRightArrow:
-		e.Move(1)
+	case key.NameLeftArrow, key.NameRightArrow:
+		start, end := e.selection()
+		switch {
+		case extend || start == end:
+			e.moveVisual(k.Name == key.NameRightArrow)
+		case k.Name == key.NameLeftArrow:
+			// Collapse the selection to its start.
+			e.rr.caret = start
+		default:
+			e.rr.caret = end
+		}
+		e.carXOff = 0
 	case key.NamePageUp:
 		e.movePages(-1)
 	case key.NamePageDown:
@@ -223,6 +322,11 @@ func (e *Editor) command(k key.Event) bool {
 	default:
 		return false
 	}
+	if !extend {
+		e.anchor = e.rr.caret
+	}
+	// Moving the caret ends a group of typing.
+	e.history.seal()
 	return true
 }
 
@@ -252,6 +356,10 @@ func (e *Editor) Layout(gtx layout.Context, sh text.Shaper, font text.Font, size
 		e.maxWidth = maxWidth
 		e.invalidate()
 	}
+	if sp := textSpacing(gtx, e.LineHeight, e.LetterSpacing, e.ParagraphSpacing); sp != e.spacing {
+		e.spacing = sp
+		e.invalidate()
+	}
 	if sh != e.shaper {
 		e.shaper = sh
 		e.invalidate()
@@ -277,24 +385,22 @@ func (e *Editor) layout(gtx layout.Context) layout.Dimensions {
 		X: -e.scrollOff.X,
 		Y: -e.scrollOff.Y,
 	}
-	clip := textPadding(e.lines)
+	clip := e.padding()
 	clip.Max = clip.Max.Add(e.viewSize)
-	it := lineIterator{
-		Lines:     e.lines,
-		Clip:      clip,
-		Alignment: e.Alignment,
-		Width:     e.viewSize.X,
-		Offset:    off,
-	}
+	// Shape the visible glyphs of the visible lines.
 	e.shapes = e.shapes[:0]
-	for {
-		_, _, layout, off, ok := it.Next()
-		if !ok {
-			break
-		}
-		path := e.shaper.Shape(e.font, e.textSize, layout)
-		e.shapes = append(e.shapes, line{off, path})
-	}
+	e.visibleLines(clip, off, func(p *paragraph, i, _ int, x fixed.Int26_6, y int) {
+		l := p.lines[i]
+		start, end, x := visibleGlyphs(l, x, clip)
+		e.shapes = append(e.shapes, line{
+			// The shaper offsets the glyphs by the fraction
+			// of a pixel of their start.
+			offset: f32.Point{X: float32(text.LineOrigin(x)), Y: float32(y)},
+			x:      float32(x) / 64,
+			clip:   e.shapeLine(p, i, start, end, x),
+			layout: l.Layout[start:end],
+		})
+	})
 
 	key.InputOp{Tag: &e.eventKey, Focus: e.requestFocus}.Add(gtx.Ops)
 	e.requestFocus = false
@@ -307,6 +413,7 @@ func (e *Editor) layout(gtx layout.Context) layout.Dimensions {
 	pointer.Rect(r).Add(gtx.Ops)
 	e.scroller.Add(gtx.Ops)
 	e.clicker.Add(gtx.Ops)
+	e.dragger.Add(gtx.Ops)
 	e.caretOn = false
 	if e.focused {
 		now := gtx.Now()
@@ -325,17 +432,56 @@ func (e *Editor) layout(gtx layout.Context) layout.Dimensions {
 }
 
 func (e *Editor) PaintText(gtx layout.Context) {
-	clip := textPadding(e.lines)
+	clip := e.padding()
 	clip.Max = clip.Max.Add(e.viewSize)
+	var deco text.DecorationMetrics
+	if e.Decoration != 0 {
+		deco = decorationMetrics(e.shaper, e.font, e.textSize)
+	}
 	for _, shape := range e.shapes {
 		stack := op.Push(gtx.Ops)
 		op.TransformOp{}.Offset(shape.offset).Add(gtx.Ops)
 		shape.clip.Add(gtx.Ops)
 		paint.PaintOp{Rect: layout.FRect(clip).Sub(shape.offset)}.Add(gtx.Ops)
 		stack.Pop()
+		doff := f32.Point{X: shape.x, Y: shape.offset.Y}
+		paintDecorations(gtx.Ops, e.Decoration, deco, shape.layout, doff, layout.FRect(clip))
 	}
 }
 
+// PaintSelection paints the highlight of the selected text
+// with the current brush.
+func (e *Editor) PaintSelection(gtx layout.Context) {
+	if !e.focused {
+		return
+	}
+	start, end := e.selection()
+	if start == end {
+		return
+	}
+	clip := e.padding()
+	clip.Max = clip.Max.Add(e.viewSize)
+	off := image.Point{
+		X: -e.scrollOff.X,
+		Y: -e.scrollOff.Y,
+	}
+	e.visibleLines(clip, off, func(p *paragraph, i, lineStart int, x fixed.Int26_6, y int) {
+		l := p.lines[i]
+		if lineStart+l.Len <= start || lineStart >= end {
+			return
+		}
+		for _, span := range selectionSpans(l, start-lineStart, end-lineStart) {
+			r := image.Rectangle{
+				Min: image.Point{X: (x + span[0]).Floor(), Y: y - l.Ascent.Ceil()},
+				Max: image.Point{X: (x + span[1]).Ceil(), Y: y + l.Descent.Ceil()},
+			}
+			if r = clip.Intersect(r); !r.Empty() {
+				paint.PaintOp{Rect: layout.FRect(r)}.Add(gtx.Ops)
+			}
+		}
+	})
+}
+
 func (e *Editor) PaintCaret(gtx layout.Context) {
 	if !e.caretOn {
 		return
@@ -345,7 +491,8 @@ func (e *Editor) PaintCaret(gtx layout.Context) {
 
 	stack := op.Push(gtx.Ops)
 	carX -= carWidth / 2
-	carAsc, carDesc := -e.lines[carLine].Bounds.Min.Y, e.lines[carLine].Bounds.Max.Y
+	l := e.line(carLine)
+	carAsc, carDesc := -l.Bounds.Min.Y, l.Bounds.Max.Y
 	carRect := image.Rectangle{
 		Min: image.Point{X: carX.Ceil(), Y: carY - carAsc.Ceil()},
 		Max: image.Point{X: carX.Ceil() + carWidth.Ceil(), Y: carY + carDesc.Ceil()},
@@ -354,7 +501,7 @@ func (e *Editor) PaintCaret(gtx layout.Context) {
 		X: -e.scrollOff.X,
 		Y: -e.scrollOff.Y,
 	})
-	clip := textPadding(e.lines)
+	clip := e.padding()
 	// Account for caret width to each side.
 	whalf := (carWidth / 2).Ceil()
 	if clip.Max.X < whalf {
@@ -381,18 +528,149 @@ func (e *Editor) Text() string {
 	return e.rr.String()
 }
 
-// SetText replaces the contents of the editor.
+// SetText replaces the contents of the editor and clears the undo
+// history.
 func (e *Editor) SetText(s string) {
 	e.rr = editBuffer{}
 	e.carXOff = 0
+	e.anchor = 0
+	e.history.clear()
 	e.prepend(s)
 }
 
+// Undo reverts the most recent change to the text and reports whether
+// there was a change to revert.
+func (e *Editor) Undo() bool {
+	h := &e.history
+	if len(h.undo) == 0 {
+		return false
+	}
+	r := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, r)
+	h.seal()
+	e.apply(r.offset, r.offset+len(r.inserted), r.deleted)
+	e.rr.caret, e.anchor = r.caret, r.anchor
+	e.caretScroll = true
+	return true
+}
+
+// Redo applies the most recently undone change and reports whether
+// there was a change to apply.
+func (e *Editor) Redo() bool {
+	h := &e.history
+	if len(h.redo) == 0 {
+		return false
+	}
+	r := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, r)
+	h.seal()
+	e.apply(r.offset, r.offset+len(r.deleted), r.inserted)
+	e.caretScroll = true
+	return true
+}
+
+// ClearHistory discards the undo and redo history.
+func (e *Editor) ClearHistory() {
+	e.history.clear()
+}
+
+// SelectionLen returns the length of the selection, in runes.
+func (e *Editor) SelectionLen() int {
+	start, end := e.selection()
+	return e.rr.runeOffset(end) - e.rr.runeOffset(start)
+}
+
+// Selection returns the start and end of the selection, as rune
+// offsets. The caret is at end, and start may be larger than end.
+func (e *Editor) Selection() (start, end int) {
+	return e.rr.runeOffset(e.anchor), e.rr.runeOffset(e.rr.caret)
+}
+
+// SetSelection selects the text between the rune offsets start and
+// end and moves the caret to end. Offsets past the end of the text
+// are clamped.
+func (e *Editor) SetSelection(start, end int) {
+	e.anchor = e.rr.byteOffset(start)
+	e.rr.caret = e.rr.byteOffset(end)
+	e.carXOff = 0
+	e.history.seal()
+}
+
+// SelectedText returns the selected text, if any.
+func (e *Editor) SelectedText() string {
+	start, end := e.selection()
+	return e.rr.substring(start, end)
+}
+
+// selection returns the ordered byte offsets of the selection.
+func (e *Editor) selection() (start, end int) {
+	start, end = e.anchor, e.rr.caret
+	if start > end {
+		start, end = end, start
+	}
+	return start, end
+}
+
+// deleteSelection deletes the selected text and reports whether
+// the selection was non-empty.
+func (e *Editor) deleteSelection() bool {
+	start, end := e.selection()
+	if start == end {
+		return false
+	}
+	e.replace(start, end, "", editOther)
+	return true
+}
+
+// selectWord selects the word or run of non-word runes around the
+// caret.
+func (e *Editor) selectWord() {
+	isWord := func(r rune) bool {
+		return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+	start, end := e.rr.caret, e.rr.caret
+	var word bool
+	switch {
+	case end < e.rr.len():
+		r, _ := e.rr.runeAt(end)
+		word = isWord(r)
+	case start > 0:
+		r, _ := e.rr.runeBefore(start)
+		word = isWord(r)
+	}
+	for start > 0 {
+		r, s := e.rr.runeBefore(start)
+		if r == '\n' || isWord(r) != word {
+			break
+		}
+		start -= s
+	}
+	for end < e.rr.len() {
+		r, s := e.rr.runeAt(end)
+		if r == '\n' || isWord(r) != word {
+			break
+		}
+		end += s
+	}
+	e.anchor, e.rr.caret = start, end
+	e.carXOff = 0
+}
+
+// selectLine selects the line containing the caret.
+func (e *Editor) selectLine() {
+	e.moveStart()
+	e.anchor = e.rr.caret
+	e.moveEnd()
+}
+
 func (e *Editor) scrollBounds() image.Rectangle {
 	var b image.Rectangle
 	if e.SingleLine {
-		if len(e.lines) > 0 {
-			b.Min.X = align(e.Alignment, e.lines[0].Width, e.viewSize.X).Floor()
+		if len(e.paras) > 0 {
+			l := e.line(0)
+			b.Min.X = align(e.Alignment, l.Direction, l.Width, e.viewSize.X).Floor()
 			if b.Min.X > 0 {
 				b.Min.X = 0
 			}
@@ -427,39 +705,39 @@ func (e *Editor) scrollAbs(x, y int) {
 }
 
 func (e *Editor) moveCoord(c unit.Converter, pos image.Point) {
-	var (
-		prevDesc fixed.Int26_6
-		carLine  int
-		y        int
-	)
-	for _, l := range e.lines {
-		y += (prevDesc + l.Ascent).Ceil()
-		prevDesc = l.Descent
-		if y+prevDesc.Ceil() >= pos.Y+e.scrollOff.Y {
-			break
-		}
-		carLine++
-	}
+	// Find the first line that extends to pos.
+	y := pos.Y + e.scrollOff.Y
+	carLine := sort.Search(e.numLines(), func(i int) bool {
+		return e.lineY(i)+e.line(i).Descent.Ceil() >= y
+	})
 	x := fixed.I(pos.X + e.scrollOff.X)
 	e.moveToLine(x, carLine)
 }
 
-func (e *Editor) layoutText(s text.Shaper) ([]text.Line, layout.Dimensions) {
-	e.rr.Reset()
-	lines, _ := s.Layout(e.font, e.textSize, e.maxWidth, &e.rr)
-	dims := linesDimens(lines)
-	for i := 0; i < len(lines)-1; i++ {
+func (e *Editor) linesDimens() layout.Dimensions {
+	first := e.paras[0].lines[0]
+	p := e.paras[len(e.paras)-1]
+	last := p.lines[len(p.lines)-1]
+	h := p.y + p.ys[len(p.ys)-1] + last.Descent.Ceil()
+	dims := layout.Dimensions{
+		Size:     image.Point{X: p.width.Ceil(), Y: h},
+		Baseline: h - first.Ascent.Ceil(),
+	}
+	if p.wrapped {
 		// To avoid layout flickering while editing, assume a soft newline takes
 		// up all available space.
-		if layout := lines[i].Layout; len(layout) > 0 {
-			r := layout[len(layout)-1].Rune
-			if r != '\n' {
-				dims.Size.X = e.maxWidth
-				break
-			}
-		}
+		dims.Size.X = e.maxWidth
 	}
-	return lines, dims
+	return dims
+}
+
+// padding returns the space the glyphs of the text extend beyond
+// its dimensions.
+func (e *Editor) padding() image.Rectangle {
+	if len(e.paras) == 0 {
+		return image.Rectangle{}
+	}
+	return textPadding([]text.Line{e.line(0), e.line(e.numLines() - 1)})
 }
 
 // CaretPos returns the line & column numbers of the caret.
@@ -476,55 +754,107 @@ func (e *Editor) CaretCoords() (x fixed.Int26_6, y int) {
 }
 
 func (e *Editor) layoutCaret() (carLine, carCol int, x fixed.Int26_6, y int) {
-	var idx int
-	var prevDesc fixed.Int26_6
-loop:
-	for carLine = 0; carLine < len(e.lines); carLine++ {
-		l := e.lines[carLine]
-		y += (prevDesc + l.Ascent).Ceil()
-		prevDesc = l.Descent
-		if carLine == len(e.lines)-1 || idx+len(l.Layout) > e.rr.caret {
-			for _, g := range l.Layout {
-				if idx == e.rr.caret {
-					break loop
-				}
-				x += g.Advance
-				idx += utf8.RuneLen(g.Rune)
-				carCol++
-			}
+	e.makeValid()
+	carLine, idx := e.lineAtOffset(e.rr.caret)
+	l := e.line(carLine)
+	for _, g := range l.Layout {
+		// A caret inside a cluster is placed after it.
+		if idx >= e.rr.caret {
 			break
 		}
-		idx += l.Len
+		idx += g.ClusterLen()
+		carCol++
 	}
-	x += align(e.Alignment, e.lines[carLine].Width, e.viewSize.X)
+	y = e.lineY(carLine)
+	x = caretPositions(l, carCol)[carCol]
+	x += align(e.Alignment, l.Direction, l.Width, e.viewSize.X)
 	return
 }
 
+// maxCol returns the last caret column of line i. Only the last line
+// has a caret column past its end.
+func (e *Editor) maxCol(i int) int {
+	l := e.line(i)
+	if i == e.numLines()-1 {
+		return len(l.Layout)
+	}
+	return lastCluster(l)
+}
+
+// invalidate discards the layout of the text.
 func (e *Editor) invalidate() {
+	e.paras = e.paras[:0]
 	e.valid = false
 }
 
 // Delete runes from the caret position. The sign of runes specifies the
-// direction to delete: positive is forward, negative is backward.
+// direction to delete: positive is forward, negative is backward. A
+// non-empty selection is deleted instead.
 func (e *Editor) Delete(runes int) {
-	e.rr.deleteRunes(runes)
-	e.carXOff = 0
-	e.invalidate()
+	e.deleteRunes(runes, editOther)
+}
+
+func (e *Editor) deleteRunes(runes int, kind editKind) {
+	if e.deleteSelection() {
+		return
+	}
+	start, end := e.rr.caret, e.rr.caret
+	for ; runes < 0 && start > 0; runes++ {
+		_, s := e.rr.runeBefore(start)
+		start -= s
+	}
+	for ; runes > 0 && end < e.rr.len(); runes-- {
+		_, s := e.rr.runeAt(end)
+		end += s
+	}
+	e.replace(start, end, "", kind)
 }
 
-// Insert inserts text at the caret, moving the caret forward.
+// Insert inserts text at the caret, moving the caret forward. The
+// inserted text replaces the selection.
 func (e *Editor) Insert(s string) {
-	e.append(s)
+	e.append(s, editOther)
 	e.caretScroll = true
-	e.invalidate()
 }
 
-func (e *Editor) append(s string) {
+func (e *Editor) append(s string, kind editKind) {
 	if e.SingleLine {
 		s = strings.ReplaceAll(s, "\n", "")
 	}
-	e.prepend(s)
+	start, end := e.selection()
+	e.replace(start, end, s, kind)
+}
+
+// replace replaces the text between the byte offsets start and end
+// with s and records the change in the undo history.
+func (e *Editor) replace(start, end int, s string, kind editKind) {
+	if start == end && s == "" {
+		return
+	}
+	e.history.record(editRecord{
+		kind:     kind,
+		offset:   start,
+		deleted:  e.rr.substring(start, end),
+		inserted: s,
+		caret:    e.rr.caret,
+		anchor:   e.anchor,
+	}, e.MaxHistory)
+	e.apply(start, end, s)
+}
+
+// apply replaces the text between the byte offsets start and end
+// with s and moves the caret to the end of s.
+func (e *Editor) apply(start, end int, s string) {
+	e.rr.caret = start
+	if end > start {
+		e.rr.deleteRunes(utf8.RuneCountInString(e.rr.substring(start, end)))
+	}
+	e.rr.prepend(s)
 	e.rr.caret += len(s)
+	e.anchor = e.rr.caret
+	e.carXOff = 0
+	e.editParagraphs(start, end, len(s))
+	e.valid = false
 }
 
 func (e *Editor) prepend(s string) {
@@ -536,115 +866,100 @@ func (e *Editor) prepend(s string) {
 func (e *Editor) movePages(pages int) {
 	_, _, carX, carY := e.layoutCaret()
 	y := carY + pages*e.viewSize.Y
-	var (
-		prevDesc fixed.Int26_6
-		carLine2 int
-	)
-	y2 := e.lines[0].Ascent.Ceil()
-	for i := 1; i < len(e.lines); i++ {
-		if y2 >= y {
-			break
-		}
-		l := e.lines[i]
-		h := (prevDesc + l.Ascent).Ceil()
-		prevDesc = l.Descent
-		if y2+h-y >= y-y2 {
-			break
-		}
-		y2 += h
-		carLine2++
+	// Move to the line with the baseline closest to y.
+	n := e.numLines()
+	carLine2 := sort.Search(n, func(i int) bool {
+		return e.lineY(i) >= y
+	})
+	switch {
+	case carLine2 == n:
+		carLine2 = n - 1
+	case carLine2 > 0 && e.lineY(carLine2)-y >= y-e.lineY(carLine2-1):
+		carLine2--
 	}
 	e.carXOff = e.moveToLine(carX+e.carXOff, carLine2)
 }
 
 func (e *Editor) moveToLine(carX fixed.Int26_6, carLine2 int) fixed.Int26_6 {
-	carLine, carCol, _, _ := e.layoutCaret()
 	if carLine2 < 0 {
 		carLine2 = 0
 	}
-	if carLine2 >= len(e.lines) {
-		carLine2 = len(e.lines) - 1
+	if n := e.numLines(); carLine2 >= n {
+		carLine2 = n - 1
 	}
-	// Move to start of line.
-	for i := carCol - 1; i >= 0; i-- {
-		_, s := e.rr.runeBefore(e.rr.caret)
-		e.rr.caret -= s
-	}
-	if carLine2 != carLine {
-		// Move to start of line2.
-		if carLine2 > carLine {
-			for i := carLine; i < carLine2; i++ {
-				e.rr.caret += e.lines[i].Len
-			}
-		} else {
-			for i := carLine - 1; i >= carLine2; i-- {
-				e.rr.caret -= e.lines[i].Len
-			}
-		}
-	}
-	l2 := e.lines[carLine2]
-	carX2 := align(e.Alignment, l2.Width, e.viewSize.X)
-	// Only move past the end of the last line
-	end := 0
-	if carLine2 < len(e.lines)-1 {
-		end = 1
-	}
-	// Move to rune closest to previous horizontal position.
-	for i := 0; i < len(l2.Layout)-end; i++ {
-		g := l2.Layout[i]
-		if carX2 >= carX {
-			break
-		}
-		if carX2+g.Advance-carX >= carX-carX2 {
-			break
-		}
-		carX2 += g.Advance
-		_, s := e.rr.runeAt(e.rr.caret)
-		e.rr.caret += s
-	}
-	return carX - carX2
+	l2 := e.line(carLine2)
+	carX2 := align(e.Alignment, l2.Direction, l2.Width, e.viewSize.X)
+	// Move to the caret position closest to the previous horizontal
+	// position.
+	col, x := closestCaret(l2, carX-carX2, e.maxCol(carLine2))
+	e.rr.caret = e.lineOffset(carLine2) + clusterOffset(l2, col)
+	return carX - (carX2 + x)
 }
 
 // Move the caret: positive distance moves forward, negative distance moves
-// backward.
+// backward. Move clears the selection.
 func (e *Editor) Move(distance int) {
 	e.rr.move(distance)
+	e.anchor = e.rr.caret
 	e.carXOff = 0
+	e.history.seal()
+}
+
+// moveVisual moves the caret one position to the right or to the left
+// on the screen. The caret moves across direction boundaries in visual
+// order and continues on the next or previous line at the edges of a
+// line.
+func (e *Editor) moveVisual(right bool) {
+	carLine, carCol, _, _ := e.layoutCaret()
+	l := e.line(carLine)
+	start := e.lineOffset(carLine)
+	if n := e.maxCol(carLine); carCol <= n {
+		if col, ok := adjacentCaret(l, carCol, n, right); ok {
+			e.rr.caret = start + clusterOffset(l, col)
+			return
+		}
+	}
+	// Leave the line in the direction of its paragraph.
+	forward := right != (l.Direction == text.RTL)
+	switch {
+	case forward && carLine < e.numLines()-1:
+		e.rr.caret = start + l.Len
+	case !forward && carLine > 0:
+		prev := e.line(carLine - 1)
+		e.rr.caret = start - prev.Len + clusterOffset(prev, e.maxCol(carLine-1))
+	}
 }
 
 func (e *Editor) moveStart() {
-	carLine, carCol, x, _ := e.layoutCaret()
-	layout := e.lines[carLine].Layout
-	for i := carCol - 1; i >= 0; i-- {
-		_, s := e.rr.runeBefore(e.rr.caret)
-		e.rr.caret -= s
-		x -= layout[i].Advance
+	carLine, _, _, _ := e.layoutCaret()
+	l := e.line(carLine)
+	e.rr.caret = e.lineOffset(carLine)
+	_, _, x, _ := e.layoutCaret()
+	// The start of right to left lines is at the right edge.
+	var start fixed.Int26_6
+	if l.Direction == text.RTL {
+		start = fixed.I(e.viewSize.X)
 	}
-	e.carXOff = -x
+	e.carXOff = start - x
 }
 
 func (e *Editor) moveEnd() {
-	carLine, carCol, x, _ := e.layoutCaret()
-	l := e.lines[carLine]
-	// Only move past the end of the last line
-	end := 0
-	if carLine < len(e.lines)-1 {
-		end = 1
-	}
-	layout := l.Layout
-	for i := carCol; i < len(layout)-end; i++ {
-		adv := layout[i].Advance
-		_, s := e.rr.runeAt(e.rr.caret)
-		e.rr.caret += s
-		x += adv
+	carLine, _, _, _ := e.layoutCaret()
+	l := e.line(carLine)
+	e.rr.caret = e.lineOffset(carLine) + clusterOffset(l, e.maxCol(carLine))
+	_, _, x, _ := e.layoutCaret()
+	a := align(e.Alignment, l.Direction, l.Width, e.viewSize.X)
+	// The end of right to left lines is at the left edge.
+	lineEnd := l.Width + a
+	if l.Direction == text.RTL {
+		lineEnd = a
 	}
-	a := align(e.Alignment, l.Width, e.viewSize.X)
-	e.carXOff = l.Width + a - x
+	e.carXOff = lineEnd - x
 }
 
 func (e *Editor) scrollToCaret() {
 	carLine, _, x, y := e.layoutCaret()
-	l := e.lines[carLine]
+	l := e.line(carLine)
 	if e.SingleLine {
 		var dist int
 		if d := x.Floor() - e.scrollOff.X; d < 0 {
@@ -669,7 +984,7 @@ func (e *Editor) scrollToCaret() {
 // NumLines returns the number of lines in the editor.
 func (e *Editor) NumLines() int {
 	e.makeValid()
-	return len(e.lines)
+	return e.numLines()
 }
 
 func (s ChangeEvent) isEditorEvent() {}
@@ -0,0 +1,461 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget_test
+
+import (
+	"image"
+	"image/color"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/font/opentype"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/widgettest"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/math/fixed"
+)
+
+// newShaper returns a shaper with the Go regular font.
+func newShaper(t *testing.T) text.Shaper {
+	t.Helper()
+	face, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shaper := new(text.FontRegistry)
+	shaper.Register(text.Font{}, face)
+	return shaper
+}
+
+func newEditorHarness(t *testing.T, e *widget.Editor) *widgettest.Harness {
+	t.Helper()
+	shaper := newShaper(t)
+	h := widgettest.New(image.Pt(300, 100), func(gtx layout.Context) layout.Dimensions {
+		return e.Layout(gtx, shaper, text.Font{}, unit.Px(10))
+	})
+	e.Focus()
+	h.Frame()
+	h.Frame()
+	return h
+}
+
+func TestEditorSelectKeys(t *testing.T) {
+	e := new(widget.Editor)
+	e.SetText("hello world")
+	h := newEditorHarness(t, e)
+	defer h.Release()
+
+	h.Key(key.NameEnd, 0)
+	h.Key(key.NameLeftArrow, key.ModShift)
+	h.Key(key.NameLeftArrow, key.ModShift)
+	if got, exp := e.SelectedText(), "ld"; got != exp {
+		t.Errorf("got selection %q, expected %q", got, exp)
+	}
+	if start, end := e.Selection(); start != 11 || end != 9 {
+		t.Errorf("got selection (%d, %d), expected (11, 9)", start, end)
+	}
+	// Typing replaces the selection.
+	h.Type("m")
+	if got, exp := e.Text(), "hello worm"; got != exp {
+		t.Errorf("got text %q, expected %q", got, exp)
+	}
+	if n := e.SelectionLen(); n != 0 {
+		t.Errorf("got selection length %d after typing, expected 0", n)
+	}
+
+	h.Key(key.NameHome, key.ModShift)
+	h.Key(key.NameDeleteBackward, 0)
+	if got := e.Text(); got != "" {
+		t.Errorf("got text %q after deleting the selection, expected empty text", got)
+	}
+}
+
+func TestEditorSelectAll(t *testing.T) {
+	e := new(widget.Editor)
+	e.SetText("hello\nworld")
+	h := newEditorHarness(t, e)
+	defer h.Release()
+
+	h.Key("A", key.ModShortcut)
+	if got, exp := e.SelectedText(), "hello\nworld"; got != exp {
+		t.Errorf("got selection %q, expected %q", got, exp)
+	}
+	// Left collapses the selection to its start.
+	h.Key(key.NameLeftArrow, 0)
+	if start, end := e.Selection(); start != 0 || end != 0 {
+		t.Errorf("got selection (%d, %d), expected (0, 0)", start, end)
+	}
+}
+
+func TestEditorClipboard(t *testing.T) {
+	e := new(widget.Editor)
+	e.SetText("hello world")
+	h := newEditorHarness(t, e)
+	defer h.Release()
+
+	e.SetSelection(0, 5)
+	h.Key("C", key.ModShortcut)
+	if h.Clipboard != "hello" {
+		t.Errorf("got clipboard %q after copy, expected %q", h.Clipboard, "hello")
+	}
+	e.SetSelection(5, 11)
+	h.Key("X", key.ModShortcut)
+	if got, exp := e.Text(), "hello"; got != exp {
+		t.Errorf("got text %q after cut, expected %q", got, exp)
+	}
+	if h.Clipboard != " world" {
+		t.Errorf("got clipboard %q after cut, expected %q", h.Clipboard, " world")
+	}
+	e.SetSelection(0, 0)
+	h.Key("V", key.ModShortcut)
+	// The clipboard content arrives in the next frame.
+	h.Frame()
+	if got, exp := e.Text(), " worldhello"; got != exp {
+		t.Errorf("got text %q after paste, expected %q", got, exp)
+	}
+}
+
+func TestEditorSelectMouse(t *testing.T) {
+	e := new(widget.Editor)
+	e.SetText("hello world\nsecond line")
+	h := newEditorHarness(t, e)
+	defer h.Release()
+
+	// Double-click selects a word.
+	pos := f32.Pt(40, 5)
+	h.Click(pos)
+	h.Click(pos)
+	if got, exp := e.SelectedText(), "world"; got != exp {
+		t.Errorf("got selection %q after double-click, expected %q", got, exp)
+	}
+	// Triple-click selects a line.
+	h.Click(pos)
+	if got, exp := e.SelectedText(), "hello world"; got != exp {
+		t.Errorf("got selection %q after triple-click, expected %q", got, exp)
+	}
+
+	// Dragging selects from the press to the release.
+	h.Advance(time.Second)
+	h.Drag(f32.Pt(0, 5), f32.Pt(300, 20), 3)
+	if got, exp := e.SelectedText(), "hello world\nsecond line"; got != exp {
+		t.Errorf("got selection %q after drag, expected %q", got, exp)
+	}
+}
+
+func TestEditorUndo(t *testing.T) {
+	e := new(widget.Editor)
+	h := newEditorHarness(t, e)
+	defer h.Release()
+
+	for _, s := range []string{"h", "e", "l", "l", "o", " ", "w", "o"} {
+		h.Type(s)
+	}
+	h.Key(key.NameDeleteBackward, 0)
+	h.Key(key.NameDeleteBackward, 0)
+	if got, exp := e.Text(), "hello "; got != exp {
+		t.Fatalf("got text %q, expected %q", got, exp)
+	}
+	// Consecutive deletions are undone together.
+	h.Key("Z", key.ModShortcut)
+	if got, exp := e.Text(), "hello wo"; got != exp {
+		t.Errorf("got text %q after undoing deletions, expected %q", got, exp)
+	}
+	// Typing is undone a word at a time.
+	h.Key("Z", key.ModShortcut)
+	if got, exp := e.Text(), "hello "; got != exp {
+		t.Errorf("got text %q after undoing a word, expected %q", got, exp)
+	}
+	h.Key("Z", key.ModShortcut)
+	if got := e.Text(); got != "" {
+		t.Errorf("got text %q after undoing all changes, expected empty text", got)
+	}
+	if e.Undo() {
+		t.Error("undo succeeded with empty history")
+	}
+	h.Key("Z", key.ModShortcut|key.ModShift)
+	if got, exp := e.Text(), "hello "; got != exp {
+		t.Errorf("got text %q after redo, expected %q", got, exp)
+	}
+	if line, col := e.CaretPos(); line != 0 || col != 6 {
+		t.Errorf("got caret at (%d, %d) after redo, expected (0, 6)", line, col)
+	}
+	// A new change clears the redo history.
+	h.Type("!")
+	if e.Redo() {
+		t.Error("redo succeeded after a change")
+	}
+}
+
+func TestEditorUndoSelection(t *testing.T) {
+	e := new(widget.Editor)
+	e.SetText("hello world")
+	h := newEditorHarness(t, e)
+	defer h.Release()
+
+	e.SetSelection(6, 11)
+	h.Type("there")
+	if got, exp := e.Text(), "hello there"; got != exp {
+		t.Fatalf("got text %q, expected %q", got, exp)
+	}
+	e.Undo()
+	if got, exp := e.Text(), "hello world"; got != exp {
+		t.Errorf("got text %q after undo, expected %q", got, exp)
+	}
+	if got, exp := e.SelectedText(), "world"; got != exp {
+		t.Errorf("got selection %q after undo, expected %q", got, exp)
+	}
+}
+
+func TestEditorMaxHistory(t *testing.T) {
+	e := &widget.Editor{MaxHistory: 2}
+	for _, s := range []string{"a", "b", "c"} {
+		e.Insert(s)
+	}
+	for e.Undo() {
+	}
+	if got, exp := e.Text(), "a"; got != exp {
+		t.Errorf("got text %q after undoing all changes, expected %q", got, exp)
+	}
+	e.ClearHistory()
+	if e.Redo() {
+		t.Error("redo succeeded after clearing the history")
+	}
+}
+
+// countingShaper counts the calls to Layout and Shape.
+type countingShaper struct {
+	text.Shaper
+	layouts, shapes int
+	glyphs          int
+}
+
+func (s *countingShaper) Layout(font text.Font, size fixed.Int26_6, maxWidth int, spacing text.Spacing, txt io.Reader) ([]text.Line, error) {
+	s.layouts++
+	return s.Shaper.Layout(font, size, maxWidth, spacing, txt)
+}
+
+func (s *countingShaper) Shape(font text.Font, size fixed.Int26_6, x fixed.Int26_6, layout []text.Glyph) op.CallOp {
+	s.shapes++
+	s.glyphs += len(layout)
+	return s.Shaper.Shape(font, size, x, layout)
+}
+
+func TestEditorIncrementalLayout(t *testing.T) {
+	shaper := &countingShaper{Shaper: newShaper(t)}
+	e := new(widget.Editor)
+	e.SetText(strings.Repeat("a line of text that wraps at the editor width\n", 1000))
+	h := widgettest.New(image.Pt(100, 100), func(gtx layout.Context) layout.Dimensions {
+		return e.Layout(gtx, shaper, text.Font{}, unit.Px(10))
+	})
+	defer h.Release()
+	h.Frame()
+	visible := shaper.shapes
+	if visible == 0 || visible > 20 {
+		t.Fatalf("shaped %d lines, expected only the visible lines", visible)
+	}
+
+	// An edit re-lays out the edited paragraph and re-shapes
+	// only its lines.
+	shaper.layouts, shaper.shapes = 0, 0
+	e.SetSelection(5, 5)
+	e.Insert("x")
+	h.Frame()
+	if shaper.layouts != 1 {
+		t.Errorf("laid out %d paragraphs after an edit, expected 1", shaper.layouts)
+	}
+	if shaper.shapes == 0 || shaper.shapes >= visible {
+		t.Errorf("shaped %d lines after an edit, expected fewer than %d", shaper.shapes, visible)
+	}
+	// Unchanged frames re-use the shapes.
+	shaper.layouts, shaper.shapes = 0, 0
+	h.Frame()
+	if shaper.layouts != 0 || shaper.shapes != 0 {
+		t.Errorf("got %d layouts and %d shapes for an unchanged frame, expected none", shaper.layouts, shaper.shapes)
+	}
+}
+
+func TestEditorHorizontalClipping(t *testing.T) {
+	shaper := &countingShaper{Shaper: newShaper(t)}
+	e := &widget.Editor{SingleLine: true}
+	e.SetText(strings.Repeat("a", 1000))
+	h := widgettest.New(image.Pt(100, 100), func(gtx layout.Context) layout.Dimensions {
+		return e.Layout(gtx, shaper, text.Font{}, unit.Px(10))
+	})
+	defer h.Release()
+	h.Frame()
+	if shaper.glyphs == 0 || shaper.glyphs > 50 {
+		t.Errorf("shaped %d glyphs, expected only the visible glyphs", shaper.glyphs)
+	}
+	// Scrolling to the caret at the end re-shapes the line.
+	shaper.glyphs = 0
+	e.SetSelection(1000, 1000)
+	e.Insert("b")
+	h.Frame()
+	if shaper.glyphs == 0 || shaper.glyphs > 50 {
+		t.Errorf("shaped %d glyphs after scrolling, expected only the visible glyphs", shaper.glyphs)
+	}
+}
+
+func TestEditorIncrementalLayoutConsistency(t *testing.T) {
+	shaper := newShaper(t)
+	layoutEditor := func(e *widget.Editor) {
+		gtx := layout.NewContext(new(op.Ops), nil, nil, image.Pt(100, 100))
+		e.Layout(gtx, shaper, text.Font{}, unit.Px(10))
+	}
+	e := new(widget.Editor)
+	layoutEditor(e)
+	rnd := rand.New(rand.NewSource(1))
+	inserts := []string{"a", "word ", "\n", "two\nlines", "\n\n", "a much longer run of text"}
+	for i := 0; i < 200; i++ {
+		n := e.Len()
+		start, end := rnd.Intn(n+1), rnd.Intn(n+1)
+		e.SetSelection(start, end)
+		if rnd.Intn(3) == 0 {
+			e.Delete(1)
+		} else {
+			e.Insert(inserts[rnd.Intn(len(inserts))])
+		}
+		layoutEditor(e)
+		ref := new(widget.Editor)
+		ref.SetText(e.Text())
+		layoutEditor(ref)
+		if got, exp := e.NumLines(), ref.NumLines(); got != exp {
+			t.Fatalf("edit %d: got %d lines, expected %d for %q", i, got, exp, e.Text())
+		}
+		for _, pos := range []int{0, e.Len() / 2, e.Len()} {
+			e.SetSelection(pos, pos)
+			ref.SetSelection(pos, pos)
+			l1, c1 := e.CaretPos()
+			l2, c2 := ref.CaretPos()
+			if l1 != l2 || c1 != c2 {
+				t.Fatalf("edit %d: got caret (%d, %d) at %d, expected (%d, %d)", i, l1, c1, pos, l2, c2)
+			}
+			x1, y1 := e.CaretCoords()
+			x2, y2 := ref.CaretCoords()
+			if x1 != x2 || y1 != y2 {
+				t.Fatalf("edit %d: got caret coordinates (%v, %d) at %d, expected (%v, %d)", i, x1, y1, pos, x2, y2)
+			}
+		}
+	}
+}
+
+func TestEditorBidi(t *testing.T) {
+	e := new(widget.Editor)
+	// The Hebrew letters are displayed right to left after the space.
+	e.SetText("ab אבג")
+	h := newEditorHarness(t, e)
+	defer h.Release()
+
+	h.Key(key.NameHome, 0)
+	// The right arrow moves visually: through the Latin letters, to
+	// the end of the text at the left edge of the Hebrew letters, and
+	// to the start of the Hebrew letters at the right edge of the line.
+	for i, exp := range []int{1, 2, 6, 5, 4, 3, 3} {
+		h.Key(key.NameRightArrow, 0)
+		if _, col := e.CaretPos(); col != exp {
+			t.Fatalf("right arrow %d: got column %d, expected %d", i, col, exp)
+		}
+	}
+	x3, _ := e.CaretCoords()
+	h.Key(key.NameLeftArrow, 0)
+	x4, _ := e.CaretCoords()
+	if x4 >= x3 {
+		t.Errorf("got caret at %v after left arrow, expected left of %v", x4, x3)
+	}
+
+	// Clicking at the right edge of the line moves the caret to the
+	// start of the Hebrew letters.
+	h.Key(key.NameHome, 0)
+	h.Click(f32.Pt(float32(x3.Round())+1, 5))
+	if _, col := e.CaretPos(); col != 3 {
+		t.Errorf("got column %d after click, expected 3", col)
+	}
+}
+
+func TestEditorSpacing(t *testing.T) {
+	caretCoords := func(e *widget.Editor, caret int) (fixed.Int26_6, int) {
+		e.SetSelection(caret, caret)
+		return e.CaretCoords()
+	}
+	plain := new(widget.Editor)
+	plain.SetText("ab\ncd")
+	h := newEditorHarness(t, plain)
+	h.Release()
+	e := &widget.Editor{
+		LineHeight:       2,
+		LetterSpacing:    unit.Px(3),
+		ParagraphSpacing: unit.Px(10),
+	}
+	e.SetText("ab\ncd")
+	h = newEditorHarness(t, e)
+	defer h.Release()
+
+	px, py := caretCoords(plain, 4)
+	x, y := caretCoords(e, 4)
+	if exp := px + fixed.I(3); x != exp {
+		t.Errorf("got caret x %v, expected %v", x, exp)
+	}
+	// The second line moves down by the paragraph spacing and
+	// the extra height of both lines.
+	_, py0 := caretCoords(plain, 0)
+	_, y0 := caretCoords(e, 0)
+	if d, pd := y-y0, py-py0; d < 2*pd-1+10 || d > 2*pd+1+10 {
+		t.Errorf("got line distance %d, expected about %d", d, 2*pd+10)
+	}
+	// Clicking the second line moves the caret to it.
+	h.Click(f32.Pt(float32(x.Round()), float32(y)))
+	if line, col := e.CaretPos(); line != 1 || col != 1 {
+		t.Errorf("got caret at line %d column %d after click, expected line 1 column 1", line, col)
+	}
+}
+
+func TestEditorDecoration(t *testing.T) {
+	const width = 40
+	shaper := decorationShaper{Shaper: newShaper(t)}
+	e := &widget.Editor{SingleLine: true, Decoration: text.Underline | text.Strikethrough}
+	e.SetText("wwwwwwwwwwwwwwww")
+	var dims layout.Dimensions
+	h := widgettest.New(image.Pt(100, 100), func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints = layout.Constraints{Max: image.Pt(width, 100)}
+		dims = e.Layout(gtx, shaper, text.Font{}, unit.Px(10))
+		paint.ColorOp{Color: color.RGBA{R: 0xff, A: 0xff}}.Add(gtx.Ops)
+		e.PaintText(gtx)
+		return dims
+	})
+	defer h.Release()
+	h.Frame()
+	checkDecorations(t, h, dims.Size.Y-dims.Baseline, width)
+}
+
+func TestEditorLineStart(t *testing.T) {
+	const width = 100
+	shaper := &recordShaper{Shaper: newShaper(t)}
+	e := &widget.Editor{Alignment: text.End}
+	e.SetText("hello")
+	h := widgettest.New(image.Pt(width, 100), func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints = layout.Exact(image.Pt(width, 100))
+		return e.Layout(gtx, shaper, text.Font{}, unit.Px(10))
+	})
+	defer h.Release()
+	h.Frame()
+	lines := shaper.LayoutString(text.Font{}, fixed.I(10), width, text.Spacing{}, "hello")
+	exp := fixed.I((fixed.I(width) - lines[0].Width).Floor())
+	if len(shaper.starts) != 1 || shaper.starts[0] != exp {
+		t.Errorf("got line starts %v, expected %v", shaper.starts, exp)
+	}
+	// The shape of the line is cached.
+	h.Frame()
+	if len(shaper.starts) != 1 {
+		t.Errorf("got line starts %v after a second frame, expected one", shaper.starts)
+	}
+}
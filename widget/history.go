@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// editHistory tracks changes to editor text for undo and redo.
+type editHistory struct {
+	undo, redo []editRecord
+	// merge tracks whether the next change may be merged into the
+	// most recent undo record.
+	merge bool
+}
+
+// editRecord is a reversible change to the editor text: the text
+// deleted at offset is replaced with inserted.
+type editRecord struct {
+	kind              editKind
+	offset            int
+	deleted, inserted string
+	// caret and anchor are the selection before the change.
+	caret, anchor int
+}
+
+type editKind uint8
+
+const (
+	// editOther changes are never merged.
+	editOther editKind = iota
+	// editTyping changes are merged with contiguous typing
+	// in the same word.
+	editTyping
+	// editDeleting changes are merged with contiguous
+	// deletions.
+	editDeleting
+)
+
+// defaultMaxHistory is the default maximum number of
+// records in the undo history.
+const defaultMaxHistory = 100
+
+// record adds a change to the undo history and clears the redo
+// history. The undo history is trimmed to at most max records.
+func (h *editHistory) record(r editRecord, max int) {
+	h.redo = h.redo[:0]
+	if h.merge && len(h.undo) > 0 && h.undo[len(h.undo)-1].mergeWith(r) {
+		return
+	}
+	h.undo = append(h.undo, r)
+	if max <= 0 {
+		max = defaultMaxHistory
+	}
+	if n := len(h.undo) - max; n > 0 {
+		h.undo = h.undo[:copy(h.undo, h.undo[n:])]
+	}
+	h.merge = true
+}
+
+// seal prevents the next change from merging with the
+// most recent record.
+func (h *editHistory) seal() {
+	h.merge = false
+}
+
+func (h *editHistory) clear() {
+	h.undo = h.undo[:0]
+	h.redo = h.redo[:0]
+	h.merge = false
+}
+
+// mergeWith merges the following change r2 into r and reports
+// whether it succeeded.
+func (r *editRecord) mergeWith(r2 editRecord) bool {
+	if r.kind != r2.kind {
+		return false
+	}
+	switch r.kind {
+	case editTyping:
+		if r.deleted != "" || r2.deleted != "" || r2.offset != r.offset+len(r.inserted) {
+			return false
+		}
+		// Start a new record at the beginning of each word.
+		last, _ := utf8.DecodeLastRuneInString(r.inserted)
+		first, _ := utf8.DecodeRuneInString(r2.inserted)
+		if unicode.IsSpace(last) && !unicode.IsSpace(first) {
+			return false
+		}
+		r.inserted += r2.inserted
+		return true
+	case editDeleting:
+		if r.inserted != "" || r2.inserted != "" {
+			return false
+		}
+		switch {
+		case r2.offset+len(r2.deleted) == r.offset:
+			// Deletion backward.
+			r.offset = r2.offset
+			r.deleted = r2.deleted + r.deleted
+		case r2.offset == r.offset:
+			// Deletion forward.
+			r.deleted += r2.deleted
+		default:
+			return false
+		}
+		return true
+	default:
+		return false
+	}
+}
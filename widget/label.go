@@ -5,7 +5,6 @@ package widget
 import (
 	"fmt"
 	"image"
-	"unicode/utf8"
 
 	"gioui.org/f32"
 	"gioui.org/layout"
@@ -23,6 +22,18 @@ type Label struct {
 	Alignment text.Alignment
 	// MaxLines limits the number of lines. Zero means no limit.
 	MaxLines int
+	// Truncation determines where an ellipsis replaces the text
+	// beyond MaxLines.
+	Truncation text.Truncation
+	// LineHeight scales the height of lines. Zero means the line
+	// height of the font.
+	LineHeight float32
+	// LetterSpacing is the extra space after every letter.
+	LetterSpacing unit.Value
+	// ParagraphSpacing is the extra space between paragraphs.
+	ParagraphSpacing unit.Value
+	// Decoration is the set of lines drawn along the text.
+	Decoration text.Decoration
 }
 
 type lineIterator struct {
@@ -38,15 +49,18 @@ type lineIterator struct {
 
 const inf = 1e6
 
-func (l *lineIterator) Next() (int, int, []text.Glyph, f32.Point, bool) {
+// Next returns the text range, glyphs and start of the next visible
+// line. The baseline is aligned to the pixel grid, while the start of
+// the line is left at its fraction of a pixel for the shaper.
+func (l *lineIterator) Next() (int, int, []text.Glyph, fixed.Point26_6, bool) {
 	for len(l.Lines) > 0 {
 		line := l.Lines[0]
 		l.Lines = l.Lines[1:]
-		x := align(l.Alignment, line.Width, l.Width) + fixed.I(l.Offset.X)
+		x := align(l.Alignment, line.Direction, line.Width, l.Width) + fixed.I(l.Offset.X)
 		l.y += l.prevDesc + line.Ascent
 		l.prevDesc = line.Descent
-		// Align baseline and line start to the pixel grid.
-		off := fixed.Point26_6{X: fixed.I(x.Floor()), Y: fixed.I(l.y.Ceil())}
+		// Align the baseline to the pixel grid.
+		off := fixed.Point26_6{X: x, Y: fixed.I(l.y.Ceil())}
 		l.y = off.Y
 		off.Y += fixed.I(l.Offset.Y)
 		if (off.Y + line.Bounds.Min.Y).Floor() > l.Clip.Max.Y {
@@ -58,39 +72,50 @@ func (l *lineIterator) Next() (int, int, []text.Glyph, f32.Point, bool) {
 		if (off.Y + line.Bounds.Max.Y).Ceil() < l.Clip.Min.Y {
 			continue
 		}
-		for len(layout) > 0 {
-			g := layout[0]
-			adv := g.Advance
-			if (off.X + adv + line.Bounds.Max.X - line.Width).Ceil() >= l.Clip.Min.X {
-				break
-			}
-			off.X += adv
-			layout = layout[1:]
-			start += utf8.RuneLen(g.Rune)
+		first, last, x := visibleGlyphs(line, off.X, l.Clip)
+		off.X = x
+		for _, g := range layout[:first] {
+			start += g.ClusterLen()
 		}
+		layout = layout[first:last]
 		end := start
-		endx := off.X
-		for i, g := range layout {
-			if (endx + line.Bounds.Min.X).Floor() > l.Clip.Max.X {
-				layout = layout[:i]
-				break
-			}
-			end += utf8.RuneLen(g.Rune)
-			endx += g.Advance
+		for _, g := range layout {
+			end += g.ClusterLen()
 		}
-		offf := f32.Point{X: float32(off.X) / 64, Y: float32(off.Y) / 64}
-		return start, end, layout, offf, true
+		return start, end, layout, off, true
 	}
-	return 0, 0, nil, f32.Point{}, false
+	return 0, 0, nil, fixed.Point26_6{}, false
+}
+
+// visibleGlyphs returns the range of glyphs of a line starting at x
+// that are visible inside clip, and the start of the first visible
+// glyph.
+func visibleGlyphs(line text.Line, x fixed.Int26_6, clip image.Rectangle) (start, end int, startX fixed.Int26_6) {
+	layout := line.Layout
+	for start < len(layout) {
+		adv := layout[start].Advance
+		if (x + adv + line.Bounds.Max.X - line.Width).Ceil() >= clip.Min.X {
+			break
+		}
+		x += adv
+		start++
+	}
+	startX = x
+	for end = start; end < len(layout); end++ {
+		if (x + line.Bounds.Min.X).Floor() > clip.Max.X {
+			break
+		}
+		x += layout[end].Advance
+	}
+	return start, end, startX
 }
 
 func (l Label) Layout(gtx layout.Context, s text.Shaper, font text.Font, size unit.Value, txt string) layout.Dimensions {
 	cs := gtx.Constraints
 	textSize := fixed.I(gtx.Px(size))
-	lines := s.LayoutString(font, textSize, cs.Max.X, txt)
-	if max := l.MaxLines; max > 0 && len(lines) > max {
-		lines = lines[:max]
-	}
+	spacing := textSpacing(gtx, l.LineHeight, l.LetterSpacing, l.ParagraphSpacing)
+	lines := s.LayoutString(font, textSize, cs.Max.X, spacing, txt)
+	lines, txt = truncate(s, font, textSize, cs.Max.X, spacing, l.Truncation, l.MaxLines, lines, txt)
 	dims := linesDimens(lines)
 	dims.Size = cs.Constrain(dims.Size)
 	clip := textPadding(lines)
@@ -101,22 +126,40 @@ func (l Label) Layout(gtx layout.Context, s text.Shaper, font text.Font, size un
 		Alignment: l.Alignment,
 		Width:     dims.Size.X,
 	}
+	var deco text.DecorationMetrics
+	if l.Decoration != 0 {
+		deco = decorationMetrics(s, font, textSize)
+	}
 	for {
-		start, end, l, off, ok := it.Next()
+		start, end, glyphs, loff, ok := it.Next()
 		if !ok {
 			break
 		}
+		// The shaper offsets the glyphs by the fraction of a
+		// pixel of the line start.
+		off := f32.Point{X: float32(text.LineOrigin(loff.X)), Y: float32(loff.Y) / 64}
 		lclip := layout.FRect(clip).Sub(off)
 		stack := op.Push(gtx.Ops)
 		op.TransformOp{}.Offset(off).Add(gtx.Ops)
 		str := txt[start:end]
-		s.ShapeString(font, textSize, str, l).Add(gtx.Ops)
+		s.ShapeString(font, textSize, loff.X, str, glyphs).Add(gtx.Ops)
 		paint.PaintOp{Rect: lclip}.Add(gtx.Ops)
 		stack.Pop()
+		doff := f32.Point{X: float32(loff.X) / 64, Y: off.Y}
+		paintDecorations(gtx.Ops, l.Decoration, deco, glyphs, doff, layout.FRect(clip))
 	}
 	return dims
 }
 
+// textSpacing converts spacing parameters to a text.Spacing.
+func textSpacing(gtx layout.Context, lineHeight float32, letter, paragraph unit.Value) text.Spacing {
+	return text.Spacing{
+		LineHeight: lineHeight,
+		Letter:     fixed.I(gtx.Px(letter)),
+		Paragraph:  fixed.I(gtx.Px(paragraph)),
+	}
+}
+
 func textPadding(lines []text.Line) (padding image.Rectangle) {
 	if len(lines) == 0 {
 		return
@@ -164,8 +207,17 @@ func linesDimens(lines []text.Line) layout.Dimensions {
 	}
 }
 
-func align(align text.Alignment, width fixed.Int26_6, maxWidth int) fixed.Int26_6 {
+func align(align text.Alignment, dir text.Direction, width fixed.Int26_6, maxWidth int) fixed.Int26_6 {
 	mw := fixed.I(maxWidth)
+	// The start of right to left lines is their right edge.
+	if dir == text.RTL {
+		switch align {
+		case text.Start:
+			align = text.End
+		case text.End:
+			align = text.Start
+		}
+	}
 	switch align {
 	case text.Middle:
 		return fixed.I(((mw - width) / 2).Floor())
@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget_test
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/widgettest"
+	"golang.org/x/image/math/fixed"
+)
+
+// recordShaper records the strings and glyphs shaped by ShapeString,
+// and the line starts of Shape.
+type recordShaper struct {
+	text.Shaper
+	shaped []string
+	glyphs [][]text.Glyph
+	starts []fixed.Int26_6
+}
+
+func (s *recordShaper) Shape(font text.Font, size fixed.Int26_6, x fixed.Int26_6, layout []text.Glyph) op.CallOp {
+	s.starts = append(s.starts, x)
+	return s.Shaper.Shape(font, size, x, layout)
+}
+
+func (s *recordShaper) ShapeString(font text.Font, size fixed.Int26_6, x fixed.Int26_6, str string, layout []text.Glyph) op.CallOp {
+	s.shaped = append(s.shaped, str)
+	s.glyphs = append(s.glyphs, layout)
+	return s.Shaper.ShapeString(font, size, x, str, layout)
+}
+
+func TestLabelTruncate(t *testing.T) {
+	const (
+		txt   = "one two three four five six seven"
+		width = 60
+	)
+	shaper := &recordShaper{Shaper: newShaper(t)}
+	layoutLabel := func(l widget.Label) ([]string, layout.Dimensions) {
+		var dims layout.Dimensions
+		h := widgettest.New(image.Pt(width, 1000), func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min = image.Point{}
+			dims = l.Layout(gtx, shaper, text.Font{}, unit.Px(10), txt)
+			return dims
+		})
+		defer h.Release()
+		shaper.shaped = nil
+		h.Frame()
+		return shaper.shaped, dims
+	}
+	full, fullDims := layoutLabel(widget.Label{})
+	if len(full) < 3 {
+		t.Fatalf("got %d lines, expected at least 3", len(full))
+	}
+	clipped, clippedDims := layoutLabel(widget.Label{MaxLines: 2})
+	if len(clipped) != 2 || clippedDims.Size.Y >= fullDims.Size.Y {
+		t.Errorf("got lines %q of height %d, expected 2 lines", clipped, clippedDims.Size.Y)
+	}
+	tests := []struct {
+		trunc    text.Truncation
+		maxLines int
+		check    func(lines []string) bool
+	}{
+		{text.TruncateEnd, 2, func(lines []string) bool {
+			return lines[0] == full[0] && strings.HasSuffix(lines[1], "…")
+		}},
+		{text.TruncateStart, 1, func(lines []string) bool {
+			return strings.HasPrefix(lines[0], "…") && strings.HasSuffix(lines[0], "seven")
+		}},
+		{text.TruncateMiddle, 1, func(lines []string) bool {
+			return strings.HasPrefix(lines[0], "one") && strings.Contains(lines[0], "…") && strings.HasSuffix(lines[0], "even")
+		}},
+	}
+	for _, test := range tests {
+		lines, dims := layoutLabel(widget.Label{MaxLines: test.maxLines, Truncation: test.trunc})
+		if len(lines) != test.maxLines || !test.check(lines) {
+			t.Errorf("%v: got lines %q", test.trunc, lines)
+		}
+		if dims.Size.X > width {
+			t.Errorf("%v: got width %d, expected at most %d", test.trunc, dims.Size.X, width)
+		}
+		if test.maxLines == 2 && dims.Size.Y != clippedDims.Size.Y {
+			t.Errorf("%v: got height %d, expected %d", test.trunc, dims.Size.Y, clippedDims.Size.Y)
+		}
+	}
+}
+
+func TestLabelTruncateBidi(t *testing.T) {
+	const width = 60
+	shaper := &recordShaper{Shaper: newShaper(t)}
+	tests := []struct {
+		txt   string
+		trunc text.Truncation
+		// prefix and suffix are the expected ends of the text
+		// of the truncated line.
+		prefix, suffix string
+		// levels are the expected embedding levels of the
+		// glyphs before and after the ellipsis, and of the
+		// ellipsis itself.
+		before, ell, after int
+	}{
+		// A right-to-left paragraph keeps its direction.
+		{"אחת שתיים שלוש ארבע חמש שש שבע", text.TruncateEnd, "אחת", "…", 1, 1, -1},
+		// The ellipsis takes the paragraph level between text of
+		// different directions.
+		{"אחת שתיים three four five six seven", text.TruncateStart, "…", "seven", -1, 1, 2},
+		// Left-to-right text in a left-to-right paragraph.
+		{"one two שלוש ארבע five six seven", text.TruncateEnd, "one", "…", 0, 0, -1},
+	}
+	for _, test := range tests {
+		h := widgettest.New(image.Pt(width, 1000), func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min = image.Point{}
+			l := widget.Label{MaxLines: 1, Truncation: test.trunc}
+			return l.Layout(gtx, shaper, text.Font{}, unit.Px(10), test.txt)
+		})
+		shaper.shaped, shaper.glyphs = nil, nil
+		h.Frame()
+		h.Release()
+		if len(shaper.shaped) != 1 {
+			t.Errorf("%q: got lines %q, expected 1 line", test.txt, shaper.shaped)
+			continue
+		}
+		line, glyphs := shaper.shaped[0], shaper.glyphs[0]
+		if !strings.HasPrefix(line, test.prefix) || !strings.HasSuffix(line, test.suffix) {
+			t.Errorf("%q: got line %q, expected %q…%q", test.txt, line, test.prefix, test.suffix)
+		}
+		ell := -1
+		for i, g := range glyphs {
+			if g.Rune == '…' {
+				ell = i
+			}
+		}
+		if ell == -1 {
+			t.Errorf("%q: no ellipsis in %q", test.txt, line)
+			continue
+		}
+		level := func(i int) int {
+			if i < 0 || i >= len(glyphs) {
+				return -1
+			}
+			return int(glyphs[i].Level)
+		}
+		if b, e, a := level(ell-1), level(ell), level(ell+1); b != test.before || e != test.ell || a != test.after {
+			t.Errorf("%q: got levels %d, %d, %d around the ellipsis, expected %d, %d, %d", test.txt, b, e, a, test.before, test.ell, test.after)
+		}
+	}
+}
+
+// decorationShaper draws only the decorations of text laid out by its
+// Shaper, with the underline a pixel below the baseline and the
+// strikethrough below the text clip.
+type decorationShaper struct {
+	text.Shaper
+}
+
+func (s decorationShaper) Shape(font text.Font, size fixed.Int26_6, x fixed.Int26_6, layout []text.Glyph) op.CallOp {
+	return emptyClip()
+}
+
+func (s decorationShaper) ShapeString(font text.Font, size fixed.Int26_6, x fixed.Int26_6, str string, layout []text.Glyph) op.CallOp {
+	return emptyClip()
+}
+
+func (s decorationShaper) DecorationMetrics(font text.Font, size fixed.Int26_6) text.DecorationMetrics {
+	return text.DecorationMetrics{
+		Underline:              fixed.I(1),
+		UnderlineThickness:     fixed.I(1),
+		Strikethrough:          fixed.I(50),
+		StrikethroughThickness: fixed.I(1),
+	}
+}
+
+// emptyClip returns the operation for an empty clip area.
+func emptyClip() op.CallOp {
+	ops := new(op.Ops)
+	m := op.Record(ops)
+	clip.Rect{}.Add(ops)
+	return m.Stop()
+}
+
+// checkDecorations checks the decorations painted by a decorationShaper
+// in red for a line with its baseline at y, clipped to a width less
+// than that of the line.
+func checkDecorations(t *testing.T, h *widgettest.Harness, y, width int) {
+	t.Helper()
+	img, err := h.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	red := color.RGBA{R: 0xff, A: 0xff}
+	for x := 0; x < img.Bounds().Dx(); x++ {
+		// Allow for the padding of the clip.
+		if got := img.RGBAAt(x, y+1); x < width-5 && got != red || x >= width+5 && got == red {
+			t.Errorf("underline pixel (%d,%d): got %v", x, y+1, got)
+		}
+		if got := img.RGBAAt(x, y+50); got == red {
+			t.Errorf("strikethrough pixel (%d,%d) outside the text clip", x, y+50)
+		}
+	}
+}
+
+func TestLabelDecoration(t *testing.T) {
+	const width = 40
+	shaper := decorationShaper{Shaper: newShaper(t)}
+	var dims layout.Dimensions
+	h := widgettest.New(image.Pt(100, 100), func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints = layout.Constraints{Max: image.Pt(width, 100)}
+		paint.ColorOp{Color: color.RGBA{R: 0xff, A: 0xff}}.Add(gtx.Ops)
+		l := widget.Label{Decoration: text.Underline | text.Strikethrough}
+		dims = l.Layout(gtx, shaper, text.Font{}, unit.Px(10), "wwwwwwwwwwwwwwww")
+		return dims
+	})
+	defer h.Release()
+	h.Frame()
+	checkDecorations(t, h, dims.Size.Y-dims.Baseline, width)
+}
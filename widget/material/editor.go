@@ -22,19 +22,22 @@ type EditorStyle struct {
 	Hint string
 	// HintColor is the color of hint text.
 	HintColor color.RGBA
-	Editor    *widget.Editor
+	// SelectionColor is the color of the background for selected text.
+	SelectionColor color.RGBA
+	Editor         *widget.Editor
 
 	shaper text.Shaper
 }
 
 func Editor(th *Theme, editor *widget.Editor, hint string) EditorStyle {
 	return EditorStyle{
-		Editor:    editor,
-		TextSize:  th.TextSize,
-		Color:     th.Color.Text,
-		shaper:    th.Shaper,
-		Hint:      hint,
-		HintColor: th.Color.Hint,
+		Editor:         editor,
+		TextSize:       th.TextSize,
+		Color:          th.Color.Text,
+		shaper:         th.Shaper,
+		Hint:           hint,
+		HintColor:      th.Color.Hint,
+		SelectionColor: mulAlpha(th.Color.Primary, 0x60),
 	}
 }
 
@@ -53,6 +56,8 @@ func (e EditorStyle) Layout(gtx layout.Context) layout.Dimensions {
 	}
 	dims = e.Editor.Layout(gtx, e.shaper, e.Font, e.TextSize)
 	if e.Editor.Len() > 0 {
+		paint.ColorOp{Color: e.SelectionColor}.Add(gtx.Ops)
+		e.Editor.PaintSelection(gtx)
 		paint.ColorOp{Color: e.Color}.Add(gtx.Ops)
 		e.Editor.PaintText(gtx)
 	} else {
@@ -21,8 +21,20 @@ type LabelStyle struct {
 	Alignment text.Alignment
 	// MaxLines limits the number of lines. Zero means no limit.
 	MaxLines int
-	Text     string
-	TextSize unit.Value
+	// Truncation determines where an ellipsis replaces the text
+	// beyond MaxLines.
+	Truncation text.Truncation
+	// LineHeight scales the height of lines. Zero means the line
+	// height of the font.
+	LineHeight float32
+	// LetterSpacing is the extra space after every letter.
+	LetterSpacing unit.Value
+	// ParagraphSpacing is the extra space between paragraphs.
+	ParagraphSpacing unit.Value
+	// Decoration is the set of lines drawn along the text.
+	Decoration text.Decoration
+	Text       string
+	TextSize   unit.Value
 
 	shaper text.Shaper
 }
@@ -74,6 +86,14 @@ func Label(th *Theme, size unit.Value, txt string) LabelStyle {
 
 func (l LabelStyle) Layout(gtx layout.Context) layout.Dimensions {
 	paint.ColorOp{Color: l.Color}.Add(gtx.Ops)
-	tl := widget.Label{Alignment: l.Alignment, MaxLines: l.MaxLines}
+	tl := widget.Label{
+		Alignment:        l.Alignment,
+		MaxLines:         l.MaxLines,
+		Truncation:       l.Truncation,
+		LineHeight:       l.LineHeight,
+		LetterSpacing:    l.LetterSpacing,
+		ParagraphSpacing: l.ParagraphSpacing,
+		Decoration:       l.Decoration,
+	}
 	return tl.Layout(gtx, l.shaper, l.Font, l.TextSize, l.Text)
 }
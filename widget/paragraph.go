@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"sort"
+	"strings"
+
+	"gioui.org/op"
+	"gioui.org/text"
+	"golang.org/x/image/math/fixed"
+)
+
+// paragraph is the layout of a range of editor text that ends
+// with a newline or at the end of the text.
+type paragraph struct {
+	// len is the length in bytes of the paragraph text,
+	// including its newline.
+	len int
+	// valid tracks whether lines and shapes match the text.
+	valid  bool
+	lines  []text.Line
+	shapes []shapedLine
+	// ys are the baselines of lines relative to the
+	// baseline of the first line.
+	ys []int
+
+	// The following fields locate the paragraph in the text and
+	// summarize the paragraphs up to and including it. They are
+	// updated by layoutParagraphs.
+
+	// off is the byte offset of the paragraph in the text.
+	off int
+	// line is the index of the first line of the paragraph.
+	line int
+	// y is the baseline of the first line.
+	y int
+	// width is the width of the widest line.
+	width fixed.Int26_6
+	// wrapped tracks whether a line ends with a soft
+	// line break.
+	wrapped bool
+}
+
+// shapedLine caches the outline of the visible part of a line.
+type shapedLine struct {
+	path op.CallOp
+	// valid tracks whether path is shaped.
+	valid bool
+	// start and end are the range of shaped glyphs.
+	start, end int
+	// frac is the offset of the first shaped glyph from its
+	// origin.
+	frac fixed.Int26_6
+}
+
+// editParagraphs updates the paragraphs for the replacement of the
+// text between the byte offsets start and end with n bytes. The
+// touched paragraphs are merged into a single paragraph that is laid
+// out by the next call to layoutParagraphs.
+func (e *Editor) editParagraphs(start, end, n int) {
+	if len(e.paras) == 0 {
+		return
+	}
+	p0, p1 := -1, -1
+	var off, off0 int
+	for i, p := range e.paras {
+		if p0 == -1 && off+p.len > start {
+			p0, off0 = i, off
+		}
+		if off+p.len > end {
+			p1 = i
+			break
+		}
+		off += p.len
+	}
+	last := len(e.paras) - 1
+	if p0 == -1 {
+		p0, off0 = last, off-e.paras[last].len
+	}
+	if p1 == -1 {
+		p1 = last
+	}
+	off1 := off0
+	for _, p := range e.paras[p0 : p1+1] {
+		off1 += p.len
+	}
+	e.paras[p0] = paragraph{
+		len: off1 - off0 - (end - start) + n,
+	}
+	e.paras = append(e.paras[:p0+1], e.paras[p1+1:]...)
+}
+
+// layoutParagraphs lays out the invalid paragraphs and updates the
+// accumulated fields of the paragraphs that follow them.
+func (e *Editor) layoutParagraphs() {
+	if len(e.paras) == 0 {
+		e.paras = append(e.paras, paragraph{len: e.rr.len()})
+	}
+	first := -1
+	var off int
+	for i := 0; i < len(e.paras); i++ {
+		p := e.paras[i]
+		if p.valid {
+			off += p.len
+			continue
+		}
+		if first == -1 {
+			first = i
+		}
+		txt := e.rr.substring(off, off+p.len)
+		off += p.len
+		// Split at newlines.
+		var paras []paragraph
+		for len(txt) > 0 {
+			n := strings.IndexByte(txt, '\n') + 1
+			if n == 0 {
+				n = len(txt)
+			}
+			paras = append(paras, e.layoutParagraph(txt[:n]))
+			txt = txt[n:]
+		}
+		e.paras = append(e.paras[:i], append(paras, e.paras[i+1:]...)...)
+		i += len(paras) - 1
+	}
+	// The text always ends with a paragraph without a newline,
+	// possibly empty.
+	if n := len(e.paras); n == 0 || endsWithNewline(e.paras[n-1].lines) {
+		if first == -1 {
+			first = n
+		}
+		e.paras = append(e.paras, e.layoutParagraph(""))
+	}
+	if first != -1 {
+		e.accumulateParagraphs(first)
+	}
+}
+
+// accumulateParagraphs updates the accumulated fields of the
+// paragraphs from index i.
+func (e *Editor) accumulateParagraphs(i int) {
+	var prev paragraph
+	var prevDesc fixed.Int26_6
+	if i > 0 {
+		prev = e.paras[i-1]
+		prevDesc = prev.lines[len(prev.lines)-1].Descent
+	}
+	for ; i < len(e.paras); i++ {
+		p := &e.paras[i]
+		p.off = prev.off + prev.len
+		p.line = prev.line + len(prev.lines)
+		p.y = (prevDesc + p.lines[0].Ascent).Ceil()
+		if i > 0 {
+			p.y += prev.y + prev.ys[len(prev.ys)-1]
+		}
+		p.width = prev.width
+		p.wrapped = prev.wrapped || len(p.lines) > 1
+		for _, l := range p.lines {
+			if l.Width > p.width {
+				p.width = l.Width
+			}
+		}
+		prev = *p
+		prevDesc = p.lines[len(p.lines)-1].Descent
+	}
+}
+
+func (e *Editor) layoutParagraph(txt string) paragraph {
+	lines, _ := e.shaper.Layout(e.font, e.textSize, e.maxWidth, e.spacing, strings.NewReader(txt))
+	if strings.HasSuffix(txt, "\n") {
+		// Drop the empty line following the newline; it
+		// belongs to the next paragraph.
+		lines = lines[:len(lines)-1]
+	}
+	ys := make([]int, len(lines))
+	for i := 1; i < len(lines); i++ {
+		ys[i] = ys[i-1] + (lines[i-1].Descent + lines[i].Ascent).Ceil()
+	}
+	return paragraph{
+		len:    len(txt),
+		valid:  true,
+		lines:  lines,
+		shapes: make([]shapedLine, len(lines)),
+		ys:     ys,
+	}
+}
+
+// numLines returns the number of editor lines.
+func (e *Editor) numLines() int {
+	p := e.paras[len(e.paras)-1]
+	return p.line + len(p.lines)
+}
+
+// paraOfLine returns the index of the paragraph containing
+// line i.
+func (e *Editor) paraOfLine(i int) int {
+	return sort.Search(len(e.paras), func(j int) bool {
+		return e.paras[j].line > i
+	}) - 1
+}
+
+// line returns line i.
+func (e *Editor) line(i int) text.Line {
+	p := e.paras[e.paraOfLine(i)]
+	return p.lines[i-p.line]
+}
+
+// lineY returns the baseline of line i.
+func (e *Editor) lineY(i int) int {
+	p := e.paras[e.paraOfLine(i)]
+	return p.y + p.ys[i-p.line]
+}
+
+// lineOffset returns the byte offset of the start of line i.
+func (e *Editor) lineOffset(i int) int {
+	p := e.paras[e.paraOfLine(i)]
+	off := p.off
+	for _, l := range p.lines[:i-p.line] {
+		off += l.Len
+	}
+	return off
+}
+
+// lineAtOffset returns the line containing the byte offset off,
+// and the byte offset of its start. An offset at the end of a line
+// belongs to the next line, if any.
+func (e *Editor) lineAtOffset(off int) (int, int) {
+	pi := sort.Search(len(e.paras), func(j int) bool {
+		return e.paras[j].off > off
+	}) - 1
+	if pi < 0 {
+		pi = 0
+	}
+	p := e.paras[pi]
+	start := p.off
+	for i, l := range p.lines {
+		if i == len(p.lines)-1 || start+l.Len > off {
+			return p.line + i, start
+		}
+		start += l.Len
+	}
+	panic("unreachable")
+}
+
+// visibleLines calls f for every line visible in clip when the text
+// is offset by off, with the byte offset of the line. The line starts
+// at x and its baseline is at y.
+func (e *Editor) visibleLines(clip image.Rectangle, off image.Point, f func(p *paragraph, i, start int, x fixed.Int26_6, y int)) {
+	// The lines of a paragraph extend a few pixels beyond its
+	// baselines; start from the paragraph before the first one
+	// with a baseline inside the clip.
+	pi := sort.Search(len(e.paras), func(j int) bool {
+		p := e.paras[j]
+		return p.y+p.ys[len(p.ys)-1]+off.Y >= clip.Min.Y
+	}) - 1
+	if pi < 0 {
+		pi = 0
+	}
+	for ; pi < len(e.paras); pi++ {
+		p := &e.paras[pi]
+		start := p.off
+		for i, l := range p.lines {
+			y := p.y + p.ys[i] + off.Y
+			if y+l.Bounds.Min.Y.Floor() > clip.Max.Y {
+				return
+			}
+			if y+l.Bounds.Max.Y.Ceil() >= clip.Min.Y {
+				x := align(e.Alignment, l.Direction, l.Width, e.viewSize.X) + fixed.I(off.X)
+				f(p, i, start, x, y)
+			}
+			start += l.Len
+		}
+	}
+}
+
+// shapeLine returns the cached outline of the glyphs start to end of
+// line i of p, where the first glyph starts at x. The outline is to
+// be drawn at text.LineOrigin(x).
+func (e *Editor) shapeLine(p *paragraph, i, start, end int, x fixed.Int26_6) op.CallOp {
+	s := &p.shapes[i]
+	frac := x - fixed.I(text.LineOrigin(x))
+	if !s.valid || s.frac != frac || s.start != start || s.end != end {
+		s.path = e.shaper.Shape(e.font, e.textSize, x, p.lines[i].Layout[start:end])
+		s.valid = true
+		s.frac = frac
+		s.start, s.end = start, end
+	}
+	return s.path
+}
+
+func endsWithNewline(lines []text.Line) bool {
+	if len(lines) == 0 {
+		return false
+	}
+	l := lines[len(lines)-1].Layout
+	return len(l) > 0 && l[len(l)-1].Rune == '\n'
+}
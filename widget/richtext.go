@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// RichText is a widget for laying out and drawing a paragraph of
+// text in several styles.
+type RichText struct {
+	// Alignment specify the text alignment.
+	Alignment text.Alignment
+	// MaxLines limits the number of lines. Zero means no limit.
+	MaxLines int
+}
+
+// Span is a run of text in a single style.
+type Span struct {
+	Font  text.Font
+	Size  unit.Value
+	Color color.RGBA
+	// Tag, if not nil, receives the pointer events within the
+	// area of the span. For example, set Tag to a *gesture.Click
+	// to make the span clickable.
+	Tag event.Tag
+	// Decoration is the set of lines drawn along the text.
+	Decoration text.Decoration
+	Text       string
+}
+
+// Layout the spans. Lines are wrapped across span boundaries.
+func (r RichText) Layout(gtx layout.Context, s text.Shaper, spans []Span) layout.Dimensions {
+	cs := gtx.Constraints
+	tspans := make([]text.Span, len(spans))
+	for i, sp := range spans {
+		tspans[i] = text.Span{
+			Font: sp.Font,
+			Size: fixed.I(gtx.Px(sp.Size)),
+			Text: sp.Text,
+		}
+	}
+	lines := text.LayoutSpans(s, tspans, cs.Max.X)
+	if max := r.MaxLines; max > 0 && len(lines) > max {
+		lines = lines[:max]
+	}
+	// Measure the lines as plain text lines.
+	tlines := make([]text.Line, len(lines))
+	for i, l := range lines {
+		tlines[i] = text.Line{
+			Width:   l.Width,
+			Ascent:  l.Ascent,
+			Descent: l.Descent,
+			Bounds:  l.Bounds,
+		}
+	}
+	dims := linesDimens(tlines)
+	dims.Size = cs.Constrain(dims.Size)
+	clip := textPadding(tlines)
+	clip.Max = clip.Max.Add(dims.Size)
+	var y, prevDesc fixed.Int26_6
+	for _, line := range lines {
+		x := align(r.Alignment, line.Direction, line.Width, dims.Size.X)
+		y += prevDesc + line.Ascent
+		prevDesc = line.Descent
+		// Align the baseline to the pixel grid. The shaper offsets
+		// the glyphs by the fraction of a pixel of the runs.
+		off := fixed.Point26_6{X: x, Y: fixed.I(y.Ceil())}
+		y = off.Y
+		if (off.Y + line.Bounds.Min.Y).Floor() > clip.Max.Y {
+			break
+		}
+		for _, run := range line.Runs {
+			sp := spans[run.Span]
+			x := off.X + run.X
+			if sp.Tag != nil {
+				stack := op.Push(gtx.Ops)
+				pointer.Rect(image.Rectangle{
+					Min: image.Point{X: x.Floor(), Y: (off.Y - line.Ascent).Floor()},
+					Max: image.Point{X: (x + run.Width).Ceil(), Y: (off.Y + line.Descent).Ceil()},
+				}).Add(gtx.Ops)
+				pointer.InputOp{Tag: sp.Tag}.Add(gtx.Ops)
+				stack.Pop()
+			}
+			roff := f32.Point{X: float32(text.LineOrigin(x)), Y: float32(off.Y) / 64}
+			stack := op.Push(gtx.Ops)
+			op.TransformOp{}.Offset(roff).Add(gtx.Ops)
+			paint.ColorOp{Color: sp.Color}.Add(gtx.Ops)
+			size := tspans[run.Span].Size
+			s.ShapeString(sp.Font, size, x, sp.Text[run.Start:run.End], run.Layout).Add(gtx.Ops)
+			paint.PaintOp{Rect: layout.FRect(clip).Sub(roff)}.Add(gtx.Ops)
+			stack.Pop()
+			if sp.Decoration != 0 {
+				stack := op.Push(gtx.Ops)
+				paint.ColorOp{Color: sp.Color}.Add(gtx.Ops)
+				deco := decorationMetrics(s, sp.Font, size)
+				doff := f32.Point{X: float32(x) / 64, Y: roff.Y}
+				paintDecorations(gtx.Ops, sp.Decoration, deco, run.Layout, doff, layout.FRect(clip))
+				stack.Pop()
+			}
+		}
+	}
+	return dims
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget_test
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/gesture"
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/widgettest"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestRichTextLink(t *testing.T) {
+	shaper := newShaper(t)
+	var link gesture.Click
+	spans := []widget.Span{
+		{Size: unit.Px(10), Text: "follow the "},
+		{Size: unit.Px(10), Text: "inline link text", Tag: &link},
+	}
+	var tspans []text.Span
+	for _, sp := range spans {
+		tspans = append(tspans, text.Span{Size: fixed.I(10), Text: sp.Text})
+	}
+	const width = 80
+	lines := text.LayoutSpans(shaper, tspans, width)
+	var clicks int
+	h := widgettest.New(image.Pt(width, 100), func(gtx layout.Context) layout.Dimensions {
+		for _, e := range link.Events(gtx) {
+			if e.Type == gesture.TypeClick {
+				clicks++
+			}
+		}
+		return widget.RichText{}.Layout(gtx, shaper, spans)
+	})
+	defer h.Release()
+	h.Frame()
+	// Find the link runs.
+	var areas []f32.Point
+	var y fixed.Int26_6
+	for _, l := range lines {
+		y += l.Ascent
+		for _, r := range l.Runs {
+			if r.Span != 1 {
+				continue
+			}
+			x := r.X + r.Width/2
+			areas = append(areas, f32.Pt(float32(x)/64, float32(y)/64))
+		}
+		y += l.Descent
+	}
+	if len(areas) < 2 {
+		t.Fatalf("link wrapped into %d runs, expected at least 2", len(areas))
+	}
+	for _, pos := range areas {
+		if !h.Hit(pos, &link) {
+			t.Errorf("link not hit at %v", pos)
+		}
+	}
+	if h.Hit(f32.Pt(1, 5), &link) {
+		t.Error("link hit outside its span")
+	}
+	for _, pos := range areas {
+		h.Click(pos)
+	}
+	if clicks != len(areas) {
+		t.Errorf("got %d clicks, expected %d", clicks, len(areas))
+	}
+}
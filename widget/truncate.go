@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"strings"
+	"unicode"
+
+	"gioui.org/internal/bidi"
+	"gioui.org/text"
+
+	"golang.org/x/image/math/fixed"
+)
+
+const ellipsis = "…"
+
+// textLine is a line of glyphs along with its text.
+type textLine struct {
+	line text.Line
+	txt  string
+}
+
+// truncate limits the lines of txt to max lines and replaces the text
+// that doesn't fit with an ellipsis shaped in font. The ellipsis is
+// placed according to mode, and the line containing it fits within
+// maxWidth. It returns the remaining lines and their text.
+func truncate(s text.Shaper, font text.Font, size fixed.Int26_6, maxWidth int, spacing text.Spacing, mode text.Truncation, max int, lines []text.Line, txt string) ([]text.Line, string) {
+	if max <= 0 || len(lines) <= max {
+		return lines, txt
+	}
+	tlines := make([]textLine, len(lines))
+	for i, l := range lines {
+		tlines[i] = textLine{line: l, txt: txt[:l.Len]}
+		txt = txt[l.Len:]
+	}
+	var ell []text.Glyph
+	if mode != text.TruncateNone {
+		if l := s.LayoutString(font, size, inf, spacing, ellipsis); len(l) > 0 {
+			ell = l[0].Layout
+		}
+	}
+	width := fixed.I(maxWidth) - glyphsWidth(ell)
+	var out []textLine
+	switch mode {
+	case text.TruncateNone:
+		out = tlines[:max]
+	case text.TruncateEnd:
+		l := tlines[max-1]
+		n := fitPrefix(l.line.Layout, width)
+		mid := joinLine(l.line, l.line.Layout[:n], ell, nil)
+		mid.txt = textPrefix(l, n) + ellipsis
+		if strings.HasSuffix(l.txt, "\n") {
+			// The line no longer ends its paragraph.
+			mid.line.Descent -= spacing.Paragraph
+		}
+		out = append(tlines[:max-1:max-1], mid)
+	case text.TruncateStart:
+		l := tlines[len(tlines)-max]
+		n := fitSuffix(l.line.Layout, width)
+		mid := joinLine(l.line, nil, ell, l.line.Layout[n:])
+		mid.txt = ellipsis + textSuffix(l, n)
+		out = append([]textLine{mid}, tlines[len(tlines)-max+1:]...)
+	case text.TruncateMiddle:
+		// The ellipsis replaces the text between the lines before
+		// and after the middle line.
+		k := max / 2
+		tail := tlines[len(tlines)-(max-1-k):]
+		first, last := tlines[k], tlines[len(tlines)-len(tail)-1]
+		n := fitPrefix(first.line.Layout, width/2)
+		m := fitSuffix(last.line.Layout, width-glyphsWidth(first.line.Layout[:n]))
+		mid := joinLine(first.line, first.line.Layout[:n], ell, last.line.Layout[m:])
+		mid.txt = textPrefix(first, n) + ellipsis + textSuffix(last, m)
+		out = append(append(tlines[:k:k], mid), tail...)
+	}
+	lines = make([]text.Line, len(out))
+	var b strings.Builder
+	for i, l := range out {
+		lines[i] = l.line
+		b.WriteString(l.txt)
+	}
+	return lines, b.String()
+}
+
+// joinLine returns the line of the prefix, ellipsis and suffix glyphs,
+// with the measurements and direction of tmpl. The glyphs keep their
+// embedding levels, and the ellipsis takes the level of the text
+// around it, or the paragraph level if the text changes direction.
+func joinLine(tmpl text.Line, prefix, ell, suffix []text.Glyph) textLine {
+	var para uint8
+	if tmpl.Direction == text.RTL {
+		para = 1
+	}
+	before, after := para, para
+	if n := len(prefix); n > 0 {
+		before = prefix[n-1].Level
+	}
+	if len(suffix) > 0 {
+		after = suffix[0].Level
+	}
+	level := para
+	if before&1 == after&1 {
+		level = before
+		if after < level {
+			level = after
+		}
+	}
+	l := tmpl
+	l.Layout = nil
+	l.Layout = append(l.Layout, prefix...)
+	for _, g := range ell {
+		g.Level = level
+		l.Layout = append(l.Layout, g)
+	}
+	l.Layout = append(l.Layout, suffix...)
+	l.Len = 0
+	for _, g := range l.Layout {
+		l.Len += g.ClusterLen()
+	}
+	l.Width = glyphsWidth(l.Layout)
+	l.Bounds.Max.X += l.Width - tmpl.Width
+	l.Runs = lineRuns(l.Layout)
+	return textLine{line: l}
+}
+
+// lineRuns returns the runs of the glyphs of a line from their
+// embedding levels, or nil if every level is zero.
+func lineRuns(glyphs []text.Glyph) []text.BidiRun {
+	ltr := true
+	levels := make([]uint8, len(glyphs))
+	for i, g := range glyphs {
+		levels[i] = g.Level
+		if g.Level != 0 {
+			ltr = false
+		}
+	}
+	if ltr {
+		return nil
+	}
+	var runs []text.BidiRun
+	for _, r := range bidi.Runs(levels) {
+		dir := text.LTR
+		if r.Level&1 == 1 {
+			dir = text.RTL
+		}
+		runs = append(runs, text.BidiRun{Start: r.Start, End: r.End, Direction: dir})
+	}
+	return runs
+}
+
+// fitPrefix returns the number of glyphs of the longest prefix of
+// glyphs that fits within width. The prefix ends between clusters and
+// excludes trailing spaces.
+func fitPrefix(glyphs []text.Glyph, width fixed.Int26_6) int {
+	n := 0
+	var x fixed.Int26_6
+	for i, g := range glyphs {
+		if x += g.Advance; x > width {
+			break
+		}
+		if i+1 == len(glyphs) || !glyphs[i+1].Continuation {
+			n = i + 1
+		}
+	}
+	for n > 0 && unicode.IsSpace(glyphs[n-1].Rune) {
+		n--
+	}
+	return n
+}
+
+// fitSuffix returns the index of the first glyph of the longest suffix
+// of glyphs that fits within width. The suffix starts at a cluster and
+// excludes leading spaces.
+func fitSuffix(glyphs []text.Glyph, width fixed.Int26_6) int {
+	n := len(glyphs)
+	var x fixed.Int26_6
+	for i := len(glyphs) - 1; i >= 0; i-- {
+		g := glyphs[i]
+		if x += g.Advance; x > width {
+			break
+		}
+		if !g.Continuation {
+			n = i
+		}
+	}
+	for n < len(glyphs) && unicode.IsSpace(glyphs[n].Rune) {
+		n++
+	}
+	return n
+}
+
+// textPrefix returns the text of the first n glyphs of l.
+func textPrefix(l textLine, n int) string {
+	off := 0
+	for _, g := range l.line.Layout[:n] {
+		off += g.ClusterLen()
+	}
+	return l.txt[:off]
+}
+
+// textSuffix returns the text of the glyphs of l from glyph n.
+func textSuffix(l textLine, n int) string {
+	off := 0
+	for _, g := range l.line.Layout[n:] {
+		off += g.ClusterLen()
+	}
+	return l.txt[len(l.txt)-off:]
+}
+
+func glyphsWidth(glyphs []text.Glyph) fixed.Int26_6 {
+	var w fixed.Int26_6
+	for _, g := range glyphs {
+		w += g.Advance
+	}
+	return w
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widgettest
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "write screenshots to the golden images instead of comparing them")
+
+// Tolerance specifies how much an image may differ from a golden
+// image.
+type Tolerance struct {
+	// Channel is the largest difference allowed between two
+	// color channel values of a pixel.
+	Channel uint8
+	// Pixels is the number of pixels allowed to differ by more
+	// than Channel.
+	Pixels int
+}
+
+// Golden compares img with the golden image testdata/<name>.png. If the
+// images differ by more than tol, Golden reports a test error and writes
+// img and an image of the differences to a temporary directory. If the
+// -update flag is set, img is written to the golden image instead.
+func Golden(t testing.TB, name string, img image.Image, tol Tolerance) {
+	t.Helper()
+	file := filepath.Join("testdata", name+".png")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := savePNG(file, img); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := loadPNG(file)
+	if err != nil {
+		t.Errorf("%v (run the test with -update to create it)", err)
+		return
+	}
+	diff, n := Compare(img, want, tol.Channel)
+	if n <= tol.Pixels {
+		return
+	}
+	if diff == nil {
+		t.Errorf("%s: got size %v, expected %v", name, img.Bounds().Size(), want.Bounds().Size())
+	} else {
+		t.Errorf("%s: %d pixels differ, %d allowed", name, n, tol.Pixels)
+	}
+	dir, err := ioutil.TempDir("", "widgettest")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	base := filepath.Join(dir, filepath.Base(name))
+	if err := savePNG(base+".png", img); err != nil {
+		t.Error(err)
+		return
+	}
+	if diff != nil {
+		if err := savePNG(base+".diff.png", diff); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	t.Logf("screenshot written to %s", dir)
+}
+
+// Golden takes a screenshot of the most recent frame and compares it
+// with a golden image. See the Golden function.
+func (h *Harness) Golden(t testing.TB, name string, tol Tolerance) {
+	t.Helper()
+	img, err := h.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	Golden(t, name, img, tol)
+}
+
+// Compare returns the number of pixels where a color channel of img
+// differs from want by more than tol, along with an image marking
+// those pixels in red on a faded copy of want. If the image sizes
+// differ, Compare returns a nil image and the number of pixels of the
+// larger image.
+func Compare(img, want image.Image, tol uint8) (*image.RGBA, int) {
+	b1, b2 := img.Bounds(), want.Bounds()
+	if b1.Size() != b2.Size() {
+		n := b1.Dx() * b1.Dy()
+		if n2 := b2.Dx() * b2.Dy(); n2 > n {
+			n = n2
+		}
+		return nil, n
+	}
+	diff := image.NewRGBA(image.Rectangle{Max: b1.Size()})
+	draw.Draw(diff, diff.Bounds(), want, b2.Min, draw.Src)
+	n := 0
+	for y := 0; y < b1.Dy(); y++ {
+		for x := 0; x < b1.Dx(); x++ {
+			c1 := color.RGBAModel.Convert(img.At(b1.Min.X+x, b1.Min.Y+y)).(color.RGBA)
+			c2 := diff.RGBAAt(x, y)
+			if channelDiff(c1.R, c2.R) > tol || channelDiff(c1.G, c2.G) > tol ||
+				channelDiff(c1.B, c2.B) > tol || channelDiff(c1.A, c2.A) > tol {
+				n++
+				diff.SetRGBA(x, y, color.RGBA{R: 0xff, A: 0xff})
+				continue
+			}
+			// Fade matching pixels to make the differences stand out.
+			g := uint8((uint32(c2.R) + uint32(c2.G) + uint32(c2.B)) / 3)
+			g = 0xc0 + g/4
+			diff.SetRGBA(x, y, color.RGBA{R: g, G: g, B: g, A: 0xff})
+		}
+	}
+	return diff, n
+}
+
+func channelDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func loadPNG(file string) (image.Image, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	return img, nil
+}
+
+func savePNG(file string, img image.Image) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
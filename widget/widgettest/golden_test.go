@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widgettest
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"gioui.org/font/gofont"
+	"gioui.org/layout"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+func TestCompare(t *testing.T) {
+	want := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img.SetRGBA(0, 0, color.RGBA{R: 2})
+	img.SetRGBA(1, 0, color.RGBA{G: 10})
+	img.SetRGBA(2, 0, color.RGBA{A: 0xff})
+	if _, n := Compare(img, want, 0); n != 3 {
+		t.Errorf("got %d differing pixels, expected 3", n)
+	}
+	diff, n := Compare(img, want, 2)
+	if n != 2 {
+		t.Errorf("got %d differing pixels, expected 2", n)
+	}
+	red := color.RGBA{R: 0xff, A: 0xff}
+	for x, exp := range []bool{false, true, true, false} {
+		if got := diff.RGBAAt(x, 0) == red; got != exp {
+			t.Errorf("pixel (%d, 0) marked %v, expected %v", x, got, exp)
+		}
+	}
+	if diff, n := Compare(image.NewRGBA(image.Rect(0, 0, 4, 5)), want, 0); diff != nil || n != 20 {
+		t.Errorf("got (%v, %d) for mismatched sizes, expected (nil, 20)", diff, n)
+	}
+}
+
+func TestGoldenButton(t *testing.T) {
+	gofont.Register()
+	th := material.NewTheme()
+	var btn widget.Clickable
+	h := New(image.Pt(100, 50), func(gtx layout.Context) layout.Dimensions {
+		return layout.Center.Layout(gtx, material.Button(th, &btn, "Golden").Layout)
+	})
+	defer h.Release()
+	h.Frame()
+	h.Golden(t, "button", Tolerance{Channel: 2, Pixels: 10})
+}
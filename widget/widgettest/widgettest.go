@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+/*
+Package widgettest provides a harness for testing widgets without a
+window.
+
+A Harness lays out a layout.Widget frame by frame, with a clock and
+pixel densities controlled by the test. Synthetic pointer and key
+events are routed to the widget's handlers through an io/router.Router
+and delivered in the following frame. Screenshots are rendered by
+app/headless.
+
+For example, to test that a click is reported by a widget.Clickable:
+
+	var btn widget.Clickable
+	h := widgettest.New(image.Pt(100, 100), btn.Layout)
+	defer h.Release()
+	h.Frame()
+	h.Click(f32.Pt(50, 50))
+	if !btn.Clicked() {
+		t.Error("button not clicked")
+	}
+
+Screenshots can be compared with golden images stored in the testdata
+directory of the test package. Run the test with the -update flag to
+create or replace the golden images:
+
+	h.Golden(t, "button", widgettest.Tolerance{Channel: 2})
+*/
+package widgettest
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"gioui.org/app/headless"
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// Harness drives a widget.
+type Harness struct {
+	// Widget is the widget under test.
+	Widget layout.Widget
+	// Size is the size of the window in pixels.
+	Size image.Point
+	// PxPerDp and PxPerSp are the number of pixels per dp and sp.
+	PxPerDp, PxPerSp float32
+	// Now is the time reported by the layout context. It only
+	// changes when the test changes it or calls Advance.
+	Now time.Time
+	// Dimensions is the result of the most recent layout.
+	Dimensions layout.Dimensions
+	// Clipboard is the content of the simulated system
+	// clipboard.
+	Clipboard string
+
+	router router.Router
+	ops    op.Ops
+	start  time.Time
+	// pos is the most recent pointer position.
+	pos     f32.Point
+	buttons pointer.Buttons
+	window  *headless.Window
+}
+
+// config implements system.Config.
+type config struct {
+	h *Harness
+}
+
+// New returns a harness for the widget w in a window of the given
+// size. The pixel densities are 1 and the clock starts at an
+// arbitrary, fixed time.
+func New(size image.Point, w layout.Widget) *Harness {
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return &Harness{
+		Widget:  w,
+		Size:    size,
+		PxPerDp: 1,
+		PxPerSp: 1,
+		Now:     start,
+		start:   start,
+	}
+}
+
+// Frame lays out the widget and replaces the event handlers with the
+// handlers of the new frame. Events added since the previous frame are
+// delivered during the layout. Clipboard writes are applied at the end
+// of the frame, and clipboard reads are delivered in the next frame.
+func (h *Harness) Frame() {
+	gtx := layout.NewContext(&h.ops, &h.router, config{h}, h.Size)
+	h.Dimensions = h.Widget(gtx)
+	h.router.Frame(&h.ops)
+	if text, ok := h.router.WriteClipboard(); ok {
+		h.Clipboard = text
+	}
+	if h.router.ReadClipboard() {
+		h.router.Add(system.ClipboardEvent{Text: h.Clipboard})
+	}
+}
+
+// Advance moves the clock forward by d and lays out a frame.
+func (h *Harness) Advance(d time.Duration) {
+	h.Now = h.Now.Add(d)
+	h.Frame()
+}
+
+// Redraw reports whether the most recent frame asked for another frame,
+// and if so, at what time. A zero time means immediately.
+func (h *Harness) Redraw() (time.Time, bool) {
+	return h.router.WakeupTime()
+}
+
+// Event adds events to the router and lays out a frame.
+func (h *Harness) Event(events ...event.Event) {
+	h.router.Add(events...)
+	h.Frame()
+}
+
+// Ops returns the operations of the most recent frame.
+func (h *Harness) Ops() *op.Ops {
+	return &h.ops
+}
+
+// Tags returns the tags of the pointer handlers at pos, in the order
+// they receive pointer events.
+func (h *Harness) Tags(pos f32.Point) []event.Tag {
+	return h.router.Tags(pos)
+}
+
+// Hit reports whether the pointer handler with the tag is hit by a
+// pointer at pos.
+func (h *Harness) Hit(pos f32.Point, tag event.Tag) bool {
+	for _, t := range h.Tags(pos) {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Move moves the mouse pointer to pos.
+func (h *Harness) Move(pos f32.Point) {
+	h.pos = pos
+	h.Event(h.pointerEvent(pointer.Move))
+}
+
+// Press moves the mouse pointer to pos and presses the left button.
+func (h *Harness) Press(pos f32.Point) {
+	h.pos = pos
+	h.buttons |= pointer.ButtonLeft
+	h.Event(h.pointerEvent(pointer.Press))
+}
+
+// ReleaseButton releases the mouse buttons at the current pointer
+// position.
+func (h *Harness) ReleaseButton() {
+	h.buttons = 0
+	h.Event(h.pointerEvent(pointer.Release))
+}
+
+// Click presses and releases the left mouse button at pos.
+func (h *Harness) Click(pos f32.Point) {
+	h.Press(pos)
+	h.ReleaseButton()
+}
+
+// Drag presses the left mouse button at from, moves the pointer to to
+// in the given number of steps and releases the button. Each step
+// lays out a frame and advances the clock by 10ms.
+func (h *Harness) Drag(from, to f32.Point, steps int) {
+	h.Press(from)
+	if steps < 1 {
+		steps = 1
+	}
+	d := to.Sub(from)
+	for i := 1; i <= steps; i++ {
+		h.Now = h.Now.Add(10 * time.Millisecond)
+		h.Move(from.Add(d.Mul(float32(i) / float32(steps))))
+	}
+	h.ReleaseButton()
+}
+
+// Scroll scrolls by delta pixels with the mouse pointer at pos.
+func (h *Harness) Scroll(pos, delta f32.Point) {
+	h.pos = pos
+	e := h.pointerEvent(pointer.Move)
+	e.Scroll = delta
+	h.Event(e)
+}
+
+// Key sends a key press to the focused key handler. See key.Event
+// for the names of keys.
+func (h *Harness) Key(name string, mods key.Modifiers) {
+	h.Event(key.Event{Name: name, Modifiers: mods})
+}
+
+// Type sends text to the focused key handler.
+func (h *Harness) Type(text string) {
+	h.Event(key.EditEvent{Text: text})
+}
+
+// Screenshot renders the most recent frame. Frames are rendered in
+// software, so screenshots are the same on every machine.
+func (h *Harness) Screenshot() (*image.RGBA, error) {
+	if h.window == nil {
+		w, err := headless.NewSoftWindow(h.Size.X, h.Size.Y)
+		if err != nil {
+			return nil, err
+		}
+		h.window = w
+	}
+	h.window.Frame(&h.ops)
+	return h.window.Screenshot()
+}
+
+// Release the resources of the harness.
+func (h *Harness) Release() {
+	if h.window != nil {
+		h.window.Release()
+		h.window = nil
+	}
+}
+
+func (h *Harness) pointerEvent(typ pointer.Type) pointer.Event {
+	return pointer.Event{
+		Type:     typ,
+		Source:   pointer.Mouse,
+		Time:     h.Now.Sub(h.start),
+		Buttons:  h.buttons,
+		Position: h.pos,
+	}
+}
+
+func (c config) Now() time.Time {
+	return c.h.Now
+}
+
+func (c config) Px(v unit.Value) int {
+	var r float32
+	switch v.U {
+	case unit.UnitPx:
+		r = v.V
+	case unit.UnitDp:
+		r = c.h.PxPerDp * v.V
+	case unit.UnitSp:
+		r = c.h.PxPerSp * v.V
+	default:
+		panic("unknown unit")
+	}
+	return int(math.Round(float64(r)))
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widgettest
+
+import (
+	"image"
+	"image/color"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/font/opentype"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestClick(t *testing.T) {
+	var btn widget.Clickable
+	h := New(image.Pt(100, 100), btn.Layout)
+	defer h.Release()
+	h.Frame()
+	if tags := h.Tags(f32.Pt(50, 50)); len(tags) != 1 {
+		t.Errorf("got %d handlers, expected 1", len(tags))
+	}
+	h.Click(f32.Pt(50, 50))
+	if !btn.Clicked() {
+		t.Error("button not clicked")
+	}
+	// Clicks outside the window are ignored.
+	h.Click(f32.Pt(150, 50))
+	if btn.Clicked() {
+		t.Error("button clicked outside its area")
+	}
+}
+
+func TestDrag(t *testing.T) {
+	var tag int
+	var moves []f32.Point
+	h := New(image.Pt(100, 100), func(gtx layout.Context) layout.Dimensions {
+		for _, e := range gtx.Events(&tag) {
+			if e, ok := e.(pointer.Event); ok && e.Type == pointer.Move {
+				moves = append(moves, e.Position)
+			}
+		}
+		pointer.Rect(image.Rect(0, 0, 100, 100)).Add(gtx.Ops)
+		pointer.InputOp{Tag: &tag}.Add(gtx.Ops)
+		return layout.Dimensions{Size: gtx.Constraints.Max}
+	})
+	defer h.Release()
+	h.Frame()
+	if !h.Hit(f32.Pt(10, 10), &tag) {
+		t.Error("handler not hit")
+	}
+	h.Drag(f32.Pt(10, 10), f32.Pt(50, 30), 2)
+	exp := []f32.Point{{X: 30, Y: 20}, {X: 50, Y: 30}}
+	if len(moves) != len(exp) {
+		t.Fatalf("got moves %v, expected %v", moves, exp)
+	}
+	for i, p := range exp {
+		if moves[i] != p {
+			t.Errorf("got move %d at %v, expected %v", i, moves[i], p)
+		}
+	}
+}
+
+func TestScroll(t *testing.T) {
+	list := &layout.List{Axis: layout.Vertical}
+	h := New(image.Pt(100, 100), func(gtx layout.Context) layout.Dimensions {
+		return list.Layout(gtx, 100, func(gtx layout.Context, i int) layout.Dimensions {
+			return layout.Dimensions{Size: image.Pt(100, 20)}
+		})
+	})
+	defer h.Release()
+	h.Frame()
+	h.Scroll(f32.Pt(50, 50), f32.Pt(0, 50))
+	// The list scrolls in the frame after the scroll event.
+	h.Frame()
+	if p := list.Position; p.First != 2 || p.Offset != 10 {
+		t.Errorf("got position %+v, expected first 2 and offset 10", p)
+	}
+}
+
+func TestTextInput(t *testing.T) {
+	face, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shaper := new(text.FontRegistry)
+	shaper.Register(text.Font{}, face)
+	var e widget.Editor
+	h := New(image.Pt(200, 50), func(gtx layout.Context) layout.Dimensions {
+		return e.Layout(gtx, shaper, text.Font{}, unit.Sp(10))
+	})
+	defer h.Release()
+	h.Frame()
+	e.Focus()
+	h.Frame()
+	h.Type("Hello")
+	h.Key("←", 0)
+	h.Type("!")
+	if got, exp := e.Text(), "Hell!o"; got != exp {
+		t.Errorf("got text %q, expected %q", got, exp)
+	}
+}
+
+func TestClockAndDensity(t *testing.T) {
+	var now time.Time
+	var px int
+	h := New(image.Pt(10, 10), func(gtx layout.Context) layout.Dimensions {
+		now = gtx.Now()
+		px = gtx.Px(unit.Dp(10))
+		op.InvalidateOp{At: gtx.Now().Add(time.Second)}.Add(gtx.Ops)
+		return layout.Dimensions{}
+	})
+	defer h.Release()
+	h.PxPerDp = 2
+	h.Frame()
+	start := now
+	if px != 20 {
+		t.Errorf("got %d pixels for 10dp, expected 20", px)
+	}
+	h.Advance(time.Second)
+	if d := now.Sub(start); d != time.Second {
+		t.Errorf("clock advanced %v, expected %v", d, time.Second)
+	}
+	if at, ok := h.Redraw(); !ok || !at.Equal(now.Add(time.Second)) {
+		t.Errorf("got redraw at %v (%v), expected %v", at, ok, now.Add(time.Second))
+	}
+}
+
+func TestScreenshot(t *testing.T) {
+	red := color.RGBA{R: 0xff, A: 0xff}
+	h := New(image.Pt(100, 100), func(gtx layout.Context) layout.Dimensions {
+		paint.ColorOp{Color: red}.Add(gtx.Ops)
+		paint.PaintOp{Rect: f32.Rect(0, 0, 50, 50)}.Add(gtx.Ops)
+		return layout.Dimensions{Size: image.Pt(50, 50)}
+	})
+	defer h.Release()
+	h.Frame()
+	img, err := h.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.RGBAAt(25, 25); got != red {
+		t.Errorf("got color %v, expected %v", got, red)
+	}
+	if got, exp := img.RGBAAt(75, 75), (color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}); got != exp {
+		t.Errorf("got color %v, expected %v", got, exp)
+	}
+}